@@ -0,0 +1,66 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+const (
+	// AnnotationKeyPaused pauses the reconciliation of a managed resource when
+	// set to "true". The resource is still observed, but no changes are pushed
+	// to the network node.
+	AnnotationKeyPaused = Group + "/paused"
+
+	// LabelKeyPaused pauses the reconciliation of all managed resources of a
+	// network node when the NetworkNode carries this label with value "true".
+	LabelKeyPaused = Group + "/paused"
+
+	// AnnotationKeyChildHash is set on the child resources generated by the
+	// SrlConfigTemplate and the composite resources, the value is the hash of
+	// the generated spec such that the child is only updated when it changed.
+	AnnotationKeyChildHash = Group + "/child-hash"
+
+	// AnnotationKeyPollInterval sets the interval at which a managed resource
+	// is checked for drift, e.g. "30s" or "10m". The poll interval of the
+	// provider is used when it is not set or not a valid duration.
+	AnnotationKeyPollInterval = Group + "/poll-interval"
+
+	// AnnotationKeyDriftPolicy sets how the drift of a managed resource is
+	// handled: correct, report or ignore. The default policy of the provider
+	// is used when it is not set.
+	AnnotationKeyDriftPolicy = Group + "/drift-policy"
+
+	// AnnotationKeyDriftIgnorePaths is a comma separated list of xpaths that are
+	// exempt from the drift comparison of a managed resource, e.g. config that
+	// the network node rewrites. The paths match the subtrees below them, list
+	// keys can be omitted or set to * to match all entries.
+	AnnotationKeyDriftIgnorePaths = Group + "/drift-ignore-paths"
+
+	// AnnotationKeyChangedBy is set by the admission webhook of the provider to
+	// the user that last changed the spec of a resource, it is recorded in the
+	// audit trail of the changes pushed to the network nodes.
+	AnnotationKeyChangedBy = Group + "/changed-by"
+)
+
+// Drift policies.
+const (
+	// DriftPolicyCorrect pushes the spec of the resource again when the config
+	// of the network node drifted
+	DriftPolicyCorrect = "correct"
+	// DriftPolicyReport reports the drift in the Drifted condition of the
+	// resource without changing the network node
+	DriftPolicyReport = "report"
+	// DriftPolicyIgnore neither corrects nor reports the drift
+	DriftPolicyIgnore = "ignore"
+)
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+)
+
+// A GenerationApplier records the generation of its spec that was applied to
+// the network node.
+// +kubebuilder:object:generate=false
+type GenerationApplier interface {
+	GetAppliedGeneration() int64
+	SetAppliedGeneration(g int64)
+}
+
+// An AppliedStatus is embedded in the status of the resources that record the
+// generation of their spec that was applied to the network node.
+type AppliedStatus struct {
+	// AppliedGeneration is the generation of the spec that was applied to the
+	// network node
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
+}
+
+// GetAppliedGeneration of this AppliedStatus.
+func (s *AppliedStatus) GetAppliedGeneration() int64 {
+	return s.AppliedGeneration
+}
+
+// SetAppliedGeneration of this AppliedStatus.
+func (s *AppliedStatus) SetAppliedGeneration(g int64) {
+	s.AppliedGeneration = g
+}
+
+// AppliedStatusOf returns the GenerationApplier of the status of the resource,
+// false is returned when its status does not embed an AppliedStatus.
+func AppliedStatusOf(o interface{}) (GenerationApplier, bool) {
+	v := reflect.ValueOf(o)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	s := v.Elem().FieldByName("Status")
+	if !s.IsValid() || !s.CanAddr() {
+		return nil, false
+	}
+	a, ok := s.Addr().Interface().(GenerationApplier)
+	return a, ok
+}
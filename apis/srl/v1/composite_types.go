@@ -0,0 +1,43 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+const (
+	// LabelKeyComposite is the label set on the child resources generated by a
+	// composite resource, the value is the name of the composite resource
+	LabelKeyComposite = Group + "/composite"
+)
+
+// A ChildResourceStatus reports the status of a child resource generated by a
+// composite resource.
+type ChildResourceStatus struct {
+	// Kind of the child resource
+	Kind string `json:"kind"`
+
+	// Name of the child resource
+	Name string `json:"name"`
+
+	// NetworkNode the child resource is applied to
+	NetworkNode string `json:"networkNode"`
+
+	// Ready is true when the child resource reports ready
+	Ready bool `json:"ready"`
+
+	// Message reports why the child resource is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
+}
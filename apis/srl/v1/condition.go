@@ -0,0 +1,205 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition Kinds specific to the srl provider.
+const (
+	// handled per resource
+	ConditionKindPaused nddv1.ConditionKind = "Paused"
+	// handled per resource
+	ConditionKindWaitingForWindow nddv1.ConditionKind = "WaitingForWindow"
+	// handled per resource
+	ConditionKindDuplicateIdentifier nddv1.ConditionKind = "DuplicateIdentifier"
+	// handled per resource
+	ConditionKindInvalidAddressing nddv1.ConditionKind = "InvalidAddressing"
+	// handled per resource
+	ConditionKindCommitFailed nddv1.ConditionKind = "CommitFailed"
+	// handled per resource
+	ConditionKindDrifted nddv1.ConditionKind = "Drifted"
+)
+
+// Condition Reasons specific to the srl provider.
+const (
+	ConditionReasonPausedByResource     nddv1.ConditionReason = "PausedByAnnotation"
+	ConditionReasonPausedByNetworkNode  nddv1.ConditionReason = "PausedByNetworkNode"
+	ConditionReasonNotPaused            nddv1.ConditionReason = "NotPaused"
+	ConditionReasonOutsideWindow        nddv1.ConditionReason = "OutsideMaintenanceWindow"
+	ConditionReasonInsideWindow         nddv1.ConditionReason = "InsideMaintenanceWindow"
+	ConditionReasonIdentifierCollision  nddv1.ConditionReason = "IdentifierCollision"
+	ConditionReasonUniqueIdentifiers    nddv1.ConditionReason = "UniqueIdentifiers"
+	ConditionReasonAddressingConflict   nddv1.ConditionReason = "AddressingConflict"
+	ConditionReasonValidAddressing      nddv1.ConditionReason = "ValidAddressing"
+	ConditionReasonCommitSucceeded      nddv1.ConditionReason = "CommitSucceeded"
+	ConditionReasonPermanentCommitError nddv1.ConditionReason = "PermanentCommitError"
+	ConditionReasonDriftReported        nddv1.ConditionReason = "DriftReported"
+	ConditionReasonNoDrift              nddv1.ConditionReason = "NoDrift"
+)
+
+// Paused returns a condition that indicates the reconciliation of the
+// resource is paused and no changes are pushed to the network node.
+func Paused(r nddv1.ConditionReason) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindPaused,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+	}
+}
+
+// NotPaused returns a condition that indicates the reconciliation of the
+// resource is not paused.
+func NotPaused() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindPaused,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonNotPaused,
+	}
+}
+
+// WaitingForWindow returns a condition that indicates changes to the resource
+// are queued until the next maintenance window opens.
+func WaitingForWindow() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindWaitingForWindow,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonOutsideWindow,
+	}
+}
+
+// InsideWindow returns a condition that indicates changes to the resource
+// are allowed since a maintenance window is open.
+func InsideWindow() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindWaitingForWindow,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonInsideWindow,
+	}
+}
+
+// DuplicateIdentifier returns a condition that indicates an identifier of the
+// resource, e.g. a VNI, EVI, ESI or router-id, collides with another resource
+// and no changes are pushed to the network node.
+func DuplicateIdentifier() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindDuplicateIdentifier,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonIdentifierCollision,
+	}
+}
+
+// UniqueIdentifiers returns a condition that indicates the identifiers of the
+// resource do not collide with other resources.
+func UniqueIdentifiers() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindDuplicateIdentifier,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonUniqueIdentifiers,
+	}
+}
+
+// InvalidAddressing returns a condition that indicates the addressing of the
+// subinterface is semantically invalid, e.g. overlapping prefixes or a static
+// neighbor outside the subnet, and no changes are pushed to the network node.
+func InvalidAddressing() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindInvalidAddressing,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonAddressingConflict,
+	}
+}
+
+// ValidAddressing returns a condition that indicates the addressing of the
+// subinterface is valid.
+func ValidAddressing() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindInvalidAddressing,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonValidAddressing,
+	}
+}
+
+// CommitFailed returns a condition that indicates the network node rejected the
+// last change of the resource. The reason is the gNMI status code of the commit
+// error, the message is the error reported by the network node.
+func CommitFailed(reason nddv1.ConditionReason) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindCommitFailed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+	}
+}
+
+// CommitReconcileError returns a Synced condition that indicates the network
+// node rejected the last change of the resource. The reason is the gNMI status
+// code of the commit error, the message is the error reported by the network
+// node.
+func CommitReconcileError(reason nddv1.ConditionReason) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               nddv1.ConditionKindSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+	}
+}
+
+// CommitSucceeded returns a condition that indicates the last change of the
+// resource was committed to the network node.
+func CommitSucceeded() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindCommitFailed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonCommitSucceeded,
+	}
+}
+
+// Drifted returns a condition that indicates the config of the network node
+// drifted from the applied spec of the resource and the drift is not corrected
+// by the drift policy of the resource. The message lists the drifted paths.
+func Drifted() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonDriftReported,
+	}
+}
+
+// NotDrifted returns a condition that indicates the config of the network node
+// matches the spec of the resource.
+func NotDrifted() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonNoDrift,
+	}
+}
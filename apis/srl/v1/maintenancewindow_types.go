@@ -0,0 +1,77 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A MaintenanceWindowSpec defines the desired state of a MaintenanceWindow.
+type MaintenanceWindowSpec struct {
+	// Schedule is a standard 5 field cron expression that defines when the
+	// maintenance window opens, e.g. "0 22 * * SAT"
+	Schedule string `json:"schedule"`
+
+	// Duration defines how long the maintenance window stays open after it
+	// got opened by the schedule
+	Duration metav1.Duration `json:"duration"`
+
+	// NetworkNodeSelector selects the network nodes the maintenance window
+	// applies to, when omitted the maintenance window applies to all network nodes
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindow is the Schema for the MaintenanceWindow API
+// When a MaintenanceWindow applies to a network node, device changing operations
+// on the resources of that network node are only allowed while the window is open
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="DURATION",type="string",JSONPath=".spec.duration"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlmw
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MaintenanceWindowSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindowList contains a list of MaintenanceWindows
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
+}
+
+// MaintenanceWindow type metadata.
+var (
+	MaintenanceWindowKind             = reflect.TypeOf(MaintenanceWindow{}).Name()
+	MaintenanceWindowGroupKind        = schema.GroupKind{Group: Group, Kind: MaintenanceWindowKind}.String()
+	MaintenanceWindowKindAPIVersion   = MaintenanceWindowKind + "." + GroupVersion.String()
+	MaintenanceWindowGroupVersionKind = GroupVersion.WithKind(MaintenanceWindowKind)
+)
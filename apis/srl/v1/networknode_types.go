@@ -0,0 +1,29 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// NetworkNodeSyncStatus reports the synchronization status of a resource that
+// is fanned out to multiple network nodes using a network node selector.
+type NetworkNodeSyncStatus struct {
+	// Name of the network node
+	Name string `json:"name"`
+	// Synced is true when the resource exists and is up to date on the network node
+	Synced bool `json:"synced"`
+	// Message contains details when the resource is not synced on the network node
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+)
+
+// A PoolReference references a SrlIpPool, SrlAsnPool or SrlVniPool from which
+// a value is allocated instead of using a literal value. There is no pool of
+// ethernet segment identifiers, the ESIs are set literally.
+type PoolReference struct {
+	// Name of the pool
+	Name string `json:"name"`
+}
+
+// A PoolAllocation is a value allocated from a pool for a claim.
+type PoolAllocation struct {
+	// Owner is the resource that claimed the value, <kind>/<name>
+	Owner string `json:"owner"`
+
+	// Claim identifies the claim within the owner
+	Claim string `json:"claim"`
+
+	// Value that is allocated
+	Value string `json:"value"`
+}
+
+// A PoolStatus represents the observed state of a pool.
+type PoolStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+
+	// Allocations are the values allocated from the pool, they are released when
+	// the owner is deleted or no longer claims the value
+	Allocations []PoolAllocation `json:"allocations,omitempty"`
+}
@@ -34,6 +34,32 @@ const (
 // RegistrationParameters are the parameter fields of a Registration.
 type RegistrationParameters struct {
 	nddv1.Register `json:",inline"`
+
+	// Subscription defines the mode, encoding and intervals of the subscriptions
+	// to the network nodes, the options that are not set use the defaults of the
+	// provider
+	Subscription *RegistrationSubscriptionOptions `json:"subscription,omitempty"`
+}
+
+// RegistrationSubscriptionOptions defines the mode, encoding and intervals of
+// a subscription.
+type RegistrationSubscriptionOptions struct {
+	// Mode of the subscription
+	// +kubebuilder:validation:Enum=`on-change`;`sample`;`target-defined`
+	Mode *string `json:"mode,omitempty"`
+
+	// SampleInterval of sample subscriptions, e.g. 10s
+	SampleInterval *metav1.Duration `json:"sampleInterval,omitempty"`
+
+	// HeartbeatInterval after which a value is sent when it did not change
+	HeartbeatInterval *metav1.Duration `json:"heartbeatInterval,omitempty"`
+
+	// SuppressRedundant suppresses the samples of values that did not change
+	SuppressRedundant *bool `json:"suppressRedundant,omitempty"`
+
+	// Encoding of the subscription
+	// +kubebuilder:validation:Enum=`json`;`bytes`;`proto`;`ascii`;`json_ietf`;`json_ietf_config_only`
+	Encoding *string `json:"encoding,omitempty"`
 }
 
 // RegistrationObservation are the observable fields of a Registration.
@@ -44,12 +70,52 @@ type RegistrationObservation struct {
 type RegistrationSpec struct {
 	nddv1.ResourceSpec `json:",inline"`
 	ForNetworkNode     RegistrationParameters `json:"forNetworkNode"`
+	// NetworkNodeSelector selects the network nodes the registration applies
+	// to, all network nodes are selected when it is not set
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
+	// Priority of the registration, a network node that is selected by multiple
+	// registrations uses the registration with the highest priority. On a tie a
+	// registration with a selector takes precedence over one without and then
+	// the registration with the lowest name is used
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// A RegistrationSubscription represents the state of the subscription to a
+// network node.
+type RegistrationSubscription struct {
+	// NetworkNode the subscription is made to
+	NetworkNode string `json:"networkNode"`
+
+	// Connected is true when the subscription is connected
+	Connected bool `json:"connected"`
+
+	// ReconnectAttempts since the subscription was last connected
+	ReconnectAttempts int32 `json:"reconnectAttempts,omitempty"`
+
+	// LastError of the subscription
+	LastError string `json:"lastError,omitempty"`
+
+	// LastTransitionTime is the last time the subscription connected or disconnected
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// ObservedGeneration of the registration that is registered to the device
+	// driver of the network node
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Deregistered is true when the deleted registration is removed from the
+	// device driver of the network node
+	Deregistered bool `json:"deregistered,omitempty"`
 }
 
 // A RegistrationStatus represents the observed state of a Registration.
 type RegistrationStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        RegistrationObservation `json:"atNetworkNode,omitempty"`
+
+	// Subscriptions are the states of the subscriptions to the network nodes
+	Subscriptions []RegistrationSubscription `json:"subscriptions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +123,7 @@ type RegistrationStatus struct {
 // Registration is the Schema for the Registration API
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.priority"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
 // +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
@@ -95,6 +162,11 @@ func (o *Registration) SetSubscriptions(sub []string) {
 	o.Spec.ForNetworkNode.Subscriptions = sub
 }
 
+// GetNetworkNodeSelector returns the network node selector of the registration
+func (o *Registration) GetNetworkNodeSelector() *metav1.LabelSelector {
+	return o.Spec.NetworkNodeSelector
+}
+
 // Registration type metadata.
 var (
 	RegistrationKind             = reflect.TypeOf(Registration{}).Name()
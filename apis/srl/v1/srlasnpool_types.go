@@ -0,0 +1,98 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A AsnPoolSpec defines the desired state of a SrlAsnPool.
+type AsnPoolSpec struct {
+	// Start is the first autonomous system of the pool
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	Start uint32 `json:"start"`
+
+	// End is the last autonomous system of the pool
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	End uint32 `json:"end"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlAsnPool is the Schema for the AsnPool API
+// A SrlAsnPool allocates autonomous systems from a range
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="START",type="integer",JSONPath=".spec.start"
+// +kubebuilder:printcolumn:name="END",type="integer",JSONPath=".spec.end"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlasnpool
+type SrlAsnPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AsnPoolSpec `json:"spec"`
+	Status PoolStatus  `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlAsnPoolList contains a list of AsnPools
+type SrlAsnPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlAsnPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlAsnPool{}, &SrlAsnPoolList{})
+}
+
+// GetCondition of this SrlAsnPool.
+func (mg *SrlAsnPool) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlAsnPool.
+func (mg *SrlAsnPool) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// GetAllocations returns the values allocated from the pool
+func (mg *SrlAsnPool) GetAllocations() []PoolAllocation {
+	return mg.Status.Allocations
+}
+
+// SetAllocations sets the values allocated from the pool
+func (mg *SrlAsnPool) SetAllocations(a []PoolAllocation) {
+	mg.Status.Allocations = a
+}
+
+// AsnPool type metadata.
+var (
+	AsnPoolKind             = reflect.TypeOf(SrlAsnPool{}).Name()
+	AsnPoolGroupKind        = schema.GroupKind{Group: Group, Kind: AsnPoolKind}.String()
+	AsnPoolKindAPIVersion   = AsnPoolKind + "." + GroupVersion.String()
+	AsnPoolGroupVersionKind = GroupVersion.WithKind(AsnPoolKind)
+)
@@ -106,6 +106,7 @@ type BfdSpec struct {
 type BfdStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        BfdObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Labels of the SrlChangeRecords, they select the records of a network node or
+// of a managed resource.
+const (
+	LabelKeyChangeNetworkNode = Group + "/network-node"
+	LabelKeyChangeKind        = Group + "/kind"
+	LabelKeyChangeResource    = Group + "/resource"
+)
+
+// A ChangeRecordResource is the managed resource of which the change was pushed
+// to the network node.
+type ChangeRecordResource struct {
+	// Kind of the managed resource
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the managed resource
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the managed resource
+	Namespace string `json:"namespace,omitempty"`
+
+	// Generation of the spec of the managed resource that was pushed
+	Generation int64 `json:"generation,omitempty"`
+
+	// User that last changed the spec of the managed resource, as recorded by
+	// the admission webhook of the provider
+	User string `json:"user,omitempty"`
+
+	// FieldManager is the field manager that last changed the spec of the
+	// managed resource, as recorded in the managed fields of the resource
+	FieldManager string `json:"fieldManager,omitempty"`
+
+	// ManagedTime is the time the field manager last changed the spec
+	ManagedTime *metav1.Time `json:"managedTime,omitempty"`
+}
+
+// A ChangeRecordUpdate is an update or replace of a path.
+type ChangeRecordUpdate struct {
+	// Path of the update as xpath
+	Path string `json:"path"`
+
+	// Value of the update encoded as JSON
+	Value string `json:"value,omitempty"`
+}
+
+// A ChangeRecordSpec records a gnmi set request the provider sent to the
+// device driver of a network node.
+type ChangeRecordSpec struct {
+	// Timestamp at which the set request returned
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// NetworkNode the set request was sent to
+	NetworkNode string `json:"networkNode"`
+
+	// Resource of which the change was pushed, it is empty for the set requests
+	// that are not made on behalf of a managed resource
+	Resource ChangeRecordResource `json:"resource,omitempty"`
+
+	// Updates of the set request
+	Updates []ChangeRecordUpdate `json:"updates,omitempty"`
+
+	// Replaces of the set request
+	Replaces []ChangeRecordUpdate `json:"replaces,omitempty"`
+
+	// Deletes are the deleted paths of the set request
+	Deletes []string `json:"deletes,omitempty"`
+
+	// Error returned by the device driver, empty when the set succeeded
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlChangeRecord is the Schema for the ChangeRecord API
+// A SrlChangeRecord is an audit record of a configuration change the provider
+// pushed to a network node, the records are created by the provider and are
+// not changed afterwards
+// +kubebuilder:printcolumn:name="NODE",type="string",JSONPath=".spec.networkNode"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.resource.kind"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.resource.name"
+// +kubebuilder:printcolumn:name="GENERATION",type="integer",JSONPath=".spec.resource.generation"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.resource.user"
+// +kubebuilder:printcolumn:name="FIELD-MANAGER",type="string",JSONPath=".spec.resource.fieldManager",priority=1
+// +kubebuilder:printcolumn:name="ERROR",type="string",JSONPath=".spec.error",priority=1
+// +kubebuilder:printcolumn:name="TIMESTAMP",type="date",JSONPath=".spec.timestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlchange
+type SrlChangeRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ChangeRecordSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlChangeRecordList contains a list of ChangeRecords
+type SrlChangeRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlChangeRecord `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlChangeRecord{}, &SrlChangeRecordList{})
+}
+
+// ChangeRecord type metadata.
+var (
+	ChangeRecordKind             = reflect.TypeOf(SrlChangeRecord{}).Name()
+	ChangeRecordGroupKind        = schema.GroupKind{Group: Group, Kind: ChangeRecordKind}.String()
+	ChangeRecordKindAPIVersion   = ChangeRecordKind + "." + GroupVersion.String()
+	ChangeRecordGroupVersionKind = GroupVersion.WithKind(ChangeRecordKind)
+)
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// LabelKeyConfigTemplate is the label set on the child resources rendered
+	// by a SrlConfigTemplate, the value is the name of the SrlConfigTemplate
+	LabelKeyConfigTemplate = Group + "/config-template"
+)
+
+// A ConfigMapReference refers to a ConfigMap by name and namespace.
+type ConfigMapReference struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap
+	Namespace string `json:"namespace"`
+}
+
+// A ConfigTemplateSpec defines the desired state of a SrlConfigTemplate.
+type ConfigTemplateSpec struct {
+	// Active specifies if the rendered resources are active or not
+	// +kubebuilder:default=true
+	Active bool `json:"active,omitempty"`
+
+	// DeletionPolicy is applied to the rendered resources
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy nddv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Kind of the SR Linux resource that is rendered per network node, e.g. SrlSystemName
+	Kind string `json:"kind"`
+
+	// Template is a go template that renders the forNetworkNode parameters of the
+	// kind as YAML or JSON. The template gets the following data:
+	// .NetworkNode is the name of the network node,
+	// .Labels and .Annotations are the labels and annotations of the network node,
+	// .Vars are the variables of the network node from the VariablesConfigMapRef
+	// claimPrefix, claimAsn and claimVni claim a value from a SrlIpPool, SrlAsnPool
+	// or SrlVniPool for the network node, e.g. {{ claimPrefix "loopbacks" "system0" }}
+	Template string `json:"template"`
+
+	// NetworkNodeSelector selects the network nodes a resource is rendered for,
+	// when omitted a resource is rendered for all network nodes
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
+
+	// VariablesConfigMapRef refers to a ConfigMap holding the variables per
+	// network node. Every key of the ConfigMap is the name of a network node,
+	// the value is a YAML map of variable names and values.
+	// +optional
+	VariablesConfigMapRef *ConfigMapReference `json:"variablesConfigMapRef,omitempty"`
+}
+
+// A ConfigTemplateChild is a resource rendered by a SrlConfigTemplate.
+type ConfigTemplateChild struct {
+	// Kind of the rendered resource
+	Kind string `json:"kind"`
+
+	// Name of the rendered resource
+	Name string `json:"name"`
+
+	// NetworkNode the resource is rendered for
+	NetworkNode string `json:"networkNode"`
+
+	// Message reports why the resource could not be rendered
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// A ConfigTemplateStatus represents the observed state of a SrlConfigTemplate.
+type ConfigTemplateStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+
+	// Children are the resources rendered by the SrlConfigTemplate
+	Children []ConfigTemplateChild `json:"children,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlConfigTemplate is the Schema for the ConfigTemplate API
+// A SrlConfigTemplate renders and manages one resource of the supplied kind per
+// selected network node, the rendered resources are owned by the SrlConfigTemplate
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.kind"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlct
+type SrlConfigTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigTemplateSpec   `json:"spec"`
+	Status ConfigTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlConfigTemplateList contains a list of ConfigTemplates
+type SrlConfigTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlConfigTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlConfigTemplate{}, &SrlConfigTemplateList{})
+}
+
+// GetCondition of this SrlConfigTemplate.
+func (mg *SrlConfigTemplate) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlConfigTemplate.
+func (mg *SrlConfigTemplate) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// ConfigTemplate type metadata.
+var (
+	ConfigTemplateKind             = reflect.TypeOf(SrlConfigTemplate{}).Name()
+	ConfigTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigTemplateKind}.String()
+	ConfigTemplateKindAPIVersion   = ConfigTemplateKind + "." + GroupVersion.String()
+	ConfigTemplateGroupVersionKind = GroupVersion.WithKind(ConfigTemplateKind)
+)
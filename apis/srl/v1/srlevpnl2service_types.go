@@ -0,0 +1,173 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EvpnServiceAttachment attaches an interface of a network node to an EVPN service.
+type EvpnServiceAttachment struct {
+	// NetworkNode the interface belongs to
+	NetworkNode string `json:"networkNode"`
+
+	// Interface is the name of the interface, e.g. ethernet-1/1
+	Interface string `json:"interface"`
+
+	// VlanID is the VLAN of the attachment, when omitted the attachment is untagged
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	// +optional
+	VlanID *uint16 `json:"vlanId,omitempty"`
+
+	// Index of the subinterface, defaults to the VlanID or 0 when untagged
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9999
+	// +optional
+	Index *uint32 `json:"index,omitempty"`
+}
+
+// A EvpnL2ServiceSpec defines the desired state of a SrlEvpnL2Service.
+type EvpnL2ServiceSpec struct {
+	// Active specifies if the generated resources are active or not
+	// +kubebuilder:default=true
+	Active bool `json:"active,omitempty"`
+
+	// DeletionPolicy is applied to the generated resources
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy nddv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Name of the mac-vrf network instance, defaults to the name of the SrlEvpnL2Service
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Vni is the VXLAN network identifier of the service, it is also used as the
+	// index of the vxlan-interface
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16777215
+	// +optional
+	Vni uint32 `json:"vni,omitempty"`
+
+	// VniPoolRef references a SrlVniPool from which the VXLAN network identifier
+	// is claimed when the Vni is not set
+	// +optional
+	VniPoolRef *PoolReference `json:"vniPoolRef,omitempty"`
+
+	// Evi is the EVPN instance identifier of the service
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Evi uint32 `json:"evi"`
+
+	// TunnelInterface is the name of the tunnel interface the vxlan-interface is created on
+	// +kubebuilder:default=vxlan0
+	// +optional
+	TunnelInterface string `json:"tunnelInterface,omitempty"`
+
+	// AutonomousSystem is used to derive the route target target:<autonomousSystem>:<evi>,
+	// it is required when the RouteTarget is not set
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	AutonomousSystem uint32 `json:"autonomousSystem,omitempty"`
+
+	// RouteTarget overrides the derived route target for import and export
+	// +kubebuilder:validation:Pattern=`target:(6553[0-5]|655[0-2][0-9]|654[0-9]{2}|65[0-4][0-9]{2}|6[0-4][0-9]{3}|[1-5][0-9]{4}|[1-9][0-9]{1,3}|[0-9]):(429496729[0-5]|42949672[0-8][0-9]|4294967[0-1][0-9]{2}|429496[0-6][0-9]{3}|42949[0-5][0-9]{4}|4294[0-8][0-9]{5}|429[0-3][0-9]{6}|4[0-1][0-9]{7}|[1-3][0-9]{9}|[1-9][0-9]{1,8}|[0-9])`
+	// +optional
+	RouteTarget string `json:"routeTarget,omitempty"`
+
+	// Attachments are the interfaces per network node that are attached to the service
+	// +kubebuilder:validation:MinItems=1
+	Attachments []EvpnServiceAttachment `json:"attachments"`
+}
+
+// A EvpnL2ServiceStatus represents the observed state of a SrlEvpnL2Service.
+type EvpnL2ServiceStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+
+	// Vni is the VXLAN network identifier used by the service
+	Vni uint32 `json:"vni,omitempty"`
+
+	// Children are the resources generated by the SrlEvpnL2Service
+	Children []ChildResourceStatus `json:"children,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlEvpnL2Service is the Schema for the EvpnL2Service API
+// A SrlEvpnL2Service generates and owns the mac-vrf network instance, the bridged
+// subinterfaces, the vxlan-interface and the bgp-evpn and bgp-vpn configuration of
+// a bridged EVPN-VXLAN service on every network node of its attachments
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VNI",type="integer",JSONPath=".status.vni"
+// +kubebuilder:printcolumn:name="EVI",type="integer",JSONPath=".spec.evi"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srll2svc
+type SrlEvpnL2Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EvpnL2ServiceSpec   `json:"spec"`
+	Status EvpnL2ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlEvpnL2ServiceList contains a list of EvpnL2Services
+type SrlEvpnL2ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlEvpnL2Service `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlEvpnL2Service{}, &SrlEvpnL2ServiceList{})
+}
+
+// GetCondition of this SrlEvpnL2Service.
+func (mg *SrlEvpnL2Service) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlEvpnL2Service.
+func (mg *SrlEvpnL2Service) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// GetChildren returns the status of the generated resources
+func (mg *SrlEvpnL2Service) GetChildren() []ChildResourceStatus {
+	return mg.Status.Children
+}
+
+// SetChildren sets the status of the generated resources
+func (mg *SrlEvpnL2Service) SetChildren(c []ChildResourceStatus) {
+	mg.Status.Children = c
+}
+
+// EvpnL2Service type metadata.
+var (
+	EvpnL2ServiceKind             = reflect.TypeOf(SrlEvpnL2Service{}).Name()
+	EvpnL2ServiceGroupKind        = schema.GroupKind{Group: Group, Kind: EvpnL2ServiceKind}.String()
+	EvpnL2ServiceKindAPIVersion   = EvpnL2ServiceKind + "." + GroupVersion.String()
+	EvpnL2ServiceGroupVersionKind = GroupVersion.WithKind(EvpnL2ServiceKind)
+)
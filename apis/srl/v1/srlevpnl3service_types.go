@@ -0,0 +1,191 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EvpnL3ServiceMacVrf links the mac-vrf of a SrlEvpnL2Service to the ip-vrf
+// using an irb subinterface with an anycast gateway.
+type EvpnL3ServiceMacVrf struct {
+	// EvpnL2Service is the name of the SrlEvpnL2Service that is linked
+	EvpnL2Service string `json:"evpnL2Service"`
+
+	// IrbIndex is the index of the irb subinterface of the mac-vrf
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9999
+	IrbIndex uint32 `json:"irbIndex"`
+
+	// Ipv4Prefixes are the anycast gateway addresses of the irb subinterface, e.g. 10.1.1.1/24
+	// +optional
+	Ipv4Prefixes []string `json:"ipv4Prefixes,omitempty"`
+
+	// Ipv6Prefixes are the anycast gateway addresses of the irb subinterface, e.g. 2001:db8::1/64
+	// +optional
+	Ipv6Prefixes []string `json:"ipv6Prefixes,omitempty"`
+}
+
+// A EvpnL3ServiceSpec defines the desired state of a SrlEvpnL3Service.
+type EvpnL3ServiceSpec struct {
+	// Active specifies if the generated resources are active or not
+	// +kubebuilder:default=true
+	Active bool `json:"active,omitempty"`
+
+	// DeletionPolicy is applied to the generated resources
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy nddv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Name of the ip-vrf network instance, defaults to the name of the SrlEvpnL3Service
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Vni is the VXLAN network identifier of the ip-vrf, it is also used as the
+	// index of the routed vxlan-interface
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16777215
+	// +optional
+	Vni uint32 `json:"vni,omitempty"`
+
+	// VniPoolRef references a SrlVniPool from which the VXLAN network identifier
+	// is claimed when the Vni is not set
+	// +optional
+	VniPoolRef *PoolReference `json:"vniPoolRef,omitempty"`
+
+	// Evi is the EVPN instance identifier of the ip-vrf
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Evi uint32 `json:"evi"`
+
+	// TunnelInterface is the name of the tunnel interface the vxlan-interface is created on
+	// +kubebuilder:default=vxlan0
+	// +optional
+	TunnelInterface string `json:"tunnelInterface,omitempty"`
+
+	// IrbInterface is the name of the irb interface the irb subinterfaces are created on
+	// +kubebuilder:default=irb0
+	// +optional
+	IrbInterface string `json:"irbInterface,omitempty"`
+
+	// AutonomousSystem is used to derive the route target target:<autonomousSystem>:<evi>,
+	// it is required when the RouteTarget is not set
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	AutonomousSystem uint32 `json:"autonomousSystem,omitempty"`
+
+	// RouteTarget overrides the derived route target for import and export
+	// +kubebuilder:validation:Pattern=`target:(6553[0-5]|655[0-2][0-9]|654[0-9]{2}|65[0-4][0-9]{2}|6[0-4][0-9]{3}|[1-5][0-9]{4}|[1-9][0-9]{1,3}|[0-9]):(429496729[0-5]|42949672[0-8][0-9]|4294967[0-1][0-9]{2}|429496[0-6][0-9]{3}|42949[0-5][0-9]{4}|4294[0-8][0-9]{5}|429[0-3][0-9]{6}|4[0-1][0-9]{7}|[1-3][0-9]{9}|[1-9][0-9]{1,8}|[0-9])`
+	// +optional
+	RouteTarget string `json:"routeTarget,omitempty"`
+
+	// AnycastGwMac is the anycast gateway MAC address of the irb subinterfaces, when
+	// omitted it is derived from the VirtualRouterId by the network node
+	// +kubebuilder:validation:Pattern=`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`
+	// +optional
+	AnycastGwMac string `json:"anycastGwMac,omitempty"`
+
+	// VirtualRouterId is used to derive the anycast gateway MAC address
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +kubebuilder:default=1
+	// +optional
+	VirtualRouterId uint8 `json:"virtualRouterId,omitempty"`
+
+	// MacVrfs are the mac-vrfs of the SrlEvpnL2Services that are linked to the
+	// ip-vrf, the ip-vrf is created on every network node of their attachments
+	// +kubebuilder:validation:MinItems=1
+	MacVrfs []EvpnL3ServiceMacVrf `json:"macVrfs"`
+}
+
+// A EvpnL3ServiceStatus represents the observed state of a SrlEvpnL3Service.
+type EvpnL3ServiceStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+
+	// Vni is the VXLAN network identifier used by the service
+	Vni uint32 `json:"vni,omitempty"`
+
+	// Children are the resources generated by the SrlEvpnL3Service
+	Children []ChildResourceStatus `json:"children,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlEvpnL3Service is the Schema for the EvpnL3Service API
+// A SrlEvpnL3Service generates and owns the ip-vrf network instance, the irb
+// subinterfaces with anycast gateway, the routed vxlan-interface and the bgp-evpn
+// and bgp-vpn configuration of a symmetric IRB EVPN-VXLAN service
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VNI",type="integer",JSONPath=".status.vni"
+// +kubebuilder:printcolumn:name="EVI",type="integer",JSONPath=".spec.evi"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srll3svc
+type SrlEvpnL3Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EvpnL3ServiceSpec   `json:"spec"`
+	Status EvpnL3ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlEvpnL3ServiceList contains a list of EvpnL3Services
+type SrlEvpnL3ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlEvpnL3Service `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlEvpnL3Service{}, &SrlEvpnL3ServiceList{})
+}
+
+// GetCondition of this SrlEvpnL3Service.
+func (mg *SrlEvpnL3Service) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlEvpnL3Service.
+func (mg *SrlEvpnL3Service) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// GetChildren returns the status of the generated resources
+func (mg *SrlEvpnL3Service) GetChildren() []ChildResourceStatus {
+	return mg.Status.Children
+}
+
+// SetChildren sets the status of the generated resources
+func (mg *SrlEvpnL3Service) SetChildren(c []ChildResourceStatus) {
+	mg.Status.Children = c
+}
+
+// EvpnL3Service type metadata.
+var (
+	EvpnL3ServiceKind             = reflect.TypeOf(SrlEvpnL3Service{}).Name()
+	EvpnL3ServiceGroupKind        = schema.GroupKind{Group: Group, Kind: EvpnL3ServiceKind}.String()
+	EvpnL3ServiceKindAPIVersion   = EvpnL3ServiceKind + "." + GroupVersion.String()
+	EvpnL3ServiceGroupVersionKind = GroupVersion.WithKind(EvpnL3ServiceKind)
+)
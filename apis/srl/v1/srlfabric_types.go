@@ -0,0 +1,219 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Roles of the network nodes of a fabric.
+const (
+	FabricRoleSuperspine = "superspine"
+	FabricRoleSpine      = "spine"
+	FabricRoleLeaf       = "leaf"
+)
+
+// FabricNode is a network node of the fabric.
+type FabricNode struct {
+	// NetworkNode is the name of the NetworkNode
+	NetworkNode string `json:"networkNode"`
+
+	// Role of the network node in the fabric, the links connect the leafs to the
+	// spines and the spines to the superspines. Every leaf gets its own
+	// autonomous system, the spines and the superspines share one per tier.
+	// +kubebuilder:validation:Enum=`superspine`;`spine`;`leaf`
+	Role string `json:"role"`
+
+	// AutonomousSystem overrides the autonomous system allocated from the pool
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	AutonomousSystem *uint32 `json:"autonomousSystem,omitempty"`
+}
+
+// FabricLinkEndpoint is an endpoint of a fabric link.
+type FabricLinkEndpoint struct {
+	// NetworkNode is the name of the NetworkNode
+	NetworkNode string `json:"networkNode"`
+
+	// Interface is the name of the interface, e.g. ethernet-1/49
+	Interface string `json:"interface"`
+}
+
+// FabricLink is a point-to-point link between two network nodes of the fabric.
+type FabricLink struct {
+	// A is the endpoint that gets the first address of the /31
+	A FabricLinkEndpoint `json:"a"`
+
+	// B is the endpoint that gets the second address of the /31
+	B FabricLinkEndpoint `json:"b"`
+}
+
+// FabricAutonomousSystemPool is the range of autonomous systems allocated to the
+// network nodes.
+type FabricAutonomousSystemPool struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	Start uint32 `json:"start"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	End uint32 `json:"end"`
+}
+
+// A FabricSpec defines the desired state of a SrlFabric.
+type FabricSpec struct {
+	// Active specifies if the generated resources are active or not
+	// +kubebuilder:default=true
+	Active bool `json:"active,omitempty"`
+
+	// DeletionPolicy is applied to the generated resources
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy nddv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// NetworkInstance is the name of the network instance of the underlay
+	// +kubebuilder:default=default
+	// +optional
+	NetworkInstance string `json:"networkInstance,omitempty"`
+
+	// Nodes are the network nodes of the fabric, the autonomous systems and
+	// loopback addresses are allocated in the order of the nodes. New nodes
+	// should be appended to keep the existing allocations stable.
+	// +kubebuilder:validation:MinItems=1
+	Nodes []FabricNode `json:"nodes"`
+
+	// Links are the point-to-point links of the fabric, the /31 subnets are
+	// allocated in the order of the links. New links should be appended to keep
+	// the existing allocations stable.
+	// +optional
+	Links []FabricLink `json:"links,omitempty"`
+
+	// LinkPool is the IPv4 prefix from which the /31 subnets of the links are allocated
+	// +optional
+	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])/(([0-9])|([1-2][0-9])|(3[0-2]))`
+	LinkPool string `json:"linkPool,omitempty"`
+
+	// LinkPoolRef references a SrlIpPool with allocation length 31 from which the
+	// subnets of the links are claimed, it takes precedence over the LinkPool
+	// +optional
+	LinkPoolRef *PoolReference `json:"linkPoolRef,omitempty"`
+
+	// LoopbackPool is the IPv4 prefix from which the system0.0 /32 addresses are
+	// allocated, they are also used as router-id
+	// +optional
+	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])/(([0-9])|([1-2][0-9])|(3[0-2]))`
+	LoopbackPool string `json:"loopbackPool,omitempty"`
+
+	// LoopbackPoolRef references a SrlIpPool with allocation length 32 from which
+	// the loopbacks are claimed, it takes precedence over the LoopbackPool
+	// +optional
+	LoopbackPoolRef *PoolReference `json:"loopbackPoolRef,omitempty"`
+
+	// AutonomousSystemPool is the range from which the autonomous systems of the
+	// network nodes are allocated
+	// +optional
+	AutonomousSystemPool *FabricAutonomousSystemPool `json:"autonomousSystemPool,omitempty"`
+
+	// AutonomousSystemPoolRef references a SrlAsnPool from which the autonomous
+	// systems are claimed, it takes precedence over the AutonomousSystemPool
+	// +optional
+	AutonomousSystemPoolRef *PoolReference `json:"autonomousSystemPoolRef,omitempty"`
+}
+
+// FabricNodeStatus is the allocation of a network node of the fabric.
+type FabricNodeStatus struct {
+	NetworkNode      string `json:"networkNode"`
+	Role             string `json:"role,omitempty"`
+	AutonomousSystem uint32 `json:"autonomousSystem,omitempty"`
+	RouterId         string `json:"routerId,omitempty"`
+}
+
+// A FabricStatus represents the observed state of a SrlFabric.
+type FabricStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+
+	// Nodes are the allocations of the network nodes
+	Nodes []FabricNodeStatus `json:"nodes,omitempty"`
+
+	// Children are the resources generated by the SrlFabric
+	Children []ChildResourceStatus `json:"children,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlFabric is the Schema for the Fabric API
+// A SrlFabric generates and owns the point-to-point interfaces, the system0
+// loopbacks, the underlay network instance interfaces, the eBGP underlay and
+// its export policy of a spine/leaf topology
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlfabric
+type SrlFabric struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FabricSpec   `json:"spec"`
+	Status FabricStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlFabricList contains a list of Fabrics
+type SrlFabricList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlFabric `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlFabric{}, &SrlFabricList{})
+}
+
+// GetCondition of this SrlFabric.
+func (mg *SrlFabric) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlFabric.
+func (mg *SrlFabric) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// GetChildren returns the status of the generated resources
+func (mg *SrlFabric) GetChildren() []ChildResourceStatus {
+	return mg.Status.Children
+}
+
+// SetChildren sets the status of the generated resources
+func (mg *SrlFabric) SetChildren(c []ChildResourceStatus) {
+	mg.Status.Children = c
+}
+
+// Fabric type metadata.
+var (
+	FabricKind             = reflect.TypeOf(SrlFabric{}).Name()
+	FabricGroupKind        = schema.GroupKind{Group: Group, Kind: FabricKind}.String()
+	FabricKindAPIVersion   = FabricKind + "." + GroupVersion.String()
+	FabricGroupVersionKind = GroupVersion.WithKind(FabricKind)
+)
@@ -217,6 +217,7 @@ type InterfaceSpec struct {
 type InterfaceStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        InterfaceObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -138,10 +138,14 @@ type InterfaceSubinterfaceIpv4 struct {
 // InterfaceSubinterfaceIpv4Address struct
 type InterfaceSubinterfaceIpv4Address struct {
 	AnycastGw *bool `json:"anycast-gw,omitempty"`
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])/(([0-9])|([1-2][0-9])|(3[0-2]))`
-	IpPrefix *string `json:"ip-prefix"`
-	Primary  *string `json:"primary,omitempty"`
+	IpPrefix *string `json:"ip-prefix,omitempty"`
+	// IpPrefixPoolRef references a SrlIpPool from which the prefix of the address
+	// is claimed when the ip-prefix is not set
+	// +kubebuilder:validation:Optional
+	IpPrefixPoolRef *PoolReference `json:"ip-prefix-pool-ref,omitempty"`
+	Primary         *string        `json:"primary,omitempty"`
 }
 
 // InterfaceSubinterfaceIpv4Arp struct
@@ -588,6 +592,7 @@ type InterfaceSubinterfaceSpec struct {
 type InterfaceSubinterfaceStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        InterfaceSubinterfaceObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
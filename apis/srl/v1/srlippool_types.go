@@ -0,0 +1,98 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A IpPoolSpec defines the desired state of a SrlIpPool.
+type IpPoolSpec struct {
+	// Prefix is the IPv4 or IPv6 prefix from which the prefixes are allocated
+	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])/(([0-9])|([1-2][0-9])|(3[0-2]))|((:|[0-9a-fA-F]{0,4}):)([0-9a-fA-F]{0,4}:){0,5}((([0-9a-fA-F]{0,4}:)?(:|[0-9a-fA-F]{0,4}))|(((25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])))(/(([0-9])|([0-9]{2})|(1[0-1][0-9])|(12[0-8])))`
+	Prefix string `json:"prefix"`
+
+	// AllocationLength is the prefix length of the allocated prefixes, e.g. 31 for
+	// point-to-point links or 32 for loopbacks
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	AllocationLength uint8 `json:"allocationLength"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlIpPool is the Schema for the IpPool API
+// A SrlIpPool allocates prefixes of the allocation length from a prefix
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="PREFIX",type="string",JSONPath=".spec.prefix"
+// +kubebuilder:printcolumn:name="LENGTH",type="integer",JSONPath=".spec.allocationLength"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlippool
+type SrlIpPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IpPoolSpec `json:"spec"`
+	Status PoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlIpPoolList contains a list of IpPools
+type SrlIpPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlIpPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlIpPool{}, &SrlIpPoolList{})
+}
+
+// GetCondition of this SrlIpPool.
+func (mg *SrlIpPool) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlIpPool.
+func (mg *SrlIpPool) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// GetAllocations returns the values allocated from the pool
+func (mg *SrlIpPool) GetAllocations() []PoolAllocation {
+	return mg.Status.Allocations
+}
+
+// SetAllocations sets the values allocated from the pool
+func (mg *SrlIpPool) SetAllocations(a []PoolAllocation) {
+	mg.Status.Allocations = a
+}
+
+// IpPool type metadata.
+var (
+	IpPoolKind             = reflect.TypeOf(SrlIpPool{}).Name()
+	IpPoolGroupKind        = schema.GroupKind{Group: Group, Kind: IpPoolKind}.String()
+	IpPoolKindAPIVersion   = IpPoolKind + "." + GroupVersion.String()
+	IpPoolGroupVersionKind = GroupVersion.WithKind(IpPoolKind)
+)
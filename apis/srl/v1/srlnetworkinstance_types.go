@@ -51,9 +51,13 @@ type Networkinstance struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern="[A-Za-z0-9 !@#$^&()|+=`~.,'/_:;?-]*"
 	Name *string `json:"name"`
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`
-	RouterId           *string                            `json:"router-id,omitempty"`
+	RouterId *string `json:"router-id,omitempty"`
+	// RouterIdPoolRef references a SrlIpPool with allocation length 32 from which
+	// the router-id is claimed when the router-id is not set
+	// +kubebuilder:validation:Optional
+	RouterIdPoolRef    *PoolReference                     `json:"router-id-pool-ref,omitempty"`
 	TrafficEngineering *NetworkinstanceTrafficEngineering `json:"traffic-engineering,omitempty"`
 	Type               *string                            `json:"type,omitempty"`
 	VxlanInterface     []*NetworkinstanceVxlanInterface   `json:"vxlan-interface,omitempty"`
@@ -291,6 +295,7 @@ type NetworkinstanceSpec struct {
 type NetworkinstanceStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -86,6 +86,7 @@ type NetworkinstanceAggregateroutesSpec struct {
 type NetworkinstanceAggregateroutesStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceAggregateroutesObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
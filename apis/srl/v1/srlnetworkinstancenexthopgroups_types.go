@@ -107,6 +107,7 @@ type NetworkinstanceNexthopgroupsSpec struct {
 type NetworkinstanceNexthopgroupsStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceNexthopgroupsObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
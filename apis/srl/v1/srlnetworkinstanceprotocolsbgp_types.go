@@ -39,18 +39,23 @@ type NetworkinstanceProtocolsBgp struct {
 	Authentication *NetworkinstanceProtocolsBgpAuthentication `json:"authentication,omitempty"`
 	// kubebuilder:validation:Minimum=1
 	// kubebuilder:validation:Maximum=4294967295
-	AutonomousSystem  *uint32                                       `json:"autonomous-system"`
-	Convergence       *NetworkinstanceProtocolsBgpConvergence       `json:"convergence,omitempty"`
-	DynamicNeighbors  *NetworkinstanceProtocolsBgpDynamicNeighbors  `json:"dynamic-neighbors,omitempty"`
-	EbgpDefaultPolicy *NetworkinstanceProtocolsBgpEbgpDefaultPolicy `json:"ebgp-default-policy,omitempty"`
-	Evpn              *NetworkinstanceProtocolsBgpEvpn              `json:"evpn,omitempty"`
-	ExportPolicy      *string                                       `json:"export-policy,omitempty"`
-	FailureDetection  *NetworkinstanceProtocolsBgpFailureDetection  `json:"failure-detection,omitempty"`
-	GracefulRestart   *NetworkinstanceProtocolsBgpGracefulRestart   `json:"graceful-restart,omitempty"`
-	Group             []*NetworkinstanceProtocolsBgpGroup           `json:"group,omitempty"`
-	ImportPolicy      *string                                       `json:"import-policy,omitempty"`
-	Ipv4Unicast       *NetworkinstanceProtocolsBgpIpv4Unicast       `json:"ipv4-unicast,omitempty"`
-	Ipv6Unicast       *NetworkinstanceProtocolsBgpIpv6Unicast       `json:"ipv6-unicast,omitempty"`
+	// +kubebuilder:validation:Optional
+	AutonomousSystem *uint32 `json:"autonomous-system,omitempty"`
+	// AutonomousSystemPoolRef references a SrlAsnPool from which the autonomous
+	// system is claimed when the autonomous-system is not set
+	// +kubebuilder:validation:Optional
+	AutonomousSystemPoolRef *PoolReference                                `json:"autonomous-system-pool-ref,omitempty"`
+	Convergence             *NetworkinstanceProtocolsBgpConvergence       `json:"convergence,omitempty"`
+	DynamicNeighbors        *NetworkinstanceProtocolsBgpDynamicNeighbors  `json:"dynamic-neighbors,omitempty"`
+	EbgpDefaultPolicy       *NetworkinstanceProtocolsBgpEbgpDefaultPolicy `json:"ebgp-default-policy,omitempty"`
+	Evpn                    *NetworkinstanceProtocolsBgpEvpn              `json:"evpn,omitempty"`
+	ExportPolicy            *string                                       `json:"export-policy,omitempty"`
+	FailureDetection        *NetworkinstanceProtocolsBgpFailureDetection  `json:"failure-detection,omitempty"`
+	GracefulRestart         *NetworkinstanceProtocolsBgpGracefulRestart   `json:"graceful-restart,omitempty"`
+	Group                   []*NetworkinstanceProtocolsBgpGroup           `json:"group,omitempty"`
+	ImportPolicy            *string                                       `json:"import-policy,omitempty"`
+	Ipv4Unicast             *NetworkinstanceProtocolsBgpIpv4Unicast       `json:"ipv4-unicast,omitempty"`
+	Ipv6Unicast             *NetworkinstanceProtocolsBgpIpv6Unicast       `json:"ipv6-unicast,omitempty"`
 	// kubebuilder:validation:Minimum=0
 	// kubebuilder:validation:Maximum=4294967295
 	LocalPreference    *uint32                                        `json:"local-preference,omitempty"`
@@ -690,6 +695,7 @@ type NetworkinstanceProtocolsBgpSpec struct {
 type NetworkinstanceProtocolsBgpStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceProtocolsBgpObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
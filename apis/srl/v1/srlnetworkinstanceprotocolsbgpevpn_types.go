@@ -112,6 +112,7 @@ type NetworkinstanceProtocolsBgpevpnSpec struct {
 type NetworkinstanceProtocolsBgpevpnStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceProtocolsBgpevpnObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
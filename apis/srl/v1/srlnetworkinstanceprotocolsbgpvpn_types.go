@@ -84,6 +84,7 @@ type NetworkinstanceProtocolsBgpvpnSpec struct {
 type NetworkinstanceProtocolsBgpvpnStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceProtocolsBgpvpnObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -398,6 +398,7 @@ type NetworkinstanceProtocolsIsisSpec struct {
 type NetworkinstanceProtocolsIsisStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceProtocolsIsisObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -58,6 +58,7 @@ type NetworkinstanceProtocolsLinuxSpec struct {
 type NetworkinstanceProtocolsLinuxStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceProtocolsLinuxObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -401,6 +401,7 @@ type NetworkinstanceProtocolsOspfSpec struct {
 type NetworkinstanceProtocolsOspfStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceProtocolsOspfObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
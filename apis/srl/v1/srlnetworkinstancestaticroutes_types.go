@@ -72,6 +72,7 @@ type NetworkinstanceStaticroutesSpec struct {
 type NetworkinstanceStaticroutesStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        NetworkinstanceStaticroutesObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// DefaultProviderConfigName is the name of the SrlProviderConfig that applies
+	// to the network nodes that do not reference a SrlProviderConfig
+	DefaultProviderConfigName = "default"
+
+	// LabelKeyProviderConfig references the SrlProviderConfig that applies to a
+	// network node when it is set on the NetworkNode, which overrides the
+	// default SrlProviderConfig.
+	LabelKeyProviderConfig = Group + "/provider-config"
+)
+
+// A DeviceDriverConnection defines how the provider connects to the device
+// drivers of the network nodes.
+type DeviceDriverConnection struct {
+	// ServicePrefix of the services of the device drivers, the service of a
+	// device driver is named <prefix>-<network node>, defaults to ndd-svc
+	ServicePrefix *string `json:"servicePrefix,omitempty"`
+
+	// ServiceDomain of the services of the device drivers, defaults to
+	// ndd-system.svc.cluster.local
+	ServiceDomain *string `json:"serviceDomain,omitempty"`
+
+	// Timeout of the gnmi connections to the device drivers, defaults to 10s
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// RetryTimer after which a failed gnmi subscription to a device driver is
+	// retried, defaults to 10s
+	RetryTimer *metav1.Duration `json:"retryTimer,omitempty"`
+
+	// BufferSize is the number of gnmi subscription responses of a device
+	// driver that are buffered, defaults to 1000
+	// +kubebuilder:validation:Minimum=1
+	BufferSize *int `json:"bufferSize,omitempty"`
+
+	// Gzip compresses the gnmi calls to the device drivers, defaults to false
+	Gzip *bool `json:"gzip,omitempty"`
+
+	// Insecure connects to the device drivers without TLS, defaults to true
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// SkipVerify skips the verification of the certificates of the device
+	// drivers, defaults to true
+	SkipVerify *bool `json:"skipVerify,omitempty"`
+
+	// CredentialsSecretRef references the secret with the username and password
+	// keys of the credentials of the device drivers, admin/admin is used when
+	// it is not set
+	CredentialsSecretRef *corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// TLSSecretRef references the secret with the ca.crt, tls.crt and tls.key
+	// keys that are used for the TLS connections to the device drivers
+	TLSSecretRef *corev1.SecretReference `json:"tlsSecretRef,omitempty"`
+}
+
+// A ProviderConfigSpec defines the desired state of a SrlProviderConfig. The
+// settings that are not set fall back to the flags of the provider.
+type ProviderConfigSpec struct {
+	// Autopilot corrects the drift of the resources without a drift policy
+	// annotation when true and reports it when false
+	Autopilot *bool `json:"autopilot,omitempty"`
+
+	// PollInterval at which the resources without a poll interval annotation
+	// are checked for drift
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// Concurrency is the number of resources a controller reconciles
+	// concurrently, it is only read from the default SrlProviderConfig when the
+	// provider starts, a change requires a restart of the provider
+	// +kubebuilder:validation:Minimum=1
+	Concurrency *int `json:"concurrency,omitempty"`
+
+	// DeviceDriver defines how the provider connects to the device drivers of
+	// the network nodes
+	DeviceDriver DeviceDriverConnection `json:"deviceDriver,omitempty"`
+}
+
+// A ProviderConfigStatus represents the observed state of a SrlProviderConfig.
+type ProviderConfigStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlProviderConfig is the Schema for the ProviderConfig API
+// A SrlProviderConfig holds the runtime settings of the provider, the settings
+// are applied without a restart of the provider. The default SrlProviderConfig
+// applies to all network nodes, a network node can reference another one with
+// the provider config label.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="AUTOPILOT",type="boolean",JSONPath=".spec.autopilot"
+// +kubebuilder:printcolumn:name="POLL",type="string",JSONPath=".spec.pollInterval"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlpc
+type SrlProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec,omitempty"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlProviderConfigList contains a list of ProviderConfigs
+type SrlProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlProviderConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlProviderConfig{}, &SrlProviderConfigList{})
+}
+
+// GetCondition of this SrlProviderConfig.
+func (mg *SrlProviderConfig) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlProviderConfig.
+func (mg *SrlProviderConfig) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// ProviderConfig type metadata.
+var (
+	ProviderConfigKind             = reflect.TypeOf(SrlProviderConfig{}).Name()
+	ProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ProviderConfigKind}.String()
+	ProviderConfigKindAPIVersion   = ProviderConfigKind + "." + GroupVersion.String()
+	ProviderConfigGroupVersionKind = GroupVersion.WithKind(ProviderConfigKind)
+)
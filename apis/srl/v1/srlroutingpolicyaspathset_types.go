@@ -62,6 +62,7 @@ type RoutingpolicyAspathsetSpec struct {
 type RoutingpolicyAspathsetStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        RoutingpolicyAspathsetObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
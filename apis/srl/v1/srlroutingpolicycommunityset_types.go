@@ -56,12 +56,19 @@ type RoutingpolicyCommunitysetObservation struct {
 type RoutingpolicyCommunitysetSpec struct {
 	nddv1.ResourceSpec `json:",inline"`
 	ForNetworkNode     RoutingpolicyCommunitysetParameters `json:"forNetworkNode"`
+	// NetworkNodeSelector selects the network nodes the resource is applied to,
+	// when set it takes precedence over the NetworkNodeReference
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
 }
 
 // A RoutingpolicyCommunitysetStatus represents the observed state of a RoutingpolicyCommunityset.
 type RoutingpolicyCommunitysetStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        RoutingpolicyCommunitysetObservation `json:"atNetworkNode,omitempty"`
+	// NetworkNodes reports the synchronization status per selected network node
+	NetworkNodes  []NetworkNodeSyncStatus `json:"networkNodes,omitempty"`
+	AppliedStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -97,6 +104,21 @@ func init() {
 	SchemeBuilder.Register(&SrlRoutingpolicyCommunityset{}, &SrlRoutingpolicyCommunitysetList{})
 }
 
+// GetNetworkNodeSelector returns the network node selector of the resource
+func (o *SrlRoutingpolicyCommunityset) GetNetworkNodeSelector() *metav1.LabelSelector {
+	return o.Spec.NetworkNodeSelector
+}
+
+// GetNetworkNodeSyncStatus returns the synchronization status per network node
+func (o *SrlRoutingpolicyCommunityset) GetNetworkNodeSyncStatus() []NetworkNodeSyncStatus {
+	return o.Status.NetworkNodes
+}
+
+// SetNetworkNodeSyncStatus sets the synchronization status per network node
+func (o *SrlRoutingpolicyCommunityset) SetNetworkNodeSyncStatus(s []NetworkNodeSyncStatus) {
+	o.Status.NetworkNodes = s
+}
+
 // RoutingpolicyCommunityset type metadata.
 var (
 	RoutingpolicyCommunitysetKind             = reflect.TypeOf(SrlRoutingpolicyCommunityset{}).Name()
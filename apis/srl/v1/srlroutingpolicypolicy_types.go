@@ -265,6 +265,7 @@ type RoutingpolicyPolicySpec struct {
 type RoutingpolicyPolicyStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        RoutingpolicyPolicyObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
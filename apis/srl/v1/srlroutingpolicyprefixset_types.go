@@ -64,12 +64,19 @@ type RoutingpolicyPrefixsetObservation struct {
 type RoutingpolicyPrefixsetSpec struct {
 	nddv1.ResourceSpec `json:",inline"`
 	ForNetworkNode     RoutingpolicyPrefixsetParameters `json:"forNetworkNode"`
+	// NetworkNodeSelector selects the network nodes the resource is applied to,
+	// when set it takes precedence over the NetworkNodeReference
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
 }
 
 // A RoutingpolicyPrefixsetStatus represents the observed state of a RoutingpolicyPrefixset.
 type RoutingpolicyPrefixsetStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        RoutingpolicyPrefixsetObservation `json:"atNetworkNode,omitempty"`
+	// NetworkNodes reports the synchronization status per selected network node
+	NetworkNodes  []NetworkNodeSyncStatus `json:"networkNodes,omitempty"`
+	AppliedStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -105,6 +112,21 @@ func init() {
 	SchemeBuilder.Register(&SrlRoutingpolicyPrefixset{}, &SrlRoutingpolicyPrefixsetList{})
 }
 
+// GetNetworkNodeSelector returns the network node selector of the resource
+func (o *SrlRoutingpolicyPrefixset) GetNetworkNodeSelector() *metav1.LabelSelector {
+	return o.Spec.NetworkNodeSelector
+}
+
+// GetNetworkNodeSyncStatus returns the synchronization status per network node
+func (o *SrlRoutingpolicyPrefixset) GetNetworkNodeSyncStatus() []NetworkNodeSyncStatus {
+	return o.Status.NetworkNodes
+}
+
+// SetNetworkNodeSyncStatus sets the synchronization status per network node
+func (o *SrlRoutingpolicyPrefixset) SetNetworkNodeSyncStatus(s []NetworkNodeSyncStatus) {
+	o.Status.NetworkNodes = s
+}
+
 // RoutingpolicyPrefixset type metadata.
 var (
 	RoutingpolicyPrefixsetKind             = reflect.TypeOf(SrlRoutingpolicyPrefixset{}).Name()
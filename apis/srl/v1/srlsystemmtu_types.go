@@ -70,6 +70,7 @@ type SystemMtuSpec struct {
 type SystemMtuStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemMtuObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
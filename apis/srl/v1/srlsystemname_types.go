@@ -58,12 +58,19 @@ type SystemNameObservation struct {
 type SystemNameSpec struct {
 	nddv1.ResourceSpec `json:",inline"`
 	ForNetworkNode     SystemNameParameters `json:"forNetworkNode"`
+	// NetworkNodeSelector selects the network nodes the resource is applied to,
+	// when set it takes precedence over the NetworkNodeReference
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
 }
 
 // A SystemNameStatus represents the observed state of a SystemName.
 type SystemNameStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemNameObservation `json:"atNetworkNode,omitempty"`
+	// NetworkNodes reports the synchronization status per selected network node
+	NetworkNodes  []NetworkNodeSyncStatus `json:"networkNodes,omitempty"`
+	AppliedStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -99,6 +106,21 @@ func init() {
 	SchemeBuilder.Register(&SrlSystemName{}, &SrlSystemNameList{})
 }
 
+// GetNetworkNodeSelector returns the network node selector of the resource
+func (o *SrlSystemName) GetNetworkNodeSelector() *metav1.LabelSelector {
+	return o.Spec.NetworkNodeSelector
+}
+
+// GetNetworkNodeSyncStatus returns the synchronization status per network node
+func (o *SrlSystemName) GetNetworkNodeSyncStatus() []NetworkNodeSyncStatus {
+	return o.Status.NetworkNodes
+}
+
+// SetNetworkNodeSyncStatus sets the synchronization status per network node
+func (o *SrlSystemName) SetNetworkNodeSyncStatus(s []NetworkNodeSyncStatus) {
+	o.Status.NetworkNodes = s
+}
+
 // SystemName type metadata.
 var (
 	SystemNameKind             = reflect.TypeOf(SrlSystemName{}).Name()
@@ -72,6 +72,7 @@ type SystemNetworkinstanceProtocolsBgpvpnSpec struct {
 type SystemNetworkinstanceProtocolsBgpvpnStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemNetworkinstanceProtocolsBgpvpnObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -70,6 +70,7 @@ type SystemNetworkinstanceProtocolsEvpnSpec struct {
 type SystemNetworkinstanceProtocolsEvpnStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemNetworkinstanceProtocolsEvpnObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
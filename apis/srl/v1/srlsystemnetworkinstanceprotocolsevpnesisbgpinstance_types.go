@@ -55,6 +55,7 @@ type SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceSpec struct {
 type SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
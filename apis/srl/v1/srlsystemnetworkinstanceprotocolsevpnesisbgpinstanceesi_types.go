@@ -129,6 +129,7 @@ type SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiSpec struct {
 type SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -61,12 +61,19 @@ type SystemNtpObservation struct {
 type SystemNtpSpec struct {
 	nddv1.ResourceSpec `json:",inline"`
 	ForNetworkNode     SystemNtpParameters `json:"forNetworkNode"`
+	// NetworkNodeSelector selects the network nodes the resource is applied to,
+	// when set it takes precedence over the NetworkNodeReference
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
 }
 
 // A SystemNtpStatus represents the observed state of a SystemNtp.
 type SystemNtpStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        SystemNtpObservation `json:"atNetworkNode,omitempty"`
+	// NetworkNodes reports the synchronization status per selected network node
+	NetworkNodes  []NetworkNodeSyncStatus `json:"networkNodes,omitempty"`
+	AppliedStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -102,6 +109,21 @@ func init() {
 	SchemeBuilder.Register(&SrlSystemNtp{}, &SrlSystemNtpList{})
 }
 
+// GetNetworkNodeSelector returns the network node selector of the resource
+func (o *SrlSystemNtp) GetNetworkNodeSelector() *metav1.LabelSelector {
+	return o.Spec.NetworkNodeSelector
+}
+
+// GetNetworkNodeSyncStatus returns the synchronization status per network node
+func (o *SrlSystemNtp) GetNetworkNodeSyncStatus() []NetworkNodeSyncStatus {
+	return o.Status.NetworkNodes
+}
+
+// SetNetworkNodeSyncStatus sets the synchronization status per network node
+func (o *SrlSystemNtp) SetNetworkNodeSyncStatus(s []NetworkNodeSyncStatus) {
+	o.Status.NetworkNodes = s
+}
+
 // SystemNtp type metadata.
 var (
 	SystemNtpKind             = reflect.TypeOf(SrlSystemNtp{}).Name()
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Telemetry sinks.
+const (
+	TelemetrySinkPrometheus = "prometheus"
+	TelemetrySinkFile       = "file"
+	TelemetrySinkStdout     = "stdout"
+)
+
+// A TelemetrySubscription is a subscription to telemetry paths of the network
+// nodes.
+type TelemetrySubscription struct {
+	// Name of the subscription, unique within the profile
+	Name string `json:"name"`
+
+	// Paths are the gnmi paths the subscription is made to, e.g.
+	// /interface[name=*]/statistics
+	// +kubebuilder:validation:MinItems=1
+	Paths []string `json:"paths"`
+
+	// Mode of the subscription
+	// +kubebuilder:validation:Enum=`on-change`;`sample`;`target-defined`
+	// +kubebuilder:default:="sample"
+	Mode *string `json:"mode,omitempty"`
+
+	// SampleInterval of sample subscriptions
+	// +kubebuilder:default:="10s"
+	SampleInterval *metav1.Duration `json:"sampleInterval,omitempty"`
+
+	// HeartbeatInterval after which a value is sent when it did not change
+	HeartbeatInterval *metav1.Duration `json:"heartbeatInterval,omitempty"`
+
+	// SuppressRedundant suppresses the samples of values that did not change
+	SuppressRedundant *bool `json:"suppressRedundant,omitempty"`
+
+	// Encoding of the subscription
+	// +kubebuilder:validation:Enum=`json`;`bytes`;`proto`;`ascii`;`json_ietf`
+	// +kubebuilder:default:="json_ietf"
+	Encoding *string `json:"encoding,omitempty"`
+}
+
+// A TelemetryExport defines the sink the received updates are exported to.
+type TelemetryExport struct {
+	// Sink the updates are exported to, prometheus exposes the numeric values as
+	// metrics on the metrics endpoint of the provider, file and stdout write the
+	// updates as JSON lines
+	// +kubebuilder:validation:Enum=`prometheus`;`file`;`stdout`
+	// +kubebuilder:default:="prometheus"
+	Sink string `json:"sink,omitempty"`
+
+	// Path of the file the updates are written to when the sink is file
+	Path *string `json:"path,omitempty"`
+}
+
+// A TelemetryProfileSpec defines the desired state of a SrlTelemetryProfile.
+type TelemetryProfileSpec struct {
+	// NetworkNodeSelector selects the network nodes the subscriptions are made
+	// to, all network nodes are selected when it is not set
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
+
+	// Subscriptions of the profile
+	// +kubebuilder:validation:MinItems=1
+	Subscriptions []TelemetrySubscription `json:"subscriptions"`
+
+	// Export defines the sink the received updates are exported to
+	// +kubebuilder:default:={"sink":"prometheus"}
+	Export TelemetryExport `json:"export,omitempty"`
+}
+
+// A TelemetryProfileStatus represents the observed state of a SrlTelemetryProfile.
+type TelemetryProfileStatus struct {
+	nddv1.ConditionedStatus `json:",inline"`
+
+	// NetworkNodes the subscriptions are made to
+	NetworkNodes []string `json:"networkNodes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlTelemetryProfile is the Schema for the TelemetryProfile API
+// A SrlTelemetryProfile subscribes to telemetry paths of the selected network
+// nodes and exports the received updates
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SINK",type="string",JSONPath=".spec.export.sink"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srltelemetry
+type SrlTelemetryProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TelemetryProfileSpec   `json:"spec"`
+	Status TelemetryProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlTelemetryProfileList contains a list of TelemetryProfiles
+type SrlTelemetryProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlTelemetryProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlTelemetryProfile{}, &SrlTelemetryProfileList{})
+}
+
+// GetCondition of this SrlTelemetryProfile.
+func (mg *SrlTelemetryProfile) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlTelemetryProfile.
+func (mg *SrlTelemetryProfile) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// TelemetryProfile type metadata.
+var (
+	TelemetryProfileKind             = reflect.TypeOf(SrlTelemetryProfile{}).Name()
+	TelemetryProfileGroupKind        = schema.GroupKind{Group: Group, Kind: TelemetryProfileKind}.String()
+	TelemetryProfileKindAPIVersion   = TelemetryProfileKind + "." + GroupVersion.String()
+	TelemetryProfileGroupVersionKind = GroupVersion.WithKind(TelemetryProfileKind)
+)
@@ -59,6 +59,7 @@ type TunnelinterfaceSpec struct {
 type TunnelinterfaceStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        TunnelinterfaceObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -125,6 +125,7 @@ type TunnelinterfaceVxlaninterfaceSpec struct {
 type TunnelinterfaceVxlaninterfaceStatus struct {
 	nddv1.ResourceStatus `json:",inline"`
 	AtNetworkNode        TunnelinterfaceVxlaninterfaceObservation `json:"atNetworkNode,omitempty"`
+	AppliedStatus        `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A VniPoolSpec defines the desired state of a SrlVniPool.
+type VniPoolSpec struct {
+	// Start is the first VXLAN network identifier of the pool
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16777215
+	Start uint32 `json:"start"`
+
+	// End is the last VXLAN network identifier of the pool
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16777215
+	End uint32 `json:"end"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlVniPool is the Schema for the VniPool API
+// A SrlVniPool allocates VXLAN network identifiers from a range
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="START",type="integer",JSONPath=".spec.start"
+// +kubebuilder:printcolumn:name="END",type="integer",JSONPath=".spec.end"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl},shortName=srlvnipool
+type SrlVniPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VniPoolSpec `json:"spec"`
+	Status PoolStatus  `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrlVniPoolList contains a list of VniPools
+type SrlVniPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrlVniPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrlVniPool{}, &SrlVniPoolList{})
+}
+
+// GetCondition of this SrlVniPool.
+func (mg *SrlVniPool) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// SetConditions of this SrlVniPool.
+func (mg *SrlVniPool) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// GetAllocations returns the values allocated from the pool
+func (mg *SrlVniPool) GetAllocations() []PoolAllocation {
+	return mg.Status.Allocations
+}
+
+// SetAllocations sets the values allocated from the pool
+func (mg *SrlVniPool) SetAllocations(a []PoolAllocation) {
+	mg.Status.Allocations = a
+}
+
+// VniPool type metadata.
+var (
+	VniPoolKind             = reflect.TypeOf(SrlVniPool{}).Name()
+	VniPoolGroupKind        = schema.GroupKind{Group: Group, Kind: VniPoolKind}.String()
+	VniPoolKindAPIVersion   = VniPoolKind + "." + GroupVersion.String()
+	VniPoolGroupVersionKind = GroupVersion.WithKind(VniPoolKind)
+)
@@ -21,9 +21,41 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedStatus) DeepCopyInto(out *AppliedStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedStatus.
+func (in *AppliedStatus) DeepCopy() *AppliedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsnPoolSpec) DeepCopyInto(out *AsnPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsnPoolSpec.
+func (in *AsnPoolSpec) DeepCopy() *AsnPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AsnPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bfd) DeepCopyInto(out *Bfd) {
 	*out = *in
@@ -188,6 +220,7 @@ func (in *BfdStatus) DeepCopyInto(out *BfdStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BfdStatus.
@@ -246,1647 +279,1505 @@ func (in *BfdSubinterface) DeepCopy() *BfdSubinterface {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Interface) DeepCopyInto(out *Interface) {
+func (in *ChangeRecordResource) DeepCopyInto(out *ChangeRecordResource) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ethernet != nil {
-		in, out := &in.Ethernet, &out.Ethernet
-		*out = new(InterfaceEthernet)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Lag != nil {
-		in, out := &in.Lag, &out.Lag
-		*out = new(InterfaceLag)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LoopbackMode != nil {
-		in, out := &in.LoopbackMode, &out.LoopbackMode
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.Qos != nil {
-		in, out := &in.Qos, &out.Qos
-		*out = new(InterfaceQos)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Sflow != nil {
-		in, out := &in.Sflow, &out.Sflow
-		*out = new(InterfaceSflow)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Transceiver != nil {
-		in, out := &in.Transceiver, &out.Transceiver
-		*out = new(InterfaceTransceiver)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.VlanTagging != nil {
-		in, out := &in.VlanTagging, &out.VlanTagging
-		*out = new(bool)
-		**out = **in
+	if in.ManagedTime != nil {
+		in, out := &in.ManagedTime, &out.ManagedTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
-func (in *Interface) DeepCopy() *Interface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRecordResource.
+func (in *ChangeRecordResource) DeepCopy() *ChangeRecordResource {
 	if in == nil {
 		return nil
 	}
-	out := new(Interface)
+	out := new(ChangeRecordResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceEthernet) DeepCopyInto(out *InterfaceEthernet) {
+func (in *ChangeRecordSpec) DeepCopyInto(out *ChangeRecordSpec) {
 	*out = *in
-	if in.AggregateId != nil {
-		in, out := &in.AggregateId, &out.AggregateId
-		*out = new(string)
-		**out = **in
-	}
-	if in.AutoNegotiate != nil {
-		in, out := &in.AutoNegotiate, &out.AutoNegotiate
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DuplexMode != nil {
-		in, out := &in.DuplexMode, &out.DuplexMode
-		*out = new(string)
-		**out = **in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.Updates != nil {
+		in, out := &in.Updates, &out.Updates
+		*out = make([]ChangeRecordUpdate, len(*in))
+		copy(*out, *in)
 	}
-	if in.FlowControl != nil {
-		in, out := &in.FlowControl, &out.FlowControl
-		*out = new(InterfaceEthernetFlowControl)
-		(*in).DeepCopyInto(*out)
+	if in.Replaces != nil {
+		in, out := &in.Replaces, &out.Replaces
+		*out = make([]ChangeRecordUpdate, len(*in))
+		copy(*out, *in)
 	}
-	if in.LacpPortPriority != nil {
-		in, out := &in.LacpPortPriority, &out.LacpPortPriority
-		*out = new(uint16)
-		**out = **in
+	if in.Deletes != nil {
+		in, out := &in.Deletes, &out.Deletes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.PortSpeed != nil {
-		in, out := &in.PortSpeed, &out.PortSpeed
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRecordSpec.
+func (in *ChangeRecordSpec) DeepCopy() *ChangeRecordSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ChangeRecordSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceEthernet.
-func (in *InterfaceEthernet) DeepCopy() *InterfaceEthernet {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeRecordUpdate) DeepCopyInto(out *ChangeRecordUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRecordUpdate.
+func (in *ChangeRecordUpdate) DeepCopy() *ChangeRecordUpdate {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceEthernet)
+	out := new(ChangeRecordUpdate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceEthernetFlowControl) DeepCopyInto(out *InterfaceEthernetFlowControl) {
+func (in *ChildResourceStatus) DeepCopyInto(out *ChildResourceStatus) {
 	*out = *in
-	if in.Receive != nil {
-		in, out := &in.Receive, &out.Receive
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildResourceStatus.
+func (in *ChildResourceStatus) DeepCopy() *ChildResourceStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(bool)
-		**out = **in
+	out := new(ChildResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
 	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceEthernetFlowControl.
-func (in *InterfaceEthernetFlowControl) DeepCopy() *InterfaceEthernetFlowControl {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTemplateChild) DeepCopyInto(out *ConfigTemplateChild) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTemplateChild.
+func (in *ConfigTemplateChild) DeepCopy() *ConfigTemplateChild {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceEthernetFlowControl)
+	out := new(ConfigTemplateChild)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceLag) DeepCopyInto(out *InterfaceLag) {
+func (in *ConfigTemplateSpec) DeepCopyInto(out *ConfigTemplateSpec) {
 	*out = *in
-	if in.Lacp != nil {
-		in, out := &in.Lacp, &out.Lacp
-		*out = new(InterfaceLagLacp)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LacpFallbackMode != nil {
-		in, out := &in.LacpFallbackMode, &out.LacpFallbackMode
-		*out = new(string)
-		**out = **in
-	}
-	if in.LacpFallbackTimeout != nil {
-		in, out := &in.LacpFallbackTimeout, &out.LacpFallbackTimeout
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.LagType != nil {
-		in, out := &in.LagType, &out.LagType
-		*out = new(string)
+	if in.VariablesConfigMapRef != nil {
+		in, out := &in.VariablesConfigMapRef, &out.VariablesConfigMapRef
+		*out = new(ConfigMapReference)
 		**out = **in
 	}
-	if in.MemberSpeed != nil {
-		in, out := &in.MemberSpeed, &out.MemberSpeed
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTemplateSpec.
+func (in *ConfigTemplateSpec) DeepCopy() *ConfigTemplateSpec {
+	if in == nil {
+		return nil
 	}
-	if in.MinLinks != nil {
-		in, out := &in.MinLinks, &out.MinLinks
-		*out = new(uint16)
-		**out = **in
+	out := new(ConfigTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTemplateStatus) DeepCopyInto(out *ConfigTemplateStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ConfigTemplateChild, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceLag.
-func (in *InterfaceLag) DeepCopy() *InterfaceLag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTemplateStatus.
+func (in *ConfigTemplateStatus) DeepCopy() *ConfigTemplateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceLag)
+	out := new(ConfigTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceLagLacp) DeepCopyInto(out *InterfaceLagLacp) {
+func (in *DeviceDriverConnection) DeepCopyInto(out *DeviceDriverConnection) {
 	*out = *in
-	if in.AdminKey != nil {
-		in, out := &in.AdminKey, &out.AdminKey
-		*out = new(uint16)
+	if in.ServicePrefix != nil {
+		in, out := &in.ServicePrefix, &out.ServicePrefix
+		*out = new(string)
 		**out = **in
 	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
+	if in.ServiceDomain != nil {
+		in, out := &in.ServiceDomain, &out.ServiceDomain
 		*out = new(string)
 		**out = **in
 	}
-	if in.LacpMode != nil {
-		in, out := &in.LacpMode, &out.LacpMode
-		*out = new(string)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.SystemIdMac != nil {
-		in, out := &in.SystemIdMac, &out.SystemIdMac
-		*out = new(string)
+	if in.RetryTimer != nil {
+		in, out := &in.RetryTimer, &out.RetryTimer
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.SystemPriority != nil {
-		in, out := &in.SystemPriority, &out.SystemPriority
-		*out = new(uint16)
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Gzip != nil {
+		in, out := &in.Gzip, &out.Gzip
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.TLSSecretRef != nil {
+		in, out := &in.TLSSecretRef, &out.TLSSecretRef
+		*out = new(corev1.SecretReference)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceLagLacp.
-func (in *InterfaceLagLacp) DeepCopy() *InterfaceLagLacp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceDriverConnection.
+func (in *DeviceDriverConnection) DeepCopy() *DeviceDriverConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceLagLacp)
+	out := new(DeviceDriverConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceObservation) DeepCopyInto(out *InterfaceObservation) {
+func (in *EvpnL2ServiceSpec) DeepCopyInto(out *EvpnL2ServiceSpec) {
 	*out = *in
+	if in.VniPoolRef != nil {
+		in, out := &in.VniPoolRef, &out.VniPoolRef
+		*out = new(PoolReference)
+		**out = **in
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]EvpnServiceAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceObservation.
-func (in *InterfaceObservation) DeepCopy() *InterfaceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnL2ServiceSpec.
+func (in *EvpnL2ServiceSpec) DeepCopy() *EvpnL2ServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceObservation)
+	out := new(EvpnL2ServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceParameters) DeepCopyInto(out *InterfaceParameters) {
+func (in *EvpnL2ServiceStatus) DeepCopyInto(out *EvpnL2ServiceStatus) {
 	*out = *in
-	if in.SrlInterface != nil {
-		in, out := &in.SrlInterface, &out.SrlInterface
-		*out = new(Interface)
-		(*in).DeepCopyInto(*out)
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildResourceStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceParameters.
-func (in *InterfaceParameters) DeepCopy() *InterfaceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnL2ServiceStatus.
+func (in *EvpnL2ServiceStatus) DeepCopy() *EvpnL2ServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceParameters)
+	out := new(EvpnL2ServiceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQos) DeepCopyInto(out *InterfaceQos) {
+func (in *EvpnL3ServiceMacVrf) DeepCopyInto(out *EvpnL3ServiceMacVrf) {
 	*out = *in
-	if in.Output != nil {
-		in, out := &in.Output, &out.Output
-		*out = new(InterfaceQosOutput)
-		(*in).DeepCopyInto(*out)
+	if in.Ipv4Prefixes != nil {
+		in, out := &in.Ipv4Prefixes, &out.Ipv4Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ipv6Prefixes != nil {
+		in, out := &in.Ipv6Prefixes, &out.Ipv6Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQos.
-func (in *InterfaceQos) DeepCopy() *InterfaceQos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnL3ServiceMacVrf.
+func (in *EvpnL3ServiceMacVrf) DeepCopy() *EvpnL3ServiceMacVrf {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQos)
+	out := new(EvpnL3ServiceMacVrf)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutput) DeepCopyInto(out *InterfaceQosOutput) {
+func (in *EvpnL3ServiceSpec) DeepCopyInto(out *EvpnL3ServiceSpec) {
 	*out = *in
-	if in.MulticastQueue != nil {
-		in, out := &in.MulticastQueue, &out.MulticastQueue
-		*out = make([]*InterfaceQosOutputMulticastQueue, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceQosOutputMulticastQueue)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = new(InterfaceQosOutputScheduler)
-		(*in).DeepCopyInto(*out)
+	if in.VniPoolRef != nil {
+		in, out := &in.VniPoolRef, &out.VniPoolRef
+		*out = new(PoolReference)
+		**out = **in
 	}
-	if in.UnicastQueue != nil {
-		in, out := &in.UnicastQueue, &out.UnicastQueue
-		*out = make([]*InterfaceQosOutputUnicastQueue, len(*in))
+	if in.MacVrfs != nil {
+		in, out := &in.MacVrfs, &out.MacVrfs
+		*out = make([]EvpnL3ServiceMacVrf, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceQosOutputUnicastQueue)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutput.
-func (in *InterfaceQosOutput) DeepCopy() *InterfaceQosOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnL3ServiceSpec.
+func (in *EvpnL3ServiceSpec) DeepCopy() *EvpnL3ServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutput)
+	out := new(EvpnL3ServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputMulticastQueue) DeepCopyInto(out *InterfaceQosOutputMulticastQueue) {
+func (in *EvpnL3ServiceStatus) DeepCopyInto(out *EvpnL3ServiceStatus) {
 	*out = *in
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Scheduling != nil {
-		in, out := &in.Scheduling, &out.Scheduling
-		*out = new(InterfaceQosOutputMulticastQueueScheduling)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(string)
-		**out = **in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildResourceStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputMulticastQueue.
-func (in *InterfaceQosOutputMulticastQueue) DeepCopy() *InterfaceQosOutputMulticastQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnL3ServiceStatus.
+func (in *EvpnL3ServiceStatus) DeepCopy() *EvpnL3ServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputMulticastQueue)
+	out := new(EvpnL3ServiceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputMulticastQueueScheduling) DeepCopyInto(out *InterfaceQosOutputMulticastQueueScheduling) {
+func (in *EvpnServiceAttachment) DeepCopyInto(out *EvpnServiceAttachment) {
 	*out = *in
-	if in.PeakRatePercent != nil {
-		in, out := &in.PeakRatePercent, &out.PeakRatePercent
-		*out = new(uint8)
+	if in.VlanID != nil {
+		in, out := &in.VlanID, &out.VlanID
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputMulticastQueueScheduling.
-func (in *InterfaceQosOutputMulticastQueueScheduling) DeepCopy() *InterfaceQosOutputMulticastQueueScheduling {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvpnServiceAttachment.
+func (in *EvpnServiceAttachment) DeepCopy() *EvpnServiceAttachment {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputMulticastQueueScheduling)
+	out := new(EvpnServiceAttachment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputScheduler) DeepCopyInto(out *InterfaceQosOutputScheduler) {
+func (in *FabricAutonomousSystemPool) DeepCopyInto(out *FabricAutonomousSystemPool) {
 	*out = *in
-	if in.Tier != nil {
-		in, out := &in.Tier, &out.Tier
-		*out = make([]*InterfaceQosOutputSchedulerTier, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceQosOutputSchedulerTier)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputScheduler.
-func (in *InterfaceQosOutputScheduler) DeepCopy() *InterfaceQosOutputScheduler {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricAutonomousSystemPool.
+func (in *FabricAutonomousSystemPool) DeepCopy() *FabricAutonomousSystemPool {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputScheduler)
+	out := new(FabricAutonomousSystemPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputSchedulerTier) DeepCopyInto(out *InterfaceQosOutputSchedulerTier) {
+func (in *FabricLink) DeepCopyInto(out *FabricLink) {
 	*out = *in
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Node != nil {
-		in, out := &in.Node, &out.Node
-		*out = make([]*InterfaceQosOutputSchedulerTierNode, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceQosOutputSchedulerTierNode)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	out.A = in.A
+	out.B = in.B
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputSchedulerTier.
-func (in *InterfaceQosOutputSchedulerTier) DeepCopy() *InterfaceQosOutputSchedulerTier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricLink.
+func (in *FabricLink) DeepCopy() *FabricLink {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputSchedulerTier)
+	out := new(FabricLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputSchedulerTierNode) DeepCopyInto(out *InterfaceQosOutputSchedulerTierNode) {
+func (in *FabricLinkEndpoint) DeepCopyInto(out *FabricLinkEndpoint) {
 	*out = *in
-	if in.NodeNumber != nil {
-		in, out := &in.NodeNumber, &out.NodeNumber
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.StrictPriority != nil {
-		in, out := &in.StrictPriority, &out.StrictPriority
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
-		*out = new(uint8)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputSchedulerTierNode.
-func (in *InterfaceQosOutputSchedulerTierNode) DeepCopy() *InterfaceQosOutputSchedulerTierNode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricLinkEndpoint.
+func (in *FabricLinkEndpoint) DeepCopy() *FabricLinkEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputSchedulerTierNode)
+	out := new(FabricLinkEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputUnicastQueue) DeepCopyInto(out *InterfaceQosOutputUnicastQueue) {
+func (in *FabricNode) DeepCopyInto(out *FabricNode) {
 	*out = *in
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Scheduling != nil {
-		in, out := &in.Scheduling, &out.Scheduling
-		*out = new(InterfaceQosOutputUnicastQueueScheduling)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(string)
-		**out = **in
-	}
-	if in.VoqTemplate != nil {
-		in, out := &in.VoqTemplate, &out.VoqTemplate
-		*out = new(string)
+	if in.AutonomousSystem != nil {
+		in, out := &in.AutonomousSystem, &out.AutonomousSystem
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputUnicastQueue.
-func (in *InterfaceQosOutputUnicastQueue) DeepCopy() *InterfaceQosOutputUnicastQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNode.
+func (in *FabricNode) DeepCopy() *FabricNode {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputUnicastQueue)
+	out := new(FabricNode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceQosOutputUnicastQueueScheduling) DeepCopyInto(out *InterfaceQosOutputUnicastQueueScheduling) {
+func (in *FabricNodeStatus) DeepCopyInto(out *FabricNodeStatus) {
 	*out = *in
-	if in.PeakRatePercent != nil {
-		in, out := &in.PeakRatePercent, &out.PeakRatePercent
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.StrictPriority != nil {
-		in, out := &in.StrictPriority, &out.StrictPriority
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
-		*out = new(uint8)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputUnicastQueueScheduling.
-func (in *InterfaceQosOutputUnicastQueueScheduling) DeepCopy() *InterfaceQosOutputUnicastQueueScheduling {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNodeStatus.
+func (in *FabricNodeStatus) DeepCopy() *FabricNodeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceQosOutputUnicastQueueScheduling)
+	out := new(FabricNodeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSflow) DeepCopyInto(out *InterfaceSflow) {
+func (in *FabricSpec) DeepCopyInto(out *FabricSpec) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]FabricNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]FabricLink, len(*in))
+		copy(*out, *in)
+	}
+	if in.LinkPoolRef != nil {
+		in, out := &in.LinkPoolRef, &out.LinkPoolRef
+		*out = new(PoolReference)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSflow.
-func (in *InterfaceSflow) DeepCopy() *InterfaceSflow {
-	if in == nil {
-		return nil
+	if in.LoopbackPoolRef != nil {
+		in, out := &in.LoopbackPoolRef, &out.LoopbackPoolRef
+		*out = new(PoolReference)
+		**out = **in
+	}
+	if in.AutonomousSystemPool != nil {
+		in, out := &in.AutonomousSystemPool, &out.AutonomousSystemPool
+		*out = new(FabricAutonomousSystemPool)
+		**out = **in
+	}
+	if in.AutonomousSystemPoolRef != nil {
+		in, out := &in.AutonomousSystemPoolRef, &out.AutonomousSystemPoolRef
+		*out = new(PoolReference)
+		**out = **in
 	}
-	out := new(InterfaceSflow)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSpec) DeepCopyInto(out *InterfaceSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSpec.
-func (in *InterfaceSpec) DeepCopy() *InterfaceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricSpec.
+func (in *FabricSpec) DeepCopy() *FabricSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSpec)
+	out := new(FabricSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceStatus) DeepCopyInto(out *InterfaceStatus) {
+func (in *FabricStatus) DeepCopyInto(out *FabricStatus) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]FabricNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildResourceStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceStatus.
-func (in *InterfaceStatus) DeepCopy() *InterfaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricStatus.
+func (in *FabricStatus) DeepCopy() *FabricStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceStatus)
+	out := new(FabricStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterface) DeepCopyInto(out *InterfaceSubinterface) {
+func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
-	if in.Acl != nil {
-		in, out := &in.Acl, &out.Acl
-		*out = new(InterfaceSubinterfaceAcl)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.AnycastGw != nil {
-		in, out := &in.AnycastGw, &out.AnycastGw
-		*out = new(InterfaceSubinterfaceAnycastGw)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BridgeTable != nil {
-		in, out := &in.BridgeTable, &out.BridgeTable
-		*out = new(InterfaceSubinterfaceBridgeTable)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Index != nil {
-		in, out := &in.Index, &out.Index
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.IpMtu != nil {
-		in, out := &in.IpMtu, &out.IpMtu
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.Ipv4 != nil {
-		in, out := &in.Ipv4, &out.Ipv4
-		*out = new(InterfaceSubinterfaceIpv4)
+	if in.Ethernet != nil {
+		in, out := &in.Ethernet, &out.Ethernet
+		*out = new(InterfaceEthernet)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Ipv6 != nil {
-		in, out := &in.Ipv6, &out.Ipv6
-		*out = new(InterfaceSubinterfaceIpv6)
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(InterfaceLag)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.L2Mtu != nil {
-		in, out := &in.L2Mtu, &out.L2Mtu
+	if in.LoopbackMode != nil {
+		in, out := &in.LoopbackMode, &out.LoopbackMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
 		*out = new(uint16)
 		**out = **in
 	}
-	if in.LocalMirrorDestination != nil {
-		in, out := &in.LocalMirrorDestination, &out.LocalMirrorDestination
-		*out = new(InterfaceSubinterfaceLocalMirrorDestination)
-		(*in).DeepCopyInto(*out)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
 	if in.Qos != nil {
 		in, out := &in.Qos, &out.Qos
-		*out = new(InterfaceSubinterfaceQos)
+		*out = new(InterfaceQos)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
-	if in.Vlan != nil {
-		in, out := &in.Vlan, &out.Vlan
-		*out = new(InterfaceSubinterfaceVlan)
+	if in.Sflow != nil {
+		in, out := &in.Sflow, &out.Sflow
+		*out = new(InterfaceSflow)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterface.
-func (in *InterfaceSubinterface) DeepCopy() *InterfaceSubinterface {
-	if in == nil {
-		return nil
-	}
-	out := new(InterfaceSubinterface)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceAcl) DeepCopyInto(out *InterfaceSubinterfaceAcl) {
-	*out = *in
-	if in.Input != nil {
-		in, out := &in.Input, &out.Input
-		*out = new(InterfaceSubinterfaceAclInput)
+	if in.Transceiver != nil {
+		in, out := &in.Transceiver, &out.Transceiver
+		*out = new(InterfaceTransceiver)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Output != nil {
-		in, out := &in.Output, &out.Output
-		*out = new(InterfaceSubinterfaceAclOutput)
-		(*in).DeepCopyInto(*out)
+	if in.VlanTagging != nil {
+		in, out := &in.VlanTagging, &out.VlanTagging
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAcl.
-func (in *InterfaceSubinterfaceAcl) DeepCopy() *InterfaceSubinterfaceAcl {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
+func (in *Interface) DeepCopy() *Interface {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceAcl)
+	out := new(Interface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceAclInput) DeepCopyInto(out *InterfaceSubinterfaceAclInput) {
+func (in *InterfaceEthernet) DeepCopyInto(out *InterfaceEthernet) {
 	*out = *in
-	if in.Ipv4Filter != nil {
-		in, out := &in.Ipv4Filter, &out.Ipv4Filter
+	if in.AggregateId != nil {
+		in, out := &in.AggregateId, &out.AggregateId
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ipv6Filter != nil {
-		in, out := &in.Ipv6Filter, &out.Ipv6Filter
+	if in.AutoNegotiate != nil {
+		in, out := &in.AutoNegotiate, &out.AutoNegotiate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DuplexMode != nil {
+		in, out := &in.DuplexMode, &out.DuplexMode
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAclInput.
-func (in *InterfaceSubinterfaceAclInput) DeepCopy() *InterfaceSubinterfaceAclInput {
-	if in == nil {
-		return nil
+	if in.FlowControl != nil {
+		in, out := &in.FlowControl, &out.FlowControl
+		*out = new(InterfaceEthernetFlowControl)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(InterfaceSubinterfaceAclInput)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceAclOutput) DeepCopyInto(out *InterfaceSubinterfaceAclOutput) {
-	*out = *in
-	if in.Ipv4Filter != nil {
-		in, out := &in.Ipv4Filter, &out.Ipv4Filter
-		*out = new(string)
+	if in.LacpPortPriority != nil {
+		in, out := &in.LacpPortPriority, &out.LacpPortPriority
+		*out = new(uint16)
 		**out = **in
 	}
-	if in.Ipv6Filter != nil {
-		in, out := &in.Ipv6Filter, &out.Ipv6Filter
+	if in.PortSpeed != nil {
+		in, out := &in.PortSpeed, &out.PortSpeed
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAclOutput.
-func (in *InterfaceSubinterfaceAclOutput) DeepCopy() *InterfaceSubinterfaceAclOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceEthernet.
+func (in *InterfaceEthernet) DeepCopy() *InterfaceEthernet {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceAclOutput)
+	out := new(InterfaceEthernet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceAnycastGw) DeepCopyInto(out *InterfaceSubinterfaceAnycastGw) {
+func (in *InterfaceEthernetFlowControl) DeepCopyInto(out *InterfaceEthernetFlowControl) {
 	*out = *in
-	if in.AnycastGwMac != nil {
-		in, out := &in.AnycastGwMac, &out.AnycastGwMac
-		*out = new(string)
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(bool)
 		**out = **in
 	}
-	if in.VirtualRouterId != nil {
-		in, out := &in.VirtualRouterId, &out.VirtualRouterId
-		*out = new(uint8)
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAnycastGw.
-func (in *InterfaceSubinterfaceAnycastGw) DeepCopy() *InterfaceSubinterfaceAnycastGw {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceEthernetFlowControl.
+func (in *InterfaceEthernetFlowControl) DeepCopy() *InterfaceEthernetFlowControl {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceAnycastGw)
+	out := new(InterfaceEthernetFlowControl)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceBridgeTable) DeepCopyInto(out *InterfaceSubinterfaceBridgeTable) {
+func (in *InterfaceLag) DeepCopyInto(out *InterfaceLag) {
 	*out = *in
-	if in.DiscardUnknownSrcMac != nil {
-		in, out := &in.DiscardUnknownSrcMac, &out.DiscardUnknownSrcMac
-		*out = new(bool)
+	if in.Lacp != nil {
+		in, out := &in.Lacp, &out.Lacp
+		*out = new(InterfaceLagLacp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LacpFallbackMode != nil {
+		in, out := &in.LacpFallbackMode, &out.LacpFallbackMode
+		*out = new(string)
 		**out = **in
 	}
-	if in.MacDuplication != nil {
-		in, out := &in.MacDuplication, &out.MacDuplication
-		*out = new(InterfaceSubinterfaceBridgeTableMacDuplication)
-		(*in).DeepCopyInto(*out)
+	if in.LacpFallbackTimeout != nil {
+		in, out := &in.LacpFallbackTimeout, &out.LacpFallbackTimeout
+		*out = new(uint16)
+		**out = **in
 	}
-	if in.MacLearning != nil {
-		in, out := &in.MacLearning, &out.MacLearning
-		*out = new(InterfaceSubinterfaceBridgeTableMacLearning)
-		(*in).DeepCopyInto(*out)
+	if in.LagType != nil {
+		in, out := &in.LagType, &out.LagType
+		*out = new(string)
+		**out = **in
 	}
-	if in.MacLimit != nil {
-		in, out := &in.MacLimit, &out.MacLimit
-		*out = new(InterfaceSubinterfaceBridgeTableMacLimit)
-		(*in).DeepCopyInto(*out)
+	if in.MemberSpeed != nil {
+		in, out := &in.MemberSpeed, &out.MemberSpeed
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinLinks != nil {
+		in, out := &in.MinLinks, &out.MinLinks
+		*out = new(uint16)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTable.
-func (in *InterfaceSubinterfaceBridgeTable) DeepCopy() *InterfaceSubinterfaceBridgeTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceLag.
+func (in *InterfaceLag) DeepCopy() *InterfaceLag {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceBridgeTable)
+	out := new(InterfaceLag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceBridgeTableMacDuplication) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacDuplication) {
+func (in *InterfaceLagLacp) DeepCopyInto(out *InterfaceLagLacp) {
 	*out = *in
-	if in.Action != nil {
-		in, out := &in.Action, &out.Action
+	if in.AdminKey != nil {
+		in, out := &in.AdminKey, &out.AdminKey
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
+	}
+	if in.LacpMode != nil {
+		in, out := &in.LacpMode, &out.LacpMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SystemIdMac != nil {
+		in, out := &in.SystemIdMac, &out.SystemIdMac
 		*out = new(string)
 		**out = **in
 	}
+	if in.SystemPriority != nil {
+		in, out := &in.SystemPriority, &out.SystemPriority
+		*out = new(uint16)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacDuplication.
-func (in *InterfaceSubinterfaceBridgeTableMacDuplication) DeepCopy() *InterfaceSubinterfaceBridgeTableMacDuplication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceLagLacp.
+func (in *InterfaceLagLacp) DeepCopy() *InterfaceLagLacp {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceBridgeTableMacDuplication)
+	out := new(InterfaceLagLacp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceBridgeTableMacLearning) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacLearning) {
+func (in *InterfaceObservation) DeepCopyInto(out *InterfaceObservation) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Aging != nil {
-		in, out := &in.Aging, &out.Aging
-		*out = new(InterfaceSubinterfaceBridgeTableMacLearningAging)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacLearning.
-func (in *InterfaceSubinterfaceBridgeTableMacLearning) DeepCopy() *InterfaceSubinterfaceBridgeTableMacLearning {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceObservation.
+func (in *InterfaceObservation) DeepCopy() *InterfaceObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceBridgeTableMacLearning)
+	out := new(InterfaceObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceBridgeTableMacLearningAging) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacLearningAging) {
+func (in *InterfaceParameters) DeepCopyInto(out *InterfaceParameters) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
+	if in.SrlInterface != nil {
+		in, out := &in.SrlInterface, &out.SrlInterface
+		*out = new(Interface)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacLearningAging.
-func (in *InterfaceSubinterfaceBridgeTableMacLearningAging) DeepCopy() *InterfaceSubinterfaceBridgeTableMacLearningAging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceParameters.
+func (in *InterfaceParameters) DeepCopy() *InterfaceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceBridgeTableMacLearningAging)
+	out := new(InterfaceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceBridgeTableMacLimit) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacLimit) {
+func (in *InterfaceQos) DeepCopyInto(out *InterfaceQos) {
 	*out = *in
-	if in.MaximumEntries != nil {
-		in, out := &in.MaximumEntries, &out.MaximumEntries
-		*out = new(int32)
-		**out = **in
-	}
-	if in.WarningThresholdPct != nil {
-		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
-		*out = new(int32)
-		**out = **in
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(InterfaceQosOutput)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacLimit.
-func (in *InterfaceSubinterfaceBridgeTableMacLimit) DeepCopy() *InterfaceSubinterfaceBridgeTableMacLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQos.
+func (in *InterfaceQos) DeepCopy() *InterfaceQos {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceBridgeTableMacLimit)
+	out := new(InterfaceQos)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4) DeepCopyInto(out *InterfaceSubinterfaceIpv4) {
+func (in *InterfaceQosOutput) DeepCopyInto(out *InterfaceQosOutput) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = make([]*InterfaceSubinterfaceIpv4Address, len(*in))
+	if in.MulticastQueue != nil {
+		in, out := &in.MulticastQueue, &out.MulticastQueue
+		*out = make([]*InterfaceQosOutputMulticastQueue, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv4Address)
+				*out = new(InterfaceQosOutputMulticastQueue)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.AllowDirectedBroadcast != nil {
-		in, out := &in.AllowDirectedBroadcast, &out.AllowDirectedBroadcast
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Arp != nil {
-		in, out := &in.Arp, &out.Arp
-		*out = new(InterfaceSubinterfaceIpv4Arp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DhcpClient != nil {
-		in, out := &in.DhcpClient, &out.DhcpClient
-		*out = new(InterfaceSubinterfaceIpv4DhcpClient)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DhcpRelay != nil {
-		in, out := &in.DhcpRelay, &out.DhcpRelay
-		*out = new(InterfaceSubinterfaceIpv4DhcpRelay)
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(InterfaceQosOutputScheduler)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Vrrp != nil {
-		in, out := &in.Vrrp, &out.Vrrp
-		*out = new(InterfaceSubinterfaceIpv4Vrrp)
-		(*in).DeepCopyInto(*out)
+	if in.UnicastQueue != nil {
+		in, out := &in.UnicastQueue, &out.UnicastQueue
+		*out = make([]*InterfaceQosOutputUnicastQueue, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceQosOutputUnicastQueue)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4.
-func (in *InterfaceSubinterfaceIpv4) DeepCopy() *InterfaceSubinterfaceIpv4 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutput.
+func (in *InterfaceQosOutput) DeepCopy() *InterfaceQosOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4)
+	out := new(InterfaceQosOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4Address) DeepCopyInto(out *InterfaceSubinterfaceIpv4Address) {
+func (in *InterfaceQosOutputMulticastQueue) DeepCopyInto(out *InterfaceQosOutputMulticastQueue) {
 	*out = *in
-	if in.AnycastGw != nil {
-		in, out := &in.AnycastGw, &out.AnycastGw
-		*out = new(bool)
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.IpPrefix != nil {
-		in, out := &in.IpPrefix, &out.IpPrefix
-		*out = new(string)
-		**out = **in
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(InterfaceQosOutputMulticastQueueScheduling)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4Address.
-func (in *InterfaceSubinterfaceIpv4Address) DeepCopy() *InterfaceSubinterfaceIpv4Address {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputMulticastQueue.
+func (in *InterfaceQosOutputMulticastQueue) DeepCopy() *InterfaceQosOutputMulticastQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4Address)
+	out := new(InterfaceQosOutputMulticastQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4Arp) DeepCopyInto(out *InterfaceSubinterfaceIpv4Arp) {
+func (in *InterfaceQosOutputMulticastQueueScheduling) DeepCopyInto(out *InterfaceQosOutputMulticastQueueScheduling) {
 	*out = *in
-	if in.Debug != nil {
-		in, out := &in.Debug, &out.Debug
-		*out = new(string)
-		**out = **in
-	}
-	if in.DuplicateAddressDetection != nil {
-		in, out := &in.DuplicateAddressDetection, &out.DuplicateAddressDetection
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Evpn != nil {
-		in, out := &in.Evpn, &out.Evpn
-		*out = new(InterfaceSubinterfaceIpv4ArpEvpn)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HostRoute != nil {
-		in, out := &in.HostRoute, &out.HostRoute
-		*out = new(InterfaceSubinterfaceIpv4ArpHostRoute)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LearnUnsolicited != nil {
-		in, out := &in.LearnUnsolicited, &out.LearnUnsolicited
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Neighbor != nil {
-		in, out := &in.Neighbor, &out.Neighbor
-		*out = make([]*InterfaceSubinterfaceIpv4ArpNeighbor, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv4ArpNeighbor)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(uint16)
+	if in.PeakRatePercent != nil {
+		in, out := &in.PeakRatePercent, &out.PeakRatePercent
+		*out = new(uint8)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4Arp.
-func (in *InterfaceSubinterfaceIpv4Arp) DeepCopy() *InterfaceSubinterfaceIpv4Arp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputMulticastQueueScheduling.
+func (in *InterfaceQosOutputMulticastQueueScheduling) DeepCopy() *InterfaceQosOutputMulticastQueueScheduling {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4Arp)
+	out := new(InterfaceQosOutputMulticastQueueScheduling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4ArpEvpn) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpEvpn) {
+func (in *InterfaceQosOutputScheduler) DeepCopyInto(out *InterfaceQosOutputScheduler) {
 	*out = *in
-	if in.Advertise != nil {
-		in, out := &in.Advertise, &out.Advertise
-		*out = make([]*InterfaceSubinterfaceIpv4ArpEvpnAdvertise, len(*in))
+	if in.Tier != nil {
+		in, out := &in.Tier, &out.Tier
+		*out = make([]*InterfaceQosOutputSchedulerTier, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv4ArpEvpnAdvertise)
+				*out = new(InterfaceQosOutputSchedulerTier)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpEvpn.
-func (in *InterfaceSubinterfaceIpv4ArpEvpn) DeepCopy() *InterfaceSubinterfaceIpv4ArpEvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputScheduler.
+func (in *InterfaceQosOutputScheduler) DeepCopy() *InterfaceQosOutputScheduler {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4ArpEvpn)
+	out := new(InterfaceQosOutputScheduler)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4ArpEvpnAdvertise) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpEvpnAdvertise) {
+func (in *InterfaceQosOutputSchedulerTier) DeepCopyInto(out *InterfaceQosOutputSchedulerTier) {
 	*out = *in
-	if in.AdminTag != nil {
-		in, out := &in.AdminTag, &out.AdminTag
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RouteType != nil {
-		in, out := &in.RouteType, &out.RouteType
-		*out = new(string)
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(uint8)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpEvpnAdvertise.
-func (in *InterfaceSubinterfaceIpv4ArpEvpnAdvertise) DeepCopy() *InterfaceSubinterfaceIpv4ArpEvpnAdvertise {
-	if in == nil {
-		return nil
-	}
-	out := new(InterfaceSubinterfaceIpv4ArpEvpnAdvertise)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4ArpHostRoute) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpHostRoute) {
-	*out = *in
-	if in.Populate != nil {
-		in, out := &in.Populate, &out.Populate
-		*out = make([]*InterfaceSubinterfaceIpv4ArpHostRoutePopulate, len(*in))
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = make([]*InterfaceQosOutputSchedulerTierNode, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv4ArpHostRoutePopulate)
+				*out = new(InterfaceQosOutputSchedulerTierNode)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpHostRoute.
-func (in *InterfaceSubinterfaceIpv4ArpHostRoute) DeepCopy() *InterfaceSubinterfaceIpv4ArpHostRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputSchedulerTier.
+func (in *InterfaceQosOutputSchedulerTier) DeepCopy() *InterfaceQosOutputSchedulerTier {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4ArpHostRoute)
+	out := new(InterfaceQosOutputSchedulerTier)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4ArpHostRoutePopulate) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpHostRoutePopulate) {
+func (in *InterfaceQosOutputSchedulerTierNode) DeepCopyInto(out *InterfaceQosOutputSchedulerTierNode) {
 	*out = *in
-	if in.AdminTag != nil {
-		in, out := &in.AdminTag, &out.AdminTag
-		*out = new(uint32)
+	if in.NodeNumber != nil {
+		in, out := &in.NodeNumber, &out.NodeNumber
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.RouteType != nil {
-		in, out := &in.RouteType, &out.RouteType
-		*out = new(string)
+	if in.StrictPriority != nil {
+		in, out := &in.StrictPriority, &out.StrictPriority
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint8)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpHostRoutePopulate.
-func (in *InterfaceSubinterfaceIpv4ArpHostRoutePopulate) DeepCopy() *InterfaceSubinterfaceIpv4ArpHostRoutePopulate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputSchedulerTierNode.
+func (in *InterfaceQosOutputSchedulerTierNode) DeepCopy() *InterfaceQosOutputSchedulerTierNode {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4ArpHostRoutePopulate)
+	out := new(InterfaceQosOutputSchedulerTierNode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4ArpNeighbor) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpNeighbor) {
+func (in *InterfaceQosOutputUnicastQueue) DeepCopyInto(out *InterfaceQosOutputUnicastQueue) {
 	*out = *in
-	if in.Ipv4Address != nil {
-		in, out := &in.Ipv4Address, &out.Ipv4Address
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(InterfaceQosOutputUnicastQueueScheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
 		*out = new(string)
 		**out = **in
 	}
-	if in.LinkLayerAddress != nil {
-		in, out := &in.LinkLayerAddress, &out.LinkLayerAddress
+	if in.VoqTemplate != nil {
+		in, out := &in.VoqTemplate, &out.VoqTemplate
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpNeighbor.
-func (in *InterfaceSubinterfaceIpv4ArpNeighbor) DeepCopy() *InterfaceSubinterfaceIpv4ArpNeighbor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputUnicastQueue.
+func (in *InterfaceQosOutputUnicastQueue) DeepCopy() *InterfaceQosOutputUnicastQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4ArpNeighbor)
+	out := new(InterfaceQosOutputUnicastQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4DhcpClient) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpClient) {
+func (in *InterfaceQosOutputUnicastQueueScheduling) DeepCopyInto(out *InterfaceQosOutputUnicastQueueScheduling) {
 	*out = *in
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(InterfaceSubinterfaceIpv4DhcpClientTraceOptions)
-		(*in).DeepCopyInto(*out)
+	if in.PeakRatePercent != nil {
+		in, out := &in.PeakRatePercent, &out.PeakRatePercent
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.StrictPriority != nil {
+		in, out := &in.StrictPriority, &out.StrictPriority
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint8)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpClient.
-func (in *InterfaceSubinterfaceIpv4DhcpClient) DeepCopy() *InterfaceSubinterfaceIpv4DhcpClient {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceQosOutputUnicastQueueScheduling.
+func (in *InterfaceQosOutputUnicastQueueScheduling) DeepCopy() *InterfaceQosOutputUnicastQueueScheduling {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4DhcpClient)
+	out := new(InterfaceQosOutputUnicastQueueScheduling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4DhcpClientTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpClientTraceOptions) {
-	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpClientTraceOptions.
-func (in *InterfaceSubinterfaceIpv4DhcpClientTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv4DhcpClientTraceOptions {
-	if in == nil {
-		return nil
-	}
-	out := new(InterfaceSubinterfaceIpv4DhcpClientTraceOptions)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4DhcpRelay) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpRelay) {
+func (in *InterfaceSflow) DeepCopyInto(out *InterfaceSflow) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.GiAddress != nil {
-		in, out := &in.GiAddress, &out.GiAddress
-		*out = new(string)
-		**out = **in
-	}
-	if in.Option != nil {
-		in, out := &in.Option, &out.Option
-		*out = new(string)
-		**out = **in
-	}
-	if in.Server != nil {
-		in, out := &in.Server, &out.Server
-		*out = new(string)
-		**out = **in
-	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(InterfaceSubinterfaceIpv4DhcpRelayTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.UseGiAddrAsSrcIpAddr != nil {
-		in, out := &in.UseGiAddrAsSrcIpAddr, &out.UseGiAddrAsSrcIpAddr
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpRelay.
-func (in *InterfaceSubinterfaceIpv4DhcpRelay) DeepCopy() *InterfaceSubinterfaceIpv4DhcpRelay {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSflow.
+func (in *InterfaceSflow) DeepCopy() *InterfaceSflow {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4DhcpRelay)
+	out := new(InterfaceSflow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions) {
+func (in *InterfaceSpec) DeepCopyInto(out *InterfaceSpec) {
 	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpRelayTraceOptions.
-func (in *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSpec.
+func (in *InterfaceSpec) DeepCopy() *InterfaceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4DhcpRelayTraceOptions)
+	out := new(InterfaceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4Vrrp) DeepCopyInto(out *InterfaceSubinterfaceIpv4Vrrp) {
+func (in *InterfaceStatus) DeepCopyInto(out *InterfaceStatus) {
 	*out = *in
-	if in.VrrpGroup != nil {
-		in, out := &in.VrrpGroup, &out.VrrpGroup
-		*out = make([]*InterfaceSubinterfaceIpv4VrrpVrrpGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4Vrrp.
-func (in *InterfaceSubinterfaceIpv4Vrrp) DeepCopy() *InterfaceSubinterfaceIpv4Vrrp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceStatus.
+func (in *InterfaceStatus) DeepCopy() *InterfaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4Vrrp)
+	out := new(InterfaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroup) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroup) {
+func (in *InterfaceSubinterface) DeepCopyInto(out *InterfaceSubinterface) {
 	*out = *in
-	if in.AcceptMode != nil {
-		in, out := &in.AcceptMode, &out.AcceptMode
-		*out = new(bool)
-		**out = **in
+	if in.Acl != nil {
+		in, out := &in.Acl, &out.Acl
+		*out = new(InterfaceSubinterfaceAcl)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertiseInterval != nil {
-		in, out := &in.AdvertiseInterval, &out.AdvertiseInterval
-		*out = new(uint16)
-		**out = **in
+	if in.AnycastGw != nil {
+		in, out := &in.AnycastGw, &out.AnycastGw
+		*out = new(InterfaceSubinterfaceAnycastGw)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication)
+	if in.BridgeTable != nil {
+		in, out := &in.BridgeTable, &out.BridgeTable
+		*out = new(InterfaceSubinterfaceBridgeTable)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.InitDelay != nil {
-		in, out := &in.InitDelay, &out.InitDelay
-		*out = new(uint16)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.InterfaceTracking != nil {
-		in, out := &in.InterfaceTracking, &out.InterfaceTracking
-		*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MasterInheritInterval != nil {
-		in, out := &in.MasterInheritInterval, &out.MasterInheritInterval
-		*out = new(bool)
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.OperInterval != nil {
-		in, out := &in.OperInterval, &out.OperInterval
+	if in.IpMtu != nil {
+		in, out := &in.IpMtu, &out.IpMtu
 		*out = new(uint16)
 		**out = **in
 	}
-	if in.Preempt != nil {
-		in, out := &in.Preempt, &out.Preempt
-		*out = new(bool)
-		**out = **in
+	if in.Ipv4 != nil {
+		in, out := &in.Ipv4, &out.Ipv4
+		*out = new(InterfaceSubinterfaceIpv4)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PreemptDelay != nil {
-		in, out := &in.PreemptDelay, &out.PreemptDelay
-		*out = new(uint16)
-		**out = **in
+	if in.Ipv6 != nil {
+		in, out := &in.Ipv6, &out.Ipv6
+		*out = new(InterfaceSubinterfaceIpv6)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(uint8)
+	if in.L2Mtu != nil {
+		in, out := &in.L2Mtu, &out.L2Mtu
+		*out = new(uint16)
 		**out = **in
 	}
-	if in.Statistics != nil {
-		in, out := &in.Statistics, &out.Statistics
-		*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics)
-		**out = **in
+	if in.LocalMirrorDestination != nil {
+		in, out := &in.LocalMirrorDestination, &out.LocalMirrorDestination
+		*out = new(InterfaceSubinterfaceLocalMirrorDestination)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(uint8)
-		**out = **in
+	if in.Qos != nil {
+		in, out := &in.Qos, &out.Qos
+		*out = new(InterfaceSubinterfaceQos)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.VirtualAddress != nil {
-		in, out := &in.VirtualAddress, &out.VirtualAddress
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
-	if in.VirtualRouterId != nil {
-		in, out := &in.VirtualRouterId, &out.VirtualRouterId
-		*out = new(uint8)
-		**out = **in
+	if in.Vlan != nil {
+		in, out := &in.Vlan, &out.Vlan
+		*out = new(InterfaceSubinterfaceVlan)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroup.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroup) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterface.
+func (in *InterfaceSubinterface) DeepCopy() *InterfaceSubinterface {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroup)
+	out := new(InterfaceSubinterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication) {
+func (in *InterfaceSubinterfaceAcl) DeepCopyInto(out *InterfaceSubinterfaceAcl) {
 	*out = *in
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
-		*out = new(string)
-		**out = **in
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(InterfaceSubinterfaceAclInput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(InterfaceSubinterfaceAclOutput)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAcl.
+func (in *InterfaceSubinterfaceAcl) DeepCopy() *InterfaceSubinterfaceAcl {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication)
+	out := new(InterfaceSubinterfaceAcl)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking) {
+func (in *InterfaceSubinterfaceAclInput) DeepCopyInto(out *InterfaceSubinterfaceAclInput) {
 	*out = *in
-	if in.TrackInterface != nil {
-		in, out := &in.TrackInterface, &out.TrackInterface
-		*out = make([]*InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Ipv4Filter != nil {
+		in, out := &in.Ipv4Filter, &out.Ipv4Filter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv6Filter != nil {
+		in, out := &in.Ipv6Filter, &out.Ipv6Filter
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAclInput.
+func (in *InterfaceSubinterfaceAclInput) DeepCopy() *InterfaceSubinterfaceAclInput {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking)
+	out := new(InterfaceSubinterfaceAclInput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface) {
+func (in *InterfaceSubinterfaceAclOutput) DeepCopyInto(out *InterfaceSubinterfaceAclOutput) {
 	*out = *in
-	if in.Interface != nil {
-		in, out := &in.Interface, &out.Interface
+	if in.Ipv4Filter != nil {
+		in, out := &in.Ipv4Filter, &out.Ipv4Filter
 		*out = new(string)
 		**out = **in
 	}
-	if in.PriorityDecrement != nil {
-		in, out := &in.PriorityDecrement, &out.PriorityDecrement
-		*out = new(uint8)
+	if in.Ipv6Filter != nil {
+		in, out := &in.Ipv6Filter, &out.Ipv6Filter
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAclOutput.
+func (in *InterfaceSubinterfaceAclOutput) DeepCopy() *InterfaceSubinterfaceAclOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface)
+	out := new(InterfaceSubinterfaceAclOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics) {
+func (in *InterfaceSubinterfaceAnycastGw) DeepCopyInto(out *InterfaceSubinterfaceAnycastGw) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics.
-func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics {
+	if in.AnycastGwMac != nil {
+		in, out := &in.AnycastGwMac, &out.AnycastGwMac
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualRouterId != nil {
+		in, out := &in.VirtualRouterId, &out.VirtualRouterId
+		*out = new(uint8)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceAnycastGw.
+func (in *InterfaceSubinterfaceAnycastGw) DeepCopy() *InterfaceSubinterfaceAnycastGw {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics)
+	out := new(InterfaceSubinterfaceAnycastGw)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6) DeepCopyInto(out *InterfaceSubinterfaceIpv6) {
+func (in *InterfaceSubinterfaceBridgeTable) DeepCopyInto(out *InterfaceSubinterfaceBridgeTable) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = make([]*InterfaceSubinterfaceIpv6Address, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6Address)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.DhcpClient != nil {
-		in, out := &in.DhcpClient, &out.DhcpClient
-		*out = new(InterfaceSubinterfaceIpv6DhcpClient)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DhcpRelay != nil {
-		in, out := &in.DhcpRelay, &out.DhcpRelay
-		*out = new(InterfaceSubinterfaceIpv6DhcpRelay)
-		(*in).DeepCopyInto(*out)
+	if in.DiscardUnknownSrcMac != nil {
+		in, out := &in.DiscardUnknownSrcMac, &out.DiscardUnknownSrcMac
+		*out = new(bool)
+		**out = **in
 	}
-	if in.NeighborDiscovery != nil {
-		in, out := &in.NeighborDiscovery, &out.NeighborDiscovery
-		*out = new(InterfaceSubinterfaceIpv6NeighborDiscovery)
+	if in.MacDuplication != nil {
+		in, out := &in.MacDuplication, &out.MacDuplication
+		*out = new(InterfaceSubinterfaceBridgeTableMacDuplication)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RouterAdvertisement != nil {
-		in, out := &in.RouterAdvertisement, &out.RouterAdvertisement
-		*out = new(InterfaceSubinterfaceIpv6RouterAdvertisement)
+	if in.MacLearning != nil {
+		in, out := &in.MacLearning, &out.MacLearning
+		*out = new(InterfaceSubinterfaceBridgeTableMacLearning)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Vrrp != nil {
-		in, out := &in.Vrrp, &out.Vrrp
-		*out = new(InterfaceSubinterfaceIpv6Vrrp)
+	if in.MacLimit != nil {
+		in, out := &in.MacLimit, &out.MacLimit
+		*out = new(InterfaceSubinterfaceBridgeTableMacLimit)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6.
-func (in *InterfaceSubinterfaceIpv6) DeepCopy() *InterfaceSubinterfaceIpv6 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTable.
+func (in *InterfaceSubinterfaceBridgeTable) DeepCopy() *InterfaceSubinterfaceBridgeTable {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6)
+	out := new(InterfaceSubinterfaceBridgeTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6Address) DeepCopyInto(out *InterfaceSubinterfaceIpv6Address) {
+func (in *InterfaceSubinterfaceBridgeTableMacDuplication) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacDuplication) {
 	*out = *in
-	if in.AnycastGw != nil {
-		in, out := &in.AnycastGw, &out.AnycastGw
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IpPrefix != nil {
-		in, out := &in.IpPrefix, &out.IpPrefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6Address.
-func (in *InterfaceSubinterfaceIpv6Address) DeepCopy() *InterfaceSubinterfaceIpv6Address {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacDuplication.
+func (in *InterfaceSubinterfaceBridgeTableMacDuplication) DeepCopy() *InterfaceSubinterfaceBridgeTableMacDuplication {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6Address)
+	out := new(InterfaceSubinterfaceBridgeTableMacDuplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6DhcpClient) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpClient) {
+func (in *InterfaceSubinterfaceBridgeTableMacLearning) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacLearning) {
 	*out = *in
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(InterfaceSubinterfaceIpv6DhcpClientTraceOptions)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.Aging != nil {
+		in, out := &in.Aging, &out.Aging
+		*out = new(InterfaceSubinterfaceBridgeTableMacLearningAging)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpClient.
-func (in *InterfaceSubinterfaceIpv6DhcpClient) DeepCopy() *InterfaceSubinterfaceIpv6DhcpClient {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacLearning.
+func (in *InterfaceSubinterfaceBridgeTableMacLearning) DeepCopy() *InterfaceSubinterfaceBridgeTableMacLearning {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6DhcpClient)
+	out := new(InterfaceSubinterfaceBridgeTableMacLearning)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6DhcpClientTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpClientTraceOptions) {
+func (in *InterfaceSubinterfaceBridgeTableMacLearningAging) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacLearningAging) {
 	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpClientTraceOptions.
-func (in *InterfaceSubinterfaceIpv6DhcpClientTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv6DhcpClientTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacLearningAging.
+func (in *InterfaceSubinterfaceBridgeTableMacLearningAging) DeepCopy() *InterfaceSubinterfaceBridgeTableMacLearningAging {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6DhcpClientTraceOptions)
+	out := new(InterfaceSubinterfaceBridgeTableMacLearningAging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6DhcpRelay) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpRelay) {
+func (in *InterfaceSubinterfaceBridgeTableMacLimit) DeepCopyInto(out *InterfaceSubinterfaceBridgeTableMacLimit) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
+	if in.MaximumEntries != nil {
+		in, out := &in.MaximumEntries, &out.MaximumEntries
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Option != nil {
-		in, out := &in.Option, &out.Option
-		*out = new(string)
+	if in.WarningThresholdPct != nil {
+		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Server != nil {
-		in, out := &in.Server, &out.Server
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceBridgeTableMacLimit.
+func (in *InterfaceSubinterfaceBridgeTableMacLimit) DeepCopy() *InterfaceSubinterfaceBridgeTableMacLimit {
+	if in == nil {
+		return nil
 	}
-	if in.SourceAddress != nil {
-		in, out := &in.SourceAddress, &out.SourceAddress
-		*out = new(string)
+	out := new(InterfaceSubinterfaceBridgeTableMacLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSubinterfaceIpv4) DeepCopyInto(out *InterfaceSubinterfaceIpv4) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = make([]*InterfaceSubinterfaceIpv4Address, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceSubinterfaceIpv4Address)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AllowDirectedBroadcast != nil {
+		in, out := &in.AllowDirectedBroadcast, &out.AllowDirectedBroadcast
+		*out = new(bool)
 		**out = **in
 	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(InterfaceSubinterfaceIpv6DhcpRelayTraceOptions)
+	if in.Arp != nil {
+		in, out := &in.Arp, &out.Arp
+		*out = new(InterfaceSubinterfaceIpv4Arp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DhcpClient != nil {
+		in, out := &in.DhcpClient, &out.DhcpClient
+		*out = new(InterfaceSubinterfaceIpv4DhcpClient)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DhcpRelay != nil {
+		in, out := &in.DhcpRelay, &out.DhcpRelay
+		*out = new(InterfaceSubinterfaceIpv4DhcpRelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vrrp != nil {
+		in, out := &in.Vrrp, &out.Vrrp
+		*out = new(InterfaceSubinterfaceIpv4Vrrp)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpRelay.
-func (in *InterfaceSubinterfaceIpv6DhcpRelay) DeepCopy() *InterfaceSubinterfaceIpv6DhcpRelay {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4.
+func (in *InterfaceSubinterfaceIpv4) DeepCopy() *InterfaceSubinterfaceIpv4 {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6DhcpRelay)
+	out := new(InterfaceSubinterfaceIpv4)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions) {
+func (in *InterfaceSubinterfaceIpv4Address) DeepCopyInto(out *InterfaceSubinterfaceIpv4Address) {
 	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
+	if in.AnycastGw != nil {
+		in, out := &in.AnycastGw, &out.AnycastGw
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IpPrefix != nil {
+		in, out := &in.IpPrefix, &out.IpPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.IpPrefixPoolRef != nil {
+		in, out := &in.IpPrefixPoolRef, &out.IpPrefixPoolRef
+		*out = new(PoolReference)
+		**out = **in
+	}
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpRelayTraceOptions.
-func (in *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4Address.
+func (in *InterfaceSubinterfaceIpv4Address) DeepCopy() *InterfaceSubinterfaceIpv4Address {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6DhcpRelayTraceOptions)
+	out := new(InterfaceSubinterfaceIpv4Address)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscovery) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscovery) {
+func (in *InterfaceSubinterfaceIpv4Arp) DeepCopyInto(out *InterfaceSubinterfaceIpv4Arp) {
 	*out = *in
 	if in.Debug != nil {
 		in, out := &in.Debug, &out.Debug
@@ -1900,80 +1791,75 @@ func (in *InterfaceSubinterfaceIpv6NeighborDiscovery) DeepCopyInto(out *Interfac
 	}
 	if in.Evpn != nil {
 		in, out := &in.Evpn, &out.Evpn
-		*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn)
+		*out = new(InterfaceSubinterfaceIpv4ArpEvpn)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.HostRoute != nil {
 		in, out := &in.HostRoute, &out.HostRoute
-		*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute)
+		*out = new(InterfaceSubinterfaceIpv4ArpHostRoute)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LearnUnsolicited != nil {
 		in, out := &in.LearnUnsolicited, &out.LearnUnsolicited
-		*out = new(string)
+		*out = new(bool)
 		**out = **in
 	}
 	if in.Neighbor != nil {
 		in, out := &in.Neighbor, &out.Neighbor
-		*out = make([]*InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor, len(*in))
+		*out = make([]*InterfaceSubinterfaceIpv4ArpNeighbor, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor)
+				*out = new(InterfaceSubinterfaceIpv4ArpNeighbor)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.ReachableTime != nil {
-		in, out := &in.ReachableTime, &out.ReachableTime
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.StaleTime != nil {
-		in, out := &in.StaleTime, &out.StaleTime
-		*out = new(uint32)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint16)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscovery.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscovery) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscovery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4Arp.
+func (in *InterfaceSubinterfaceIpv4Arp) DeepCopy() *InterfaceSubinterfaceIpv4Arp {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6NeighborDiscovery)
+	out := new(InterfaceSubinterfaceIpv4Arp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn) {
+func (in *InterfaceSubinterfaceIpv4ArpEvpn) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpEvpn) {
 	*out = *in
 	if in.Advertise != nil {
 		in, out := &in.Advertise, &out.Advertise
-		*out = make([]*InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise, len(*in))
+		*out = make([]*InterfaceSubinterfaceIpv4ArpEvpnAdvertise, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise)
+				*out = new(InterfaceSubinterfaceIpv4ArpEvpnAdvertise)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpEvpn.
+func (in *InterfaceSubinterfaceIpv4ArpEvpn) DeepCopy() *InterfaceSubinterfaceIpv4ArpEvpn {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn)
+	out := new(InterfaceSubinterfaceIpv4ArpEvpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) {
+func (in *InterfaceSubinterfaceIpv4ArpEvpnAdvertise) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpEvpnAdvertise) {
 	*out = *in
 	if in.AdminTag != nil {
 		in, out := &in.AdminTag, &out.AdminTag
@@ -1987,44 +1873,44 @@ func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) DeepCopyInto(
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpEvpnAdvertise.
+func (in *InterfaceSubinterfaceIpv4ArpEvpnAdvertise) DeepCopy() *InterfaceSubinterfaceIpv4ArpEvpnAdvertise {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise)
+	out := new(InterfaceSubinterfaceIpv4ArpEvpnAdvertise)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute) {
+func (in *InterfaceSubinterfaceIpv4ArpHostRoute) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpHostRoute) {
 	*out = *in
 	if in.Populate != nil {
 		in, out := &in.Populate, &out.Populate
-		*out = make([]*InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate, len(*in))
+		*out = make([]*InterfaceSubinterfaceIpv4ArpHostRoutePopulate, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate)
+				*out = new(InterfaceSubinterfaceIpv4ArpHostRoutePopulate)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpHostRoute.
+func (in *InterfaceSubinterfaceIpv4ArpHostRoute) DeepCopy() *InterfaceSubinterfaceIpv4ArpHostRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute)
+	out := new(InterfaceSubinterfaceIpv4ArpHostRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) {
+func (in *InterfaceSubinterfaceIpv4ArpHostRoutePopulate) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpHostRoutePopulate) {
 	*out = *in
 	if in.AdminTag != nil {
 		in, out := &in.AdminTag, &out.AdminTag
@@ -2038,21 +1924,21 @@ func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) DeepCopyI
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpHostRoutePopulate.
+func (in *InterfaceSubinterfaceIpv4ArpHostRoutePopulate) DeepCopy() *InterfaceSubinterfaceIpv4ArpHostRoutePopulate {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate)
+	out := new(InterfaceSubinterfaceIpv4ArpHostRoutePopulate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) {
+func (in *InterfaceSubinterfaceIpv4ArpNeighbor) DeepCopyInto(out *InterfaceSubinterfaceIpv4ArpNeighbor) {
 	*out = *in
-	if in.Ipv6Address != nil {
-		in, out := &in.Ipv6Address, &out.Ipv6Address
+	if in.Ipv4Address != nil {
+		in, out := &in.Ipv4Address, &out.Ipv4Address
 		*out = new(string)
 		**out = **in
 	}
@@ -2063,185 +1949,149 @@ func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) DeepCopyInto(out *
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor.
-func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4ArpNeighbor.
+func (in *InterfaceSubinterfaceIpv4ArpNeighbor) DeepCopy() *InterfaceSubinterfaceIpv4ArpNeighbor {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor)
+	out := new(InterfaceSubinterfaceIpv4ArpNeighbor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6RouterAdvertisement) DeepCopyInto(out *InterfaceSubinterfaceIpv6RouterAdvertisement) {
+func (in *InterfaceSubinterfaceIpv4DhcpClient) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpClient) {
 	*out = *in
-	if in.Debug != nil {
-		in, out := &in.Debug, &out.Debug
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(InterfaceSubinterfaceIpv4DhcpClientTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpClient.
+func (in *InterfaceSubinterfaceIpv4DhcpClient) DeepCopy() *InterfaceSubinterfaceIpv4DhcpClient {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSubinterfaceIpv4DhcpClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSubinterfaceIpv4DhcpClientTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpClientTraceOptions) {
+	*out = *in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
 		*out = new(string)
 		**out = **in
 	}
-	if in.RouterRole != nil {
-		in, out := &in.RouterRole, &out.RouterRole
-		*out = new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6RouterAdvertisement.
-func (in *InterfaceSubinterfaceIpv6RouterAdvertisement) DeepCopy() *InterfaceSubinterfaceIpv6RouterAdvertisement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpClientTraceOptions.
+func (in *InterfaceSubinterfaceIpv4DhcpClientTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv4DhcpClientTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6RouterAdvertisement)
+	out := new(InterfaceSubinterfaceIpv4DhcpClientTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole) DeepCopyInto(out *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole) {
+func (in *InterfaceSubinterfaceIpv4DhcpRelay) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpRelay) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.CurrentHopLimit != nil {
-		in, out := &in.CurrentHopLimit, &out.CurrentHopLimit
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.IpMtu != nil {
-		in, out := &in.IpMtu, &out.IpMtu
-		*out = new(uint16)
+	if in.GiAddress != nil {
+		in, out := &in.GiAddress, &out.GiAddress
+		*out = new(string)
 		**out = **in
 	}
-	if in.ManagedConfigurationFlag != nil {
-		in, out := &in.ManagedConfigurationFlag, &out.ManagedConfigurationFlag
-		*out = new(bool)
+	if in.Option != nil {
+		in, out := &in.Option, &out.Option
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxAdvertisementInterval != nil {
-		in, out := &in.MaxAdvertisementInterval, &out.MaxAdvertisementInterval
-		*out = new(uint16)
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(string)
 		**out = **in
 	}
-	if in.MinAdvertisementInterval != nil {
-		in, out := &in.MinAdvertisementInterval, &out.MinAdvertisementInterval
-		*out = new(uint16)
-		**out = **in
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(InterfaceSubinterfaceIpv4DhcpRelayTraceOptions)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OtherConfigurationFlag != nil {
-		in, out := &in.OtherConfigurationFlag, &out.OtherConfigurationFlag
+	if in.UseGiAddrAsSrcIpAddr != nil {
+		in, out := &in.UseGiAddrAsSrcIpAddr, &out.UseGiAddrAsSrcIpAddr
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = make([]*InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.ReachableTime != nil {
-		in, out := &in.ReachableTime, &out.ReachableTime
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RetransmitTime != nil {
-		in, out := &in.RetransmitTime, &out.RetransmitTime
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RouterLifetime != nil {
-		in, out := &in.RouterLifetime, &out.RouterLifetime
-		*out = new(uint16)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole.
-func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole) DeepCopy() *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpRelay.
+func (in *InterfaceSubinterfaceIpv4DhcpRelay) DeepCopy() *InterfaceSubinterfaceIpv4DhcpRelay {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole)
+	out := new(InterfaceSubinterfaceIpv4DhcpRelay)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix) DeepCopyInto(out *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix) {
+func (in *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions) {
 	*out = *in
-	if in.AutonomousFlag != nil {
-		in, out := &in.AutonomousFlag, &out.AutonomousFlag
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ipv6Prefix != nil {
-		in, out := &in.Ipv6Prefix, &out.Ipv6Prefix
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
 		*out = new(string)
 		**out = **in
 	}
-	if in.OnLinkFlag != nil {
-		in, out := &in.OnLinkFlag, &out.OnLinkFlag
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PreferredLifetime != nil {
-		in, out := &in.PreferredLifetime, &out.PreferredLifetime
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ValidLifetime != nil {
-		in, out := &in.ValidLifetime, &out.ValidLifetime
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix.
-func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix) DeepCopy() *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4DhcpRelayTraceOptions.
+func (in *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv4DhcpRelayTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix)
+	out := new(InterfaceSubinterfaceIpv4DhcpRelayTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6Vrrp) DeepCopyInto(out *InterfaceSubinterfaceIpv6Vrrp) {
+func (in *InterfaceSubinterfaceIpv4Vrrp) DeepCopyInto(out *InterfaceSubinterfaceIpv4Vrrp) {
 	*out = *in
 	if in.VrrpGroup != nil {
 		in, out := &in.VrrpGroup, &out.VrrpGroup
-		*out = make([]*InterfaceSubinterfaceIpv6VrrpVrrpGroup, len(*in))
+		*out = make([]*InterfaceSubinterfaceIpv4VrrpVrrpGroup, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroup)
+				*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroup)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6Vrrp.
-func (in *InterfaceSubinterfaceIpv6Vrrp) DeepCopy() *InterfaceSubinterfaceIpv6Vrrp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4Vrrp.
+func (in *InterfaceSubinterfaceIpv4Vrrp) DeepCopy() *InterfaceSubinterfaceIpv4Vrrp {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6Vrrp)
+	out := new(InterfaceSubinterfaceIpv4Vrrp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroup) {
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroup) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroup) {
 	*out = *in
 	if in.AcceptMode != nil {
 		in, out := &in.AcceptMode, &out.AcceptMode
@@ -2260,7 +2110,7 @@ func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopyInto(out *InterfaceSub
 	}
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
-		*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication)
+		*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.InitDelay != nil {
@@ -2270,7 +2120,7 @@ func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopyInto(out *InterfaceSub
 	}
 	if in.InterfaceTracking != nil {
 		in, out := &in.InterfaceTracking, &out.InterfaceTracking
-		*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking)
+		*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.MasterInheritInterval != nil {
@@ -2300,7 +2150,7 @@ func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopyInto(out *InterfaceSub
 	}
 	if in.Statistics != nil {
 		in, out := &in.Statistics, &out.Statistics
-		*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics)
+		*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics)
 		**out = **in
 	}
 	if in.Version != nil {
@@ -2320,18 +2170,18 @@ func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopyInto(out *InterfaceSub
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroup.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroup.
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroup) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroup)
+	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) {
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication) {
 	*out = *in
 	if in.Keychain != nil {
 		in, out := &in.Keychain, &out.Keychain
@@ -2340,44 +2190,44 @@ func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) DeepCopyInto(out
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication.
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication)
+	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking) {
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking) {
 	*out = *in
 	if in.TrackInterface != nil {
 		in, out := &in.TrackInterface, &out.TrackInterface
-		*out = make([]*InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface, len(*in))
+		*out = make([]*InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface)
+				*out = new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking.
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking)
+	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTracking)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface) {
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface) {
 	*out = *in
 	if in.Interface != nil {
 		in, out := &in.Interface, &out.Interface
@@ -2391,2613 +2241,2575 @@ func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface.
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface)
+	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupInterfaceTrackingTrackInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics) {
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics) DeepCopyInto(out *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics.
-func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics.
+func (in *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics) DeepCopy() *InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics)
+	out := new(InterfaceSubinterfaceIpv4VrrpVrrpGroupStatistics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceLocalMirrorDestination) DeepCopyInto(out *InterfaceSubinterfaceLocalMirrorDestination) {
+func (in *InterfaceSubinterfaceIpv6) DeepCopyInto(out *InterfaceSubinterfaceIpv6) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceLocalMirrorDestination.
-func (in *InterfaceSubinterfaceLocalMirrorDestination) DeepCopy() *InterfaceSubinterfaceLocalMirrorDestination {
-	if in == nil {
-		return nil
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = make([]*InterfaceSubinterfaceIpv6Address, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceSubinterfaceIpv6Address)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DhcpClient != nil {
+		in, out := &in.DhcpClient, &out.DhcpClient
+		*out = new(InterfaceSubinterfaceIpv6DhcpClient)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DhcpRelay != nil {
+		in, out := &in.DhcpRelay, &out.DhcpRelay
+		*out = new(InterfaceSubinterfaceIpv6DhcpRelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NeighborDiscovery != nil {
+		in, out := &in.NeighborDiscovery, &out.NeighborDiscovery
+		*out = new(InterfaceSubinterfaceIpv6NeighborDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterAdvertisement != nil {
+		in, out := &in.RouterAdvertisement, &out.RouterAdvertisement
+		*out = new(InterfaceSubinterfaceIpv6RouterAdvertisement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vrrp != nil {
+		in, out := &in.Vrrp, &out.Vrrp
+		*out = new(InterfaceSubinterfaceIpv6Vrrp)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(InterfaceSubinterfaceLocalMirrorDestination)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceObservation) DeepCopyInto(out *InterfaceSubinterfaceObservation) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceObservation.
-func (in *InterfaceSubinterfaceObservation) DeepCopy() *InterfaceSubinterfaceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6.
+func (in *InterfaceSubinterfaceIpv6) DeepCopy() *InterfaceSubinterfaceIpv6 {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceObservation)
+	out := new(InterfaceSubinterfaceIpv6)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceParameters) DeepCopyInto(out *InterfaceSubinterfaceParameters) {
+func (in *InterfaceSubinterfaceIpv6Address) DeepCopyInto(out *InterfaceSubinterfaceIpv6Address) {
 	*out = *in
-	if in.InterfaceName != nil {
-		in, out := &in.InterfaceName, &out.InterfaceName
+	if in.AnycastGw != nil {
+		in, out := &in.AnycastGw, &out.AnycastGw
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IpPrefix != nil {
+		in, out := &in.IpPrefix, &out.IpPrefix
 		*out = new(string)
 		**out = **in
 	}
-	if in.SrlInterfaceSubinterface != nil {
-		in, out := &in.SrlInterfaceSubinterface, &out.SrlInterfaceSubinterface
-		*out = new(InterfaceSubinterface)
-		(*in).DeepCopyInto(*out)
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceParameters.
-func (in *InterfaceSubinterfaceParameters) DeepCopy() *InterfaceSubinterfaceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6Address.
+func (in *InterfaceSubinterfaceIpv6Address) DeepCopy() *InterfaceSubinterfaceIpv6Address {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceParameters)
+	out := new(InterfaceSubinterfaceIpv6Address)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceQos) DeepCopyInto(out *InterfaceSubinterfaceQos) {
+func (in *InterfaceSubinterfaceIpv6DhcpClient) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpClient) {
 	*out = *in
-	if in.Input != nil {
-		in, out := &in.Input, &out.Input
-		*out = new(InterfaceSubinterfaceQosInput)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Output != nil {
-		in, out := &in.Output, &out.Output
-		*out = new(InterfaceSubinterfaceQosOutput)
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(InterfaceSubinterfaceIpv6DhcpClientTraceOptions)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQos.
-func (in *InterfaceSubinterfaceQos) DeepCopy() *InterfaceSubinterfaceQos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpClient.
+func (in *InterfaceSubinterfaceIpv6DhcpClient) DeepCopy() *InterfaceSubinterfaceIpv6DhcpClient {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceQos)
+	out := new(InterfaceSubinterfaceIpv6DhcpClient)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceQosInput) DeepCopyInto(out *InterfaceSubinterfaceQosInput) {
+func (in *InterfaceSubinterfaceIpv6DhcpClientTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpClientTraceOptions) {
 	*out = *in
-	if in.Classifiers != nil {
-		in, out := &in.Classifiers, &out.Classifiers
-		*out = new(InterfaceSubinterfaceQosInputClassifiers)
-		(*in).DeepCopyInto(*out)
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosInput.
-func (in *InterfaceSubinterfaceQosInput) DeepCopy() *InterfaceSubinterfaceQosInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpClientTraceOptions.
+func (in *InterfaceSubinterfaceIpv6DhcpClientTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv6DhcpClientTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceQosInput)
+	out := new(InterfaceSubinterfaceIpv6DhcpClientTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceQosInputClassifiers) DeepCopyInto(out *InterfaceSubinterfaceQosInputClassifiers) {
+func (in *InterfaceSubinterfaceIpv6DhcpRelay) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpRelay) {
 	*out = *in
-	if in.Dscp != nil {
-		in, out := &in.Dscp, &out.Dscp
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ipv4Dscp != nil {
-		in, out := &in.Ipv4Dscp, &out.Ipv4Dscp
+	if in.Option != nil {
+		in, out := &in.Option, &out.Option
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ipv6Dscp != nil {
-		in, out := &in.Ipv6Dscp, &out.Ipv6Dscp
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
 		*out = new(string)
 		**out = **in
 	}
-	if in.MplsTrafficClass != nil {
-		in, out := &in.MplsTrafficClass, &out.MplsTrafficClass
+	if in.SourceAddress != nil {
+		in, out := &in.SourceAddress, &out.SourceAddress
 		*out = new(string)
 		**out = **in
 	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(InterfaceSubinterfaceIpv6DhcpRelayTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosInputClassifiers.
-func (in *InterfaceSubinterfaceQosInputClassifiers) DeepCopy() *InterfaceSubinterfaceQosInputClassifiers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpRelay.
+func (in *InterfaceSubinterfaceIpv6DhcpRelay) DeepCopy() *InterfaceSubinterfaceIpv6DhcpRelay {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceQosInputClassifiers)
+	out := new(InterfaceSubinterfaceIpv6DhcpRelay)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceQosOutput) DeepCopyInto(out *InterfaceSubinterfaceQosOutput) {
+func (in *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions) DeepCopyInto(out *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions) {
 	*out = *in
-	if in.RewriteRules != nil {
-		in, out := &in.RewriteRules, &out.RewriteRules
-		*out = new(InterfaceSubinterfaceQosOutputRewriteRules)
-		(*in).DeepCopyInto(*out)
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosOutput.
-func (in *InterfaceSubinterfaceQosOutput) DeepCopy() *InterfaceSubinterfaceQosOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6DhcpRelayTraceOptions.
+func (in *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions) DeepCopy() *InterfaceSubinterfaceIpv6DhcpRelayTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceQosOutput)
+	out := new(InterfaceSubinterfaceIpv6DhcpRelayTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceQosOutputRewriteRules) DeepCopyInto(out *InterfaceSubinterfaceQosOutputRewriteRules) {
+func (in *InterfaceSubinterfaceIpv6NeighborDiscovery) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscovery) {
 	*out = *in
-	if in.Dscp != nil {
-		in, out := &in.Dscp, &out.Dscp
+	if in.Debug != nil {
+		in, out := &in.Debug, &out.Debug
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ipv4Dscp != nil {
-		in, out := &in.Ipv4Dscp, &out.Ipv4Dscp
-		*out = new(string)
+	if in.DuplicateAddressDetection != nil {
+		in, out := &in.DuplicateAddressDetection, &out.DuplicateAddressDetection
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Ipv6Dscp != nil {
-		in, out := &in.Ipv6Dscp, &out.Ipv6Dscp
+	if in.Evpn != nil {
+		in, out := &in.Evpn, &out.Evpn
+		*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostRoute != nil {
+		in, out := &in.HostRoute, &out.HostRoute
+		*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LearnUnsolicited != nil {
+		in, out := &in.LearnUnsolicited, &out.LearnUnsolicited
 		*out = new(string)
 		**out = **in
 	}
-	if in.MplsTrafficClass != nil {
-		in, out := &in.MplsTrafficClass, &out.MplsTrafficClass
-		*out = new(string)
+	if in.Neighbor != nil {
+		in, out := &in.Neighbor, &out.Neighbor
+		*out = make([]*InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ReachableTime != nil {
+		in, out := &in.ReachableTime, &out.ReachableTime
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.StaleTime != nil {
+		in, out := &in.StaleTime, &out.StaleTime
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosOutputRewriteRules.
-func (in *InterfaceSubinterfaceQosOutputRewriteRules) DeepCopy() *InterfaceSubinterfaceQosOutputRewriteRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscovery.
+func (in *InterfaceSubinterfaceIpv6NeighborDiscovery) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscovery {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceQosOutputRewriteRules)
+	out := new(InterfaceSubinterfaceIpv6NeighborDiscovery)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceSpec) DeepCopyInto(out *InterfaceSubinterfaceSpec) {
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.Advertise != nil {
+		in, out := &in.Advertise, &out.Advertise
+		*out = make([]*InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceSpec.
-func (in *InterfaceSubinterfaceSpec) DeepCopy() *InterfaceSubinterfaceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn.
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceSpec)
+	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceStatus) DeepCopyInto(out *InterfaceSubinterfaceStatus) {
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.AdminTag != nil {
+		in, out := &in.AdminTag, &out.AdminTag
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceStatus.
-func (in *InterfaceSubinterfaceStatus) DeepCopy() *InterfaceSubinterfaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise.
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceStatus)
+	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceVlan) DeepCopyInto(out *InterfaceSubinterfaceVlan) {
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute) {
 	*out = *in
-	if in.Encap != nil {
-		in, out := &in.Encap, &out.Encap
-		*out = new(InterfaceSubinterfaceVlanEncap)
-		(*in).DeepCopyInto(*out)
+	if in.Populate != nil {
+		in, out := &in.Populate, &out.Populate
+		*out = make([]*InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlan.
-func (in *InterfaceSubinterfaceVlan) DeepCopy() *InterfaceSubinterfaceVlan {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute.
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceVlan)
+	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceVlanEncap) DeepCopyInto(out *InterfaceSubinterfaceVlanEncap) {
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) {
 	*out = *in
-	if in.SingleTagged != nil {
-		in, out := &in.SingleTagged, &out.SingleTagged
-		*out = new(InterfaceSubinterfaceVlanEncapSingleTagged)
-		(*in).DeepCopyInto(*out)
+	if in.AdminTag != nil {
+		in, out := &in.AdminTag, &out.AdminTag
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.Untagged != nil {
-		in, out := &in.Untagged, &out.Untagged
-		*out = new(InterfaceSubinterfaceVlanEncapUntagged)
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlanEncap.
-func (in *InterfaceSubinterfaceVlanEncap) DeepCopy() *InterfaceSubinterfaceVlanEncap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate.
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceVlanEncap)
+	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceVlanEncapSingleTagged) DeepCopyInto(out *InterfaceSubinterfaceVlanEncapSingleTagged) {
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) DeepCopyInto(out *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) {
 	*out = *in
-	if in.VlanId != nil {
-		in, out := &in.VlanId, &out.VlanId
+	if in.Ipv6Address != nil {
+		in, out := &in.Ipv6Address, &out.Ipv6Address
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlanEncapSingleTagged.
-func (in *InterfaceSubinterfaceVlanEncapSingleTagged) DeepCopy() *InterfaceSubinterfaceVlanEncapSingleTagged {
-	if in == nil {
-		return nil
+	if in.LinkLayerAddress != nil {
+		in, out := &in.LinkLayerAddress, &out.LinkLayerAddress
+		*out = new(string)
+		**out = **in
 	}
-	out := new(InterfaceSubinterfaceVlanEncapSingleTagged)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSubinterfaceVlanEncapUntagged) DeepCopyInto(out *InterfaceSubinterfaceVlanEncapUntagged) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlanEncapUntagged.
-func (in *InterfaceSubinterfaceVlanEncapUntagged) DeepCopy() *InterfaceSubinterfaceVlanEncapUntagged {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor.
+func (in *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor) DeepCopy() *InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSubinterfaceVlanEncapUntagged)
+	out := new(InterfaceSubinterfaceIpv6NeighborDiscoveryNeighbor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceTransceiver) DeepCopyInto(out *InterfaceTransceiver) {
+func (in *InterfaceSubinterfaceIpv6RouterAdvertisement) DeepCopyInto(out *InterfaceSubinterfaceIpv6RouterAdvertisement) {
 	*out = *in
-	if in.DdmEvents != nil {
-		in, out := &in.DdmEvents, &out.DdmEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ForwardErrorCorrection != nil {
-		in, out := &in.ForwardErrorCorrection, &out.ForwardErrorCorrection
+	if in.Debug != nil {
+		in, out := &in.Debug, &out.Debug
 		*out = new(string)
 		**out = **in
 	}
-	if in.TxLaser != nil {
-		in, out := &in.TxLaser, &out.TxLaser
-		*out = new(bool)
-		**out = **in
+	if in.RouterRole != nil {
+		in, out := &in.RouterRole, &out.RouterRole
+		*out = new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceTransceiver.
-func (in *InterfaceTransceiver) DeepCopy() *InterfaceTransceiver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6RouterAdvertisement.
+func (in *InterfaceSubinterfaceIpv6RouterAdvertisement) DeepCopy() *InterfaceSubinterfaceIpv6RouterAdvertisement {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceTransceiver)
+	out := new(InterfaceSubinterfaceIpv6RouterAdvertisement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Networkinstance) DeepCopyInto(out *Networkinstance) {
+func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole) DeepCopyInto(out *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.BridgeTable != nil {
-		in, out := &in.BridgeTable, &out.BridgeTable
-		*out = new(NetworkinstanceBridgeTable)
-		(*in).DeepCopyInto(*out)
+	if in.CurrentHopLimit != nil {
+		in, out := &in.CurrentHopLimit, &out.CurrentHopLimit
+		*out = new(uint8)
+		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.IpMtu != nil {
+		in, out := &in.IpMtu, &out.IpMtu
+		*out = new(uint16)
 		**out = **in
 	}
-	if in.Interface != nil {
-		in, out := &in.Interface, &out.Interface
-		*out = make([]*NetworkinstanceInterface, len(*in))
+	if in.ManagedConfigurationFlag != nil {
+		in, out := &in.ManagedConfigurationFlag, &out.ManagedConfigurationFlag
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAdvertisementInterval != nil {
+		in, out := &in.MaxAdvertisementInterval, &out.MaxAdvertisementInterval
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.MinAdvertisementInterval != nil {
+		in, out := &in.MinAdvertisementInterval, &out.MinAdvertisementInterval
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.OtherConfigurationFlag != nil {
+		in, out := &in.OtherConfigurationFlag, &out.OtherConfigurationFlag
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = make([]*InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceInterface)
+				*out = new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.IpForwarding != nil {
-		in, out := &in.IpForwarding, &out.IpForwarding
-		*out = new(NetworkinstanceIpForwarding)
-		(*in).DeepCopyInto(*out)
+	if in.ReachableTime != nil {
+		in, out := &in.ReachableTime, &out.ReachableTime
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.IpLoadBalancing != nil {
-		in, out := &in.IpLoadBalancing, &out.IpLoadBalancing
-		*out = new(NetworkinstanceIpLoadBalancing)
-		(*in).DeepCopyInto(*out)
+	if in.RetransmitTime != nil {
+		in, out := &in.RetransmitTime, &out.RetransmitTime
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.Mpls != nil {
-		in, out := &in.Mpls, &out.Mpls
-		*out = new(NetworkinstanceMpls)
-		(*in).DeepCopyInto(*out)
+	if in.RouterLifetime != nil {
+		in, out := &in.RouterLifetime, &out.RouterLifetime
+		*out = new(uint16)
+		**out = **in
 	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
-		*out = new(NetworkinstanceMtu)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole.
+func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole) DeepCopy() *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole {
+	if in == nil {
+		return nil
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	out := new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix) DeepCopyInto(out *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix) {
+	*out = *in
+	if in.AutonomousFlag != nil {
+		in, out := &in.AutonomousFlag, &out.AutonomousFlag
+		*out = new(bool)
 		**out = **in
 	}
-	if in.RouterId != nil {
-		in, out := &in.RouterId, &out.RouterId
+	if in.Ipv6Prefix != nil {
+		in, out := &in.Ipv6Prefix, &out.Ipv6Prefix
 		*out = new(string)
 		**out = **in
 	}
-	if in.TrafficEngineering != nil {
-		in, out := &in.TrafficEngineering, &out.TrafficEngineering
-		*out = new(NetworkinstanceTrafficEngineering)
-		(*in).DeepCopyInto(*out)
+	if in.OnLinkFlag != nil {
+		in, out := &in.OnLinkFlag, &out.OnLinkFlag
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
+	if in.PreferredLifetime != nil {
+		in, out := &in.PreferredLifetime, &out.PreferredLifetime
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.VxlanInterface != nil {
-		in, out := &in.VxlanInterface, &out.VxlanInterface
-		*out = make([]*NetworkinstanceVxlanInterface, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceVxlanInterface)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.ValidLifetime != nil {
+		in, out := &in.ValidLifetime, &out.ValidLifetime
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networkinstance.
-func (in *Networkinstance) DeepCopy() *Networkinstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix.
+func (in *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix) DeepCopy() *InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix {
 	if in == nil {
 		return nil
 	}
-	out := new(Networkinstance)
+	out := new(InterfaceSubinterfaceIpv6RouterAdvertisementRouterRolePrefix)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutes) DeepCopyInto(out *NetworkinstanceAggregateroutes) {
+func (in *InterfaceSubinterfaceIpv6Vrrp) DeepCopyInto(out *InterfaceSubinterfaceIpv6Vrrp) {
 	*out = *in
-	if in.Route != nil {
-		in, out := &in.Route, &out.Route
-		*out = make([]*NetworkinstanceAggregateroutesRoute, len(*in))
+	if in.VrrpGroup != nil {
+		in, out := &in.VrrpGroup, &out.VrrpGroup
+		*out = make([]*InterfaceSubinterfaceIpv6VrrpVrrpGroup, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceAggregateroutesRoute)
+				*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroup)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutes.
-func (in *NetworkinstanceAggregateroutes) DeepCopy() *NetworkinstanceAggregateroutes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6Vrrp.
+func (in *InterfaceSubinterfaceIpv6Vrrp) DeepCopy() *InterfaceSubinterfaceIpv6Vrrp {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceAggregateroutes)
+	out := new(InterfaceSubinterfaceIpv6Vrrp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesObservation) DeepCopyInto(out *NetworkinstanceAggregateroutesObservation) {
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroup) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesObservation.
-func (in *NetworkinstanceAggregateroutesObservation) DeepCopy() *NetworkinstanceAggregateroutesObservation {
-	if in == nil {
-		return nil
+	if in.AcceptMode != nil {
+		in, out := &in.AcceptMode, &out.AcceptMode
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(NetworkinstanceAggregateroutesObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesParameters) DeepCopyInto(out *NetworkinstanceAggregateroutesParameters) {
-	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SrlNetworkinstanceAggregateroutes != nil {
-		in, out := &in.SrlNetworkinstanceAggregateroutes, &out.SrlNetworkinstanceAggregateroutes
-		*out = new(NetworkinstanceAggregateroutes)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesParameters.
-func (in *NetworkinstanceAggregateroutesParameters) DeepCopy() *NetworkinstanceAggregateroutesParameters {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceAggregateroutesParameters)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesRoute) DeepCopyInto(out *NetworkinstanceAggregateroutesRoute) {
-	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Aggregator != nil {
-		in, out := &in.Aggregator, &out.Aggregator
-		*out = new(NetworkinstanceAggregateroutesRouteAggregator)
+	if in.AdvertiseInterval != nil {
+		in, out := &in.AdvertiseInterval, &out.AdvertiseInterval
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Communities != nil {
-		in, out := &in.Communities, &out.Communities
-		*out = new(NetworkinstanceAggregateroutesRouteCommunities)
+	if in.InitDelay != nil {
+		in, out := &in.InitDelay, &out.InitDelay
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.InterfaceTracking != nil {
+		in, out := &in.InterfaceTracking, &out.InterfaceTracking
+		*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.GenerateIcmp != nil {
-		in, out := &in.GenerateIcmp, &out.GenerateIcmp
+	if in.MasterInheritInterval != nil {
+		in, out := &in.MasterInheritInterval, &out.MasterInheritInterval
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = new(string)
+	if in.OperInterval != nil {
+		in, out := &in.OperInterval, &out.OperInterval
+		*out = new(uint16)
 		**out = **in
 	}
-	if in.SummaryOnly != nil {
-		in, out := &in.SummaryOnly, &out.SummaryOnly
+	if in.Preempt != nil {
+		in, out := &in.Preempt, &out.Preempt
 		*out = new(bool)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesRoute.
-func (in *NetworkinstanceAggregateroutesRoute) DeepCopy() *NetworkinstanceAggregateroutesRoute {
-	if in == nil {
-		return nil
+	if in.PreemptDelay != nil {
+		in, out := &in.PreemptDelay, &out.PreemptDelay
+		*out = new(uint16)
+		**out = **in
 	}
-	out := new(NetworkinstanceAggregateroutesRoute)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesRouteAggregator) DeepCopyInto(out *NetworkinstanceAggregateroutesRouteAggregator) {
-	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.VirtualAddress != nil {
+		in, out := &in.VirtualAddress, &out.VirtualAddress
 		*out = new(string)
 		**out = **in
 	}
-	if in.AsNumber != nil {
-		in, out := &in.AsNumber, &out.AsNumber
-		*out = new(uint32)
+	if in.VirtualRouterId != nil {
+		in, out := &in.VirtualRouterId, &out.VirtualRouterId
+		*out = new(uint8)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesRouteAggregator.
-func (in *NetworkinstanceAggregateroutesRouteAggregator) DeepCopy() *NetworkinstanceAggregateroutesRouteAggregator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroup.
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroup) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceAggregateroutesRouteAggregator)
+	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesRouteCommunities) DeepCopyInto(out *NetworkinstanceAggregateroutesRouteCommunities) {
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) {
 	*out = *in
-	if in.Add != nil {
-		in, out := &in.Add, &out.Add
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesRouteCommunities.
-func (in *NetworkinstanceAggregateroutesRouteCommunities) DeepCopy() *NetworkinstanceAggregateroutesRouteCommunities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication.
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceAggregateroutesRouteCommunities)
+	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesSpec) DeepCopyInto(out *NetworkinstanceAggregateroutesSpec) {
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.TrackInterface != nil {
+		in, out := &in.TrackInterface, &out.TrackInterface
+		*out = make([]*InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesSpec.
-func (in *NetworkinstanceAggregateroutesSpec) DeepCopy() *NetworkinstanceAggregateroutesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking.
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceAggregateroutesSpec)
+	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTracking)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceAggregateroutesStatus) DeepCopyInto(out *NetworkinstanceAggregateroutesStatus) {
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = new(string)
+		**out = **in
+	}
+	if in.PriorityDecrement != nil {
+		in, out := &in.PriorityDecrement, &out.PriorityDecrement
+		*out = new(uint8)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesStatus.
-func (in *NetworkinstanceAggregateroutesStatus) DeepCopy() *NetworkinstanceAggregateroutesStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface.
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceAggregateroutesStatus)
+	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupInterfaceTrackingTrackInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTable) DeepCopyInto(out *NetworkinstanceBridgeTable) {
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics) DeepCopyInto(out *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics) {
 	*out = *in
-	if in.DiscardUnknownDestMac != nil {
-		in, out := &in.DiscardUnknownDestMac, &out.DiscardUnknownDestMac
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MacDuplication != nil {
-		in, out := &in.MacDuplication, &out.MacDuplication
-		*out = new(NetworkinstanceBridgeTableMacDuplication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MacLearning != nil {
-		in, out := &in.MacLearning, &out.MacLearning
-		*out = new(NetworkinstanceBridgeTableMacLearning)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MacLimit != nil {
-		in, out := &in.MacLimit, &out.MacLimit
-		*out = new(NetworkinstanceBridgeTableMacLimit)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ProtectAnycastGwMac != nil {
-		in, out := &in.ProtectAnycastGwMac, &out.ProtectAnycastGwMac
-		*out = new(bool)
-		**out = **in
-	}
-	if in.StaticMac != nil {
-		in, out := &in.StaticMac, &out.StaticMac
-		*out = new(NetworkinstanceBridgeTableStaticMac)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTable.
-func (in *NetworkinstanceBridgeTable) DeepCopy() *NetworkinstanceBridgeTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics.
+func (in *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics) DeepCopy() *InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTable)
+	out := new(InterfaceSubinterfaceIpv6VrrpVrrpGroupStatistics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTableMacDuplication) DeepCopyInto(out *NetworkinstanceBridgeTableMacDuplication) {
+func (in *InterfaceSubinterfaceLocalMirrorDestination) DeepCopyInto(out *InterfaceSubinterfaceLocalMirrorDestination) {
 	*out = *in
-	if in.Action != nil {
-		in, out := &in.Action, &out.Action
-		*out = new(string)
-		**out = **in
-	}
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.HoldDownTime != nil {
-		in, out := &in.HoldDownTime, &out.HoldDownTime
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MonitoringWindow != nil {
-		in, out := &in.MonitoringWindow, &out.MonitoringWindow
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.NumMoves != nil {
-		in, out := &in.NumMoves, &out.NumMoves
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacDuplication.
-func (in *NetworkinstanceBridgeTableMacDuplication) DeepCopy() *NetworkinstanceBridgeTableMacDuplication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceLocalMirrorDestination.
+func (in *InterfaceSubinterfaceLocalMirrorDestination) DeepCopy() *InterfaceSubinterfaceLocalMirrorDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTableMacDuplication)
+	out := new(InterfaceSubinterfaceLocalMirrorDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTableMacLearning) DeepCopyInto(out *NetworkinstanceBridgeTableMacLearning) {
+func (in *InterfaceSubinterfaceObservation) DeepCopyInto(out *InterfaceSubinterfaceObservation) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Aging != nil {
-		in, out := &in.Aging, &out.Aging
-		*out = new(NetworkinstanceBridgeTableMacLearningAging)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacLearning.
-func (in *NetworkinstanceBridgeTableMacLearning) DeepCopy() *NetworkinstanceBridgeTableMacLearning {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceObservation.
+func (in *InterfaceSubinterfaceObservation) DeepCopy() *InterfaceSubinterfaceObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTableMacLearning)
+	out := new(InterfaceSubinterfaceObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTableMacLearningAging) DeepCopyInto(out *NetworkinstanceBridgeTableMacLearningAging) {
+func (in *InterfaceSubinterfaceParameters) DeepCopyInto(out *InterfaceSubinterfaceParameters) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
 		*out = new(string)
 		**out = **in
 	}
-	if in.AgeTime != nil {
-		in, out := &in.AgeTime, &out.AgeTime
-		*out = new(int32)
-		**out = **in
+	if in.SrlInterfaceSubinterface != nil {
+		in, out := &in.SrlInterfaceSubinterface, &out.SrlInterfaceSubinterface
+		*out = new(InterfaceSubinterface)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacLearningAging.
-func (in *NetworkinstanceBridgeTableMacLearningAging) DeepCopy() *NetworkinstanceBridgeTableMacLearningAging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceParameters.
+func (in *InterfaceSubinterfaceParameters) DeepCopy() *InterfaceSubinterfaceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTableMacLearningAging)
+	out := new(InterfaceSubinterfaceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTableMacLimit) DeepCopyInto(out *NetworkinstanceBridgeTableMacLimit) {
+func (in *InterfaceSubinterfaceQos) DeepCopyInto(out *InterfaceSubinterfaceQos) {
 	*out = *in
-	if in.MaximumEntries != nil {
-		in, out := &in.MaximumEntries, &out.MaximumEntries
-		*out = new(int32)
-		**out = **in
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(InterfaceSubinterfaceQosInput)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.WarningThresholdPct != nil {
-		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
-		*out = new(int32)
-		**out = **in
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(InterfaceSubinterfaceQosOutput)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacLimit.
-func (in *NetworkinstanceBridgeTableMacLimit) DeepCopy() *NetworkinstanceBridgeTableMacLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQos.
+func (in *InterfaceSubinterfaceQos) DeepCopy() *InterfaceSubinterfaceQos {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTableMacLimit)
+	out := new(InterfaceSubinterfaceQos)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTableStaticMac) DeepCopyInto(out *NetworkinstanceBridgeTableStaticMac) {
+func (in *InterfaceSubinterfaceQosInput) DeepCopyInto(out *InterfaceSubinterfaceQosInput) {
 	*out = *in
-	if in.Mac != nil {
-		in, out := &in.Mac, &out.Mac
-		*out = make([]*NetworkinstanceBridgeTableStaticMacMac, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceBridgeTableStaticMacMac)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Classifiers != nil {
+		in, out := &in.Classifiers, &out.Classifiers
+		*out = new(InterfaceSubinterfaceQosInputClassifiers)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableStaticMac.
-func (in *NetworkinstanceBridgeTableStaticMac) DeepCopy() *NetworkinstanceBridgeTableStaticMac {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosInput.
+func (in *InterfaceSubinterfaceQosInput) DeepCopy() *InterfaceSubinterfaceQosInput {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTableStaticMac)
+	out := new(InterfaceSubinterfaceQosInput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceBridgeTableStaticMacMac) DeepCopyInto(out *NetworkinstanceBridgeTableStaticMacMac) {
+func (in *InterfaceSubinterfaceQosInputClassifiers) DeepCopyInto(out *InterfaceSubinterfaceQosInputClassifiers) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
+	if in.Dscp != nil {
+		in, out := &in.Dscp, &out.Dscp
 		*out = new(string)
 		**out = **in
 	}
-	if in.Destination != nil {
-		in, out := &in.Destination, &out.Destination
+	if in.Ipv4Dscp != nil {
+		in, out := &in.Ipv4Dscp, &out.Ipv4Dscp
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv6Dscp != nil {
+		in, out := &in.Ipv6Dscp, &out.Ipv6Dscp
+		*out = new(string)
+		**out = **in
+	}
+	if in.MplsTrafficClass != nil {
+		in, out := &in.MplsTrafficClass, &out.MplsTrafficClass
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableStaticMacMac.
-func (in *NetworkinstanceBridgeTableStaticMacMac) DeepCopy() *NetworkinstanceBridgeTableStaticMacMac {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosInputClassifiers.
+func (in *InterfaceSubinterfaceQosInputClassifiers) DeepCopy() *InterfaceSubinterfaceQosInputClassifiers {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceBridgeTableStaticMacMac)
+	out := new(InterfaceSubinterfaceQosInputClassifiers)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceInterface) DeepCopyInto(out *NetworkinstanceInterface) {
+func (in *InterfaceSubinterfaceQosOutput) DeepCopyInto(out *InterfaceSubinterfaceQosOutput) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	if in.RewriteRules != nil {
+		in, out := &in.RewriteRules, &out.RewriteRules
+		*out = new(InterfaceSubinterfaceQosOutputRewriteRules)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceInterface.
-func (in *NetworkinstanceInterface) DeepCopy() *NetworkinstanceInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosOutput.
+func (in *InterfaceSubinterfaceQosOutput) DeepCopy() *InterfaceSubinterfaceQosOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceInterface)
+	out := new(InterfaceSubinterfaceQosOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceIpForwarding) DeepCopyInto(out *NetworkinstanceIpForwarding) {
+func (in *InterfaceSubinterfaceQosOutputRewriteRules) DeepCopyInto(out *InterfaceSubinterfaceQosOutputRewriteRules) {
 	*out = *in
-	if in.ReceiveIpv4Check != nil {
-		in, out := &in.ReceiveIpv4Check, &out.ReceiveIpv4Check
-		*out = new(bool)
+	if in.Dscp != nil {
+		in, out := &in.Dscp, &out.Dscp
+		*out = new(string)
 		**out = **in
 	}
-	if in.ReceiveIpv6Check != nil {
-		in, out := &in.ReceiveIpv6Check, &out.ReceiveIpv6Check
-		*out = new(bool)
+	if in.Ipv4Dscp != nil {
+		in, out := &in.Ipv4Dscp, &out.Ipv4Dscp
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv6Dscp != nil {
+		in, out := &in.Ipv6Dscp, &out.Ipv6Dscp
+		*out = new(string)
+		**out = **in
+	}
+	if in.MplsTrafficClass != nil {
+		in, out := &in.MplsTrafficClass, &out.MplsTrafficClass
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceIpForwarding.
-func (in *NetworkinstanceIpForwarding) DeepCopy() *NetworkinstanceIpForwarding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceQosOutputRewriteRules.
+func (in *InterfaceSubinterfaceQosOutputRewriteRules) DeepCopy() *InterfaceSubinterfaceQosOutputRewriteRules {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceIpForwarding)
+	out := new(InterfaceSubinterfaceQosOutputRewriteRules)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceIpLoadBalancing) DeepCopyInto(out *NetworkinstanceIpLoadBalancing) {
+func (in *InterfaceSubinterfaceSpec) DeepCopyInto(out *InterfaceSubinterfaceSpec) {
 	*out = *in
-	if in.ResilientHashPrefix != nil {
-		in, out := &in.ResilientHashPrefix, &out.ResilientHashPrefix
-		*out = make([]*NetworkinstanceIpLoadBalancingResilientHashPrefix, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceIpLoadBalancingResilientHashPrefix)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceIpLoadBalancing.
-func (in *NetworkinstanceIpLoadBalancing) DeepCopy() *NetworkinstanceIpLoadBalancing {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceSpec.
+func (in *InterfaceSubinterfaceSpec) DeepCopy() *InterfaceSubinterfaceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceIpLoadBalancing)
+	out := new(InterfaceSubinterfaceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceIpLoadBalancingResilientHashPrefix) DeepCopyInto(out *NetworkinstanceIpLoadBalancingResilientHashPrefix) {
+func (in *InterfaceSubinterfaceStatus) DeepCopyInto(out *InterfaceSubinterfaceStatus) {
 	*out = *in
-	if in.HashBucketsPerPath != nil {
-		in, out := &in.HashBucketsPerPath, &out.HashBucketsPerPath
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.IpPrefix != nil {
-		in, out := &in.IpPrefix, &out.IpPrefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxPaths != nil {
-		in, out := &in.MaxPaths, &out.MaxPaths
-		*out = new(uint8)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceIpLoadBalancingResilientHashPrefix.
-func (in *NetworkinstanceIpLoadBalancingResilientHashPrefix) DeepCopy() *NetworkinstanceIpLoadBalancingResilientHashPrefix {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceStatus.
+func (in *InterfaceSubinterfaceStatus) DeepCopy() *InterfaceSubinterfaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceIpLoadBalancingResilientHashPrefix)
+	out := new(InterfaceSubinterfaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceMpls) DeepCopyInto(out *NetworkinstanceMpls) {
+func (in *InterfaceSubinterfaceVlan) DeepCopyInto(out *InterfaceSubinterfaceVlan) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.StaticMplsEntry != nil {
-		in, out := &in.StaticMplsEntry, &out.StaticMplsEntry
-		*out = make([]*NetworkinstanceMplsStaticMplsEntry, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceMplsStaticMplsEntry)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TtlPropagation != nil {
-		in, out := &in.TtlPropagation, &out.TtlPropagation
-		*out = new(bool)
-		**out = **in
+	if in.Encap != nil {
+		in, out := &in.Encap, &out.Encap
+		*out = new(InterfaceSubinterfaceVlanEncap)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceMpls.
-func (in *NetworkinstanceMpls) DeepCopy() *NetworkinstanceMpls {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlan.
+func (in *InterfaceSubinterfaceVlan) DeepCopy() *InterfaceSubinterfaceVlan {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceMpls)
+	out := new(InterfaceSubinterfaceVlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceMplsStaticMplsEntry) DeepCopyInto(out *NetworkinstanceMplsStaticMplsEntry) {
+func (in *InterfaceSubinterfaceVlanEncap) DeepCopyInto(out *InterfaceSubinterfaceVlanEncap) {
 	*out = *in
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NextHopGroup != nil {
-		in, out := &in.NextHopGroup, &out.NextHopGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.Operation != nil {
-		in, out := &in.Operation, &out.Operation
-		*out = new(string)
-		**out = **in
-	}
-	if in.Preference != nil {
-		in, out := &in.Preference, &out.Preference
-		*out = new(uint8)
-		**out = **in
+	if in.SingleTagged != nil {
+		in, out := &in.SingleTagged, &out.SingleTagged
+		*out = new(InterfaceSubinterfaceVlanEncapSingleTagged)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.TopLabel != nil {
-		in, out := &in.TopLabel, &out.TopLabel
-		*out = new(string)
+	if in.Untagged != nil {
+		in, out := &in.Untagged, &out.Untagged
+		*out = new(InterfaceSubinterfaceVlanEncapUntagged)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceMplsStaticMplsEntry.
-func (in *NetworkinstanceMplsStaticMplsEntry) DeepCopy() *NetworkinstanceMplsStaticMplsEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlanEncap.
+func (in *InterfaceSubinterfaceVlanEncap) DeepCopy() *InterfaceSubinterfaceVlanEncap {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceMplsStaticMplsEntry)
+	out := new(InterfaceSubinterfaceVlanEncap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceMtu) DeepCopyInto(out *NetworkinstanceMtu) {
+func (in *InterfaceSubinterfaceVlanEncapSingleTagged) DeepCopyInto(out *InterfaceSubinterfaceVlanEncapSingleTagged) {
 	*out = *in
-	if in.PathMtuDiscovery != nil {
-		in, out := &in.PathMtuDiscovery, &out.PathMtuDiscovery
-		*out = new(bool)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceMtu.
-func (in *NetworkinstanceMtu) DeepCopy() *NetworkinstanceMtu {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceMtu)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroups) DeepCopyInto(out *NetworkinstanceNexthopgroups) {
-	*out = *in
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*NetworkinstanceNexthopgroupsGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceNexthopgroupsGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroups.
-func (in *NetworkinstanceNexthopgroups) DeepCopy() *NetworkinstanceNexthopgroups {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceNexthopgroups)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsGroup) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroup) {
-	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Blackhole != nil {
-		in, out := &in.Blackhole, &out.Blackhole
-		*out = new(NetworkinstanceNexthopgroupsGroupBlackhole)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.VlanId != nil {
+		in, out := &in.VlanId, &out.VlanId
 		*out = new(string)
 		**out = **in
 	}
-	if in.Nexthop != nil {
-		in, out := &in.Nexthop, &out.Nexthop
-		*out = make([]*NetworkinstanceNexthopgroupsGroupNexthop, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceNexthopgroupsGroupNexthop)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroup.
-func (in *NetworkinstanceNexthopgroupsGroup) DeepCopy() *NetworkinstanceNexthopgroupsGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlanEncapSingleTagged.
+func (in *InterfaceSubinterfaceVlanEncapSingleTagged) DeepCopy() *InterfaceSubinterfaceVlanEncapSingleTagged {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceNexthopgroupsGroup)
+	out := new(InterfaceSubinterfaceVlanEncapSingleTagged)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsGroupBlackhole) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupBlackhole) {
+func (in *InterfaceSubinterfaceVlanEncapUntagged) DeepCopyInto(out *InterfaceSubinterfaceVlanEncapUntagged) {
 	*out = *in
-	if in.GenerateIcmp != nil {
-		in, out := &in.GenerateIcmp, &out.GenerateIcmp
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupBlackhole.
-func (in *NetworkinstanceNexthopgroupsGroupBlackhole) DeepCopy() *NetworkinstanceNexthopgroupsGroupBlackhole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSubinterfaceVlanEncapUntagged.
+func (in *InterfaceSubinterfaceVlanEncapUntagged) DeepCopy() *InterfaceSubinterfaceVlanEncapUntagged {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceNexthopgroupsGroupBlackhole)
+	out := new(InterfaceSubinterfaceVlanEncapUntagged)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsGroupNexthop) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupNexthop) {
+func (in *InterfaceTransceiver) DeepCopyInto(out *InterfaceTransceiver) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.FailureDetection != nil {
-		in, out := &in.FailureDetection, &out.FailureDetection
-		*out = new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Index != nil {
-		in, out := &in.Index, &out.Index
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.IpAddress != nil {
-		in, out := &in.IpAddress, &out.IpAddress
-		*out = new(string)
+	if in.DdmEvents != nil {
+		in, out := &in.DdmEvents, &out.DdmEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.PushedMplsLabelStack != nil {
-		in, out := &in.PushedMplsLabelStack, &out.PushedMplsLabelStack
+	if in.ForwardErrorCorrection != nil {
+		in, out := &in.ForwardErrorCorrection, &out.ForwardErrorCorrection
 		*out = new(string)
 		**out = **in
 	}
-	if in.Resolve != nil {
-		in, out := &in.Resolve, &out.Resolve
+	if in.TxLaser != nil {
+		in, out := &in.TxLaser, &out.TxLaser
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupNexthop.
-func (in *NetworkinstanceNexthopgroupsGroupNexthop) DeepCopy() *NetworkinstanceNexthopgroupsGroupNexthop {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceTransceiver.
+func (in *InterfaceTransceiver) DeepCopy() *InterfaceTransceiver {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceNexthopgroupsGroupNexthop)
+	out := new(InterfaceTransceiver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection) {
+func (in *IpPoolSpec) DeepCopyInto(out *IpPoolSpec) {
 	*out = *in
-	if in.EnableBfd != nil {
-		in, out := &in.EnableBfd, &out.EnableBfd
-		*out = new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupNexthopFailureDetection.
-func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection) DeepCopy() *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IpPoolSpec.
+func (in *IpPoolSpec) DeepCopy() *IpPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetection)
+	out := new(IpPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd) {
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
 	*out = *in
-	if in.LocalAddress != nil {
-		in, out := &in.LocalAddress, &out.LocalAddress
-		*out = new(string)
-		**out = **in
-	}
-	if in.LocalDiscriminator != nil {
-		in, out := &in.LocalDiscriminator, &out.LocalDiscriminator
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RemoteDiscriminator != nil {
-		in, out := &in.RemoteDiscriminator, &out.RemoteDiscriminator
-		*out = new(uint32)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd.
-func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd) DeepCopy() *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd)
+	out := new(MaintenanceWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsObservation) DeepCopyInto(out *NetworkinstanceNexthopgroupsObservation) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsObservation.
-func (in *NetworkinstanceNexthopgroupsObservation) DeepCopy() *NetworkinstanceNexthopgroupsObservation {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(NetworkinstanceNexthopgroupsObservation)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsParameters) DeepCopyInto(out *NetworkinstanceNexthopgroupsParameters) {
+func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SrlNetworkinstanceNexthopgroups != nil {
-		in, out := &in.SrlNetworkinstanceNexthopgroups, &out.SrlNetworkinstanceNexthopgroups
-		*out = new(NetworkinstanceNexthopgroups)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsParameters.
-func (in *NetworkinstanceNexthopgroupsParameters) DeepCopy() *NetworkinstanceNexthopgroupsParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowList.
+func (in *MaintenanceWindowList) DeepCopy() *MaintenanceWindowList {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceNexthopgroupsParameters)
+	out := new(MaintenanceWindowList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsSpec) DeepCopyInto(out *NetworkinstanceNexthopgroupsSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsSpec.
-func (in *NetworkinstanceNexthopgroupsSpec) DeepCopy() *NetworkinstanceNexthopgroupsSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(NetworkinstanceNexthopgroupsSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceNexthopgroupsStatus) DeepCopyInto(out *NetworkinstanceNexthopgroupsStatus) {
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsStatus.
-func (in *NetworkinstanceNexthopgroupsStatus) DeepCopy() *NetworkinstanceNexthopgroupsStatus {
-	if in == nil {
-		return nil
+	out.Duration = in.Duration
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(NetworkinstanceNexthopgroupsStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceObservation) DeepCopyInto(out *NetworkinstanceObservation) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceObservation.
-func (in *NetworkinstanceObservation) DeepCopy() *NetworkinstanceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceObservation)
+	out := new(MaintenanceWindowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceParameters) DeepCopyInto(out *NetworkinstanceParameters) {
+func (in *NetworkNodeSyncStatus) DeepCopyInto(out *NetworkNodeSyncStatus) {
 	*out = *in
-	if in.SrlNetworkinstance != nil {
-		in, out := &in.SrlNetworkinstance, &out.SrlNetworkinstance
-		*out = new(Networkinstance)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceParameters.
-func (in *NetworkinstanceParameters) DeepCopy() *NetworkinstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkNodeSyncStatus.
+func (in *NetworkNodeSyncStatus) DeepCopy() *NetworkNodeSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceParameters)
+	out := new(NetworkNodeSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgp) DeepCopyInto(out *NetworkinstanceProtocolsBgp) {
+func (in *Networkinstance) DeepCopyInto(out *Networkinstance) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.AsPathOptions != nil {
-		in, out := &in.AsPathOptions, &out.AsPathOptions
-		*out = new(NetworkinstanceProtocolsBgpAsPathOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsBgpAuthentication)
+	if in.BridgeTable != nil {
+		in, out := &in.BridgeTable, &out.BridgeTable
+		*out = new(NetworkinstanceBridgeTable)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.AutonomousSystem != nil {
-		in, out := &in.AutonomousSystem, &out.AutonomousSystem
-		*out = new(uint32)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.Convergence != nil {
-		in, out := &in.Convergence, &out.Convergence
-		*out = new(NetworkinstanceProtocolsBgpConvergence)
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = make([]*NetworkinstanceInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IpForwarding != nil {
+		in, out := &in.IpForwarding, &out.IpForwarding
+		*out = new(NetworkinstanceIpForwarding)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DynamicNeighbors != nil {
-		in, out := &in.DynamicNeighbors, &out.DynamicNeighbors
-		*out = new(NetworkinstanceProtocolsBgpDynamicNeighbors)
+	if in.IpLoadBalancing != nil {
+		in, out := &in.IpLoadBalancing, &out.IpLoadBalancing
+		*out = new(NetworkinstanceIpLoadBalancing)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.EbgpDefaultPolicy != nil {
-		in, out := &in.EbgpDefaultPolicy, &out.EbgpDefaultPolicy
-		*out = new(NetworkinstanceProtocolsBgpEbgpDefaultPolicy)
+	if in.Mpls != nil {
+		in, out := &in.Mpls, &out.Mpls
+		*out = new(NetworkinstanceMpls)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Evpn != nil {
-		in, out := &in.Evpn, &out.Evpn
-		*out = new(NetworkinstanceProtocolsBgpEvpn)
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(NetworkinstanceMtu)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.FailureDetection != nil {
-		in, out := &in.FailureDetection, &out.FailureDetection
-		*out = new(NetworkinstanceProtocolsBgpFailureDetection)
-		(*in).DeepCopyInto(*out)
+	if in.RouterId != nil {
+		in, out := &in.RouterId, &out.RouterId
+		*out = new(string)
+		**out = **in
 	}
-	if in.GracefulRestart != nil {
-		in, out := &in.GracefulRestart, &out.GracefulRestart
-		*out = new(NetworkinstanceProtocolsBgpGracefulRestart)
+	if in.RouterIdPoolRef != nil {
+		in, out := &in.RouterIdPoolRef, &out.RouterIdPoolRef
+		*out = new(PoolReference)
+		**out = **in
+	}
+	if in.TrafficEngineering != nil {
+		in, out := &in.TrafficEngineering, &out.TrafficEngineering
+		*out = new(NetworkinstanceTrafficEngineering)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*NetworkinstanceProtocolsBgpGroup, len(*in))
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.VxlanInterface != nil {
+		in, out := &in.VxlanInterface, &out.VxlanInterface
+		*out = make([]*NetworkinstanceVxlanInterface, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpGroup)
+				*out = new(NetworkinstanceVxlanInterface)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.ImportPolicy != nil {
-		in, out := &in.ImportPolicy, &out.ImportPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ipv4Unicast != nil {
-		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
-		*out = new(NetworkinstanceProtocolsBgpIpv4Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ipv6Unicast != nil {
-		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
-		*out = new(NetworkinstanceProtocolsBgpIpv6Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LocalPreference != nil {
-		in, out := &in.LocalPreference, &out.LocalPreference
-		*out = new(uint32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networkinstance.
+func (in *Networkinstance) DeepCopy() *Networkinstance {
+	if in == nil {
+		return nil
 	}
-	if in.Neighbor != nil {
-		in, out := &in.Neighbor, &out.Neighbor
-		*out = make([]*NetworkinstanceProtocolsBgpNeighbor, len(*in))
+	out := new(Networkinstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceAggregateroutes) DeepCopyInto(out *NetworkinstanceAggregateroutes) {
+	*out = *in
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = make([]*NetworkinstanceAggregateroutesRoute, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpNeighbor)
+				*out = new(NetworkinstanceAggregateroutesRoute)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Preference != nil {
-		in, out := &in.Preference, &out.Preference
-		*out = new(NetworkinstanceProtocolsBgpPreference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RouteAdvertisement != nil {
-		in, out := &in.RouteAdvertisement, &out.RouteAdvertisement
-		*out = new(NetworkinstanceProtocolsBgpRouteAdvertisement)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RouteReflector != nil {
-		in, out := &in.RouteReflector, &out.RouteReflector
-		*out = new(NetworkinstanceProtocolsBgpRouteReflector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RouterId != nil {
-		in, out := &in.RouterId, &out.RouterId
-		*out = new(string)
-		**out = **in
-	}
-	if in.SendCommunity != nil {
-		in, out := &in.SendCommunity, &out.SendCommunity
-		*out = new(NetworkinstanceProtocolsBgpSendCommunity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsBgpTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Transport != nil {
-		in, out := &in.Transport, &out.Transport
-		*out = new(NetworkinstanceProtocolsBgpTransport)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutes.
+func (in *NetworkinstanceAggregateroutes) DeepCopy() *NetworkinstanceAggregateroutes {
+	if in == nil {
+		return nil
 	}
+	out := new(NetworkinstanceAggregateroutes)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgp.
-func (in *NetworkinstanceProtocolsBgp) DeepCopy() *NetworkinstanceProtocolsBgp {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceAggregateroutesObservation) DeepCopyInto(out *NetworkinstanceAggregateroutesObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesObservation.
+func (in *NetworkinstanceAggregateroutesObservation) DeepCopy() *NetworkinstanceAggregateroutesObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgp)
+	out := new(NetworkinstanceAggregateroutesObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpAsPathOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpAsPathOptions) {
+func (in *NetworkinstanceAggregateroutesParameters) DeepCopyInto(out *NetworkinstanceAggregateroutesParameters) {
 	*out = *in
-	if in.AllowOwnAs != nil {
-		in, out := &in.AllowOwnAs, &out.AllowOwnAs
-		*out = new(uint8)
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
 		**out = **in
 	}
-	if in.RemovePrivateAs != nil {
-		in, out := &in.RemovePrivateAs, &out.RemovePrivateAs
-		*out = new(NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs)
+	if in.SrlNetworkinstanceAggregateroutes != nil {
+		in, out := &in.SrlNetworkinstanceAggregateroutes, &out.SrlNetworkinstanceAggregateroutes
+		*out = new(NetworkinstanceAggregateroutes)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpAsPathOptions.
-func (in *NetworkinstanceProtocolsBgpAsPathOptions) DeepCopy() *NetworkinstanceProtocolsBgpAsPathOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesParameters.
+func (in *NetworkinstanceAggregateroutesParameters) DeepCopy() *NetworkinstanceAggregateroutesParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpAsPathOptions)
+	out := new(NetworkinstanceAggregateroutesParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs) {
+func (in *NetworkinstanceAggregateroutesRoute) DeepCopyInto(out *NetworkinstanceAggregateroutesRoute) {
 	*out = *in
-	if in.IgnorePeerAs != nil {
-		in, out := &in.IgnorePeerAs, &out.IgnorePeerAs
-		*out = new(bool)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.LeadingOnly != nil {
-		in, out := &in.LeadingOnly, &out.LeadingOnly
+	if in.Aggregator != nil {
+		in, out := &in.Aggregator, &out.Aggregator
+		*out = new(NetworkinstanceAggregateroutesRouteAggregator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = new(NetworkinstanceAggregateroutesRouteCommunities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GenerateIcmp != nil {
+		in, out := &in.GenerateIcmp, &out.GenerateIcmp
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
 		*out = new(string)
 		**out = **in
 	}
+	if in.SummaryOnly != nil {
+		in, out := &in.SummaryOnly, &out.SummaryOnly
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs.
-func (in *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs) DeepCopy() *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesRoute.
+func (in *NetworkinstanceAggregateroutesRoute) DeepCopy() *NetworkinstanceAggregateroutesRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs)
+	out := new(NetworkinstanceAggregateroutesRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsBgpAuthentication) {
+func (in *NetworkinstanceAggregateroutesRouteAggregator) DeepCopyInto(out *NetworkinstanceAggregateroutesRouteAggregator) {
 	*out = *in
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
 		*out = new(string)
 		**out = **in
 	}
+	if in.AsNumber != nil {
+		in, out := &in.AsNumber, &out.AsNumber
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpAuthentication.
-func (in *NetworkinstanceProtocolsBgpAuthentication) DeepCopy() *NetworkinstanceProtocolsBgpAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesRouteAggregator.
+func (in *NetworkinstanceAggregateroutesRouteAggregator) DeepCopy() *NetworkinstanceAggregateroutesRouteAggregator {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpAuthentication)
+	out := new(NetworkinstanceAggregateroutesRouteAggregator)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpConvergence) DeepCopyInto(out *NetworkinstanceProtocolsBgpConvergence) {
+func (in *NetworkinstanceAggregateroutesRouteCommunities) DeepCopyInto(out *NetworkinstanceAggregateroutesRouteCommunities) {
 	*out = *in
-	if in.MinWaitToAdvertise != nil {
-		in, out := &in.MinWaitToAdvertise, &out.MinWaitToAdvertise
-		*out = new(uint16)
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpConvergence.
-func (in *NetworkinstanceProtocolsBgpConvergence) DeepCopy() *NetworkinstanceProtocolsBgpConvergence {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesRouteCommunities.
+func (in *NetworkinstanceAggregateroutesRouteCommunities) DeepCopy() *NetworkinstanceAggregateroutesRouteCommunities {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpConvergence)
+	out := new(NetworkinstanceAggregateroutesRouteCommunities)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpDynamicNeighbors) DeepCopyInto(out *NetworkinstanceProtocolsBgpDynamicNeighbors) {
+func (in *NetworkinstanceAggregateroutesSpec) DeepCopyInto(out *NetworkinstanceAggregateroutesSpec) {
 	*out = *in
-	if in.Accept != nil {
-		in, out := &in.Accept, &out.Accept
-		*out = new(NetworkinstanceProtocolsBgpDynamicNeighborsAccept)
-		(*in).DeepCopyInto(*out)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesSpec.
+func (in *NetworkinstanceAggregateroutesSpec) DeepCopy() *NetworkinstanceAggregateroutesSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(NetworkinstanceAggregateroutesSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpDynamicNeighbors.
-func (in *NetworkinstanceProtocolsBgpDynamicNeighbors) DeepCopy() *NetworkinstanceProtocolsBgpDynamicNeighbors {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceAggregateroutesStatus) DeepCopyInto(out *NetworkinstanceAggregateroutesStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceAggregateroutesStatus.
+func (in *NetworkinstanceAggregateroutesStatus) DeepCopy() *NetworkinstanceAggregateroutesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpDynamicNeighbors)
+	out := new(NetworkinstanceAggregateroutesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAccept) DeepCopyInto(out *NetworkinstanceProtocolsBgpDynamicNeighborsAccept) {
+func (in *NetworkinstanceBridgeTable) DeepCopyInto(out *NetworkinstanceBridgeTable) {
 	*out = *in
-	if in.Match != nil {
-		in, out := &in.Match, &out.Match
-		*out = make([]*NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.DiscardUnknownDestMac != nil {
+		in, out := &in.DiscardUnknownDestMac, &out.DiscardUnknownDestMac
+		*out = new(bool)
+		**out = **in
 	}
-	if in.MaxSessions != nil {
-		in, out := &in.MaxSessions, &out.MaxSessions
-		*out = new(uint16)
+	if in.MacDuplication != nil {
+		in, out := &in.MacDuplication, &out.MacDuplication
+		*out = new(NetworkinstanceBridgeTableMacDuplication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MacLearning != nil {
+		in, out := &in.MacLearning, &out.MacLearning
+		*out = new(NetworkinstanceBridgeTableMacLearning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MacLimit != nil {
+		in, out := &in.MacLimit, &out.MacLimit
+		*out = new(NetworkinstanceBridgeTableMacLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectAnycastGwMac != nil {
+		in, out := &in.ProtectAnycastGwMac, &out.ProtectAnycastGwMac
+		*out = new(bool)
 		**out = **in
 	}
+	if in.StaticMac != nil {
+		in, out := &in.StaticMac, &out.StaticMac
+		*out = new(NetworkinstanceBridgeTableStaticMac)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpDynamicNeighborsAccept.
-func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAccept) DeepCopy() *NetworkinstanceProtocolsBgpDynamicNeighborsAccept {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTable.
+func (in *NetworkinstanceBridgeTable) DeepCopy() *NetworkinstanceBridgeTable {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpDynamicNeighborsAccept)
+	out := new(NetworkinstanceBridgeTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch) DeepCopyInto(out *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch) {
+func (in *NetworkinstanceBridgeTableMacDuplication) DeepCopyInto(out *NetworkinstanceBridgeTableMacDuplication) {
 	*out = *in
-	if in.AllowedPeerAs != nil {
-		in, out := &in.AllowedPeerAs, &out.AllowedPeerAs
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
 		*out = new(string)
 		**out = **in
 	}
-	if in.PeerGroup != nil {
-		in, out := &in.PeerGroup, &out.PeerGroup
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = new(string)
+	if in.HoldDownTime != nil {
+		in, out := &in.HoldDownTime, &out.HoldDownTime
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MonitoringWindow != nil {
+		in, out := &in.MonitoringWindow, &out.MonitoringWindow
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NumMoves != nil {
+		in, out := &in.NumMoves, &out.NumMoves
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch.
-func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch) DeepCopy() *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacDuplication.
+func (in *NetworkinstanceBridgeTableMacDuplication) DeepCopy() *NetworkinstanceBridgeTableMacDuplication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch)
+	out := new(NetworkinstanceBridgeTableMacDuplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpEbgpDefaultPolicy) DeepCopyInto(out *NetworkinstanceProtocolsBgpEbgpDefaultPolicy) {
+func (in *NetworkinstanceBridgeTableMacLearning) DeepCopyInto(out *NetworkinstanceBridgeTableMacLearning) {
 	*out = *in
-	if in.ExportRejectAll != nil {
-		in, out := &in.ExportRejectAll, &out.ExportRejectAll
-		*out = new(bool)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.ImportRejectAll != nil {
-		in, out := &in.ImportRejectAll, &out.ImportRejectAll
-		*out = new(bool)
-		**out = **in
+	if in.Aging != nil {
+		in, out := &in.Aging, &out.Aging
+		*out = new(NetworkinstanceBridgeTableMacLearningAging)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpEbgpDefaultPolicy.
-func (in *NetworkinstanceProtocolsBgpEbgpDefaultPolicy) DeepCopy() *NetworkinstanceProtocolsBgpEbgpDefaultPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacLearning.
+func (in *NetworkinstanceBridgeTableMacLearning) DeepCopy() *NetworkinstanceBridgeTableMacLearning {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpEbgpDefaultPolicy)
+	out := new(NetworkinstanceBridgeTableMacLearning)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpEvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpEvpn) {
+func (in *NetworkinstanceBridgeTableMacLearningAging) DeepCopyInto(out *NetworkinstanceBridgeTableMacLearningAging) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertiseIpv6NextHops != nil {
-		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
-		*out = new(bool)
-		**out = **in
-	}
-	if in.KeepAllRoutes != nil {
-		in, out := &in.KeepAllRoutes, &out.KeepAllRoutes
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Multipath != nil {
-		in, out := &in.Multipath, &out.Multipath
-		*out = new(NetworkinstanceProtocolsBgpEvpnMultipath)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RapidUpdate != nil {
-		in, out := &in.RapidUpdate, &out.RapidUpdate
-		*out = new(bool)
+	if in.AgeTime != nil {
+		in, out := &in.AgeTime, &out.AgeTime
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpEvpn.
-func (in *NetworkinstanceProtocolsBgpEvpn) DeepCopy() *NetworkinstanceProtocolsBgpEvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacLearningAging.
+func (in *NetworkinstanceBridgeTableMacLearningAging) DeepCopy() *NetworkinstanceBridgeTableMacLearningAging {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpEvpn)
+	out := new(NetworkinstanceBridgeTableMacLearningAging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpEvpnMultipath) DeepCopyInto(out *NetworkinstanceProtocolsBgpEvpnMultipath) {
+func (in *NetworkinstanceBridgeTableMacLimit) DeepCopyInto(out *NetworkinstanceBridgeTableMacLimit) {
 	*out = *in
-	if in.AllowMultipleAs != nil {
-		in, out := &in.AllowMultipleAs, &out.AllowMultipleAs
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MaxPathsLevel1 != nil {
-		in, out := &in.MaxPathsLevel1, &out.MaxPathsLevel1
-		*out = new(uint32)
+	if in.MaximumEntries != nil {
+		in, out := &in.MaximumEntries, &out.MaximumEntries
+		*out = new(int32)
 		**out = **in
 	}
-	if in.MaxPathsLevel2 != nil {
-		in, out := &in.MaxPathsLevel2, &out.MaxPathsLevel2
-		*out = new(uint32)
+	if in.WarningThresholdPct != nil {
+		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpEvpnMultipath.
-func (in *NetworkinstanceProtocolsBgpEvpnMultipath) DeepCopy() *NetworkinstanceProtocolsBgpEvpnMultipath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableMacLimit.
+func (in *NetworkinstanceBridgeTableMacLimit) DeepCopy() *NetworkinstanceBridgeTableMacLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpEvpnMultipath)
+	out := new(NetworkinstanceBridgeTableMacLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsBgpFailureDetection) {
+func (in *NetworkinstanceBridgeTableStaticMac) DeepCopyInto(out *NetworkinstanceBridgeTableStaticMac) {
 	*out = *in
-	if in.EnableBfd != nil {
-		in, out := &in.EnableBfd, &out.EnableBfd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.FastFailover != nil {
-		in, out := &in.FastFailover, &out.FastFailover
-		*out = new(bool)
-		**out = **in
+	if in.Mac != nil {
+		in, out := &in.Mac, &out.Mac
+		*out = make([]*NetworkinstanceBridgeTableStaticMacMac, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceBridgeTableStaticMacMac)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpFailureDetection.
-func (in *NetworkinstanceProtocolsBgpFailureDetection) DeepCopy() *NetworkinstanceProtocolsBgpFailureDetection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableStaticMac.
+func (in *NetworkinstanceBridgeTableStaticMac) DeepCopy() *NetworkinstanceBridgeTableStaticMac {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpFailureDetection)
+	out := new(NetworkinstanceBridgeTableStaticMac)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpGracefulRestart) {
+func (in *NetworkinstanceBridgeTableStaticMacMac) DeepCopyInto(out *NetworkinstanceBridgeTableStaticMacMac) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
 		*out = new(string)
 		**out = **in
 	}
-	if in.StaleRoutesTime != nil {
-		in, out := &in.StaleRoutesTime, &out.StaleRoutesTime
-		*out = new(uint16)
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGracefulRestart.
-func (in *NetworkinstanceProtocolsBgpGracefulRestart) DeepCopy() *NetworkinstanceProtocolsBgpGracefulRestart {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceBridgeTableStaticMacMac.
+func (in *NetworkinstanceBridgeTableStaticMacMac) DeepCopy() *NetworkinstanceBridgeTableStaticMacMac {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGracefulRestart)
+	out := new(NetworkinstanceBridgeTableStaticMacMac)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroup) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroup) {
+func (in *NetworkinstanceInterface) DeepCopyInto(out *NetworkinstanceInterface) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.AsPathOptions != nil {
-		in, out := &in.AsPathOptions, &out.AsPathOptions
-		*out = new(NetworkinstanceProtocolsBgpGroupAsPathOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsBgpGroupAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceInterface.
+func (in *NetworkinstanceInterface) DeepCopy() *NetworkinstanceInterface {
+	if in == nil {
+		return nil
 	}
-	if in.Evpn != nil {
-		in, out := &in.Evpn, &out.Evpn
-		*out = new(NetworkinstanceProtocolsBgpGroupEvpn)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.FailureDetection != nil {
-		in, out := &in.FailureDetection, &out.FailureDetection
-		*out = new(NetworkinstanceProtocolsBgpGroupFailureDetection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GracefulRestart != nil {
-		in, out := &in.GracefulRestart, &out.GracefulRestart
-		*out = new(NetworkinstanceProtocolsBgpGroupGracefulRestart)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImportPolicy != nil {
-		in, out := &in.ImportPolicy, &out.ImportPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ipv4Unicast != nil {
-		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
-		*out = new(NetworkinstanceProtocolsBgpGroupIpv4Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ipv6Unicast != nil {
-		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
-		*out = new(NetworkinstanceProtocolsBgpGroupIpv6Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LocalAs != nil {
-		in, out := &in.LocalAs, &out.LocalAs
-		*out = make([]*NetworkinstanceProtocolsBgpGroupLocalAs, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpGroupLocalAs)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.LocalPreference != nil {
-		in, out := &in.LocalPreference, &out.LocalPreference
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.NextHopSelf != nil {
-		in, out := &in.NextHopSelf, &out.NextHopSelf
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PeerAs != nil {
-		in, out := &in.PeerAs, &out.PeerAs
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RouteReflector != nil {
-		in, out := &in.RouteReflector, &out.RouteReflector
-		*out = new(NetworkinstanceProtocolsBgpGroupRouteReflector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SendCommunity != nil {
-		in, out := &in.SendCommunity, &out.SendCommunity
-		*out = new(NetworkinstanceProtocolsBgpGroupSendCommunity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SendDefaultRoute != nil {
-		in, out := &in.SendDefaultRoute, &out.SendDefaultRoute
-		*out = new(NetworkinstanceProtocolsBgpGroupSendDefaultRoute)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Timers != nil {
-		in, out := &in.Timers, &out.Timers
-		*out = new(NetworkinstanceProtocolsBgpGroupTimers)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsBgpGroupTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Transport != nil {
-		in, out := &in.Transport, &out.Transport
-		*out = new(NetworkinstanceProtocolsBgpGroupTransport)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroup.
-func (in *NetworkinstanceProtocolsBgpGroup) DeepCopy() *NetworkinstanceProtocolsBgpGroup {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsBgpGroup)
+	out := new(NetworkinstanceInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupAsPathOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupAsPathOptions) {
+func (in *NetworkinstanceIpForwarding) DeepCopyInto(out *NetworkinstanceIpForwarding) {
 	*out = *in
-	if in.AllowOwnAs != nil {
-		in, out := &in.AllowOwnAs, &out.AllowOwnAs
-		*out = new(uint8)
+	if in.ReceiveIpv4Check != nil {
+		in, out := &in.ReceiveIpv4Check, &out.ReceiveIpv4Check
+		*out = new(bool)
 		**out = **in
 	}
-	if in.RemovePrivateAs != nil {
-		in, out := &in.RemovePrivateAs, &out.RemovePrivateAs
-		*out = new(NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReplacePeerAs != nil {
-		in, out := &in.ReplacePeerAs, &out.ReplacePeerAs
+	if in.ReceiveIpv6Check != nil {
+		in, out := &in.ReceiveIpv6Check, &out.ReceiveIpv6Check
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupAsPathOptions.
-func (in *NetworkinstanceProtocolsBgpGroupAsPathOptions) DeepCopy() *NetworkinstanceProtocolsBgpGroupAsPathOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceIpForwarding.
+func (in *NetworkinstanceIpForwarding) DeepCopy() *NetworkinstanceIpForwarding {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupAsPathOptions)
+	out := new(NetworkinstanceIpForwarding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs) {
+func (in *NetworkinstanceIpLoadBalancing) DeepCopyInto(out *NetworkinstanceIpLoadBalancing) {
 	*out = *in
-	if in.IgnorePeerAs != nil {
-		in, out := &in.IgnorePeerAs, &out.IgnorePeerAs
-		*out = new(bool)
-		**out = **in
-	}
-	if in.LeadingOnly != nil {
-		in, out := &in.LeadingOnly, &out.LeadingOnly
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(string)
-		**out = **in
+	if in.ResilientHashPrefix != nil {
+		in, out := &in.ResilientHashPrefix, &out.ResilientHashPrefix
+		*out = make([]*NetworkinstanceIpLoadBalancingResilientHashPrefix, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceIpLoadBalancingResilientHashPrefix)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs.
-func (in *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs) DeepCopy() *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceIpLoadBalancing.
+func (in *NetworkinstanceIpLoadBalancing) DeepCopy() *NetworkinstanceIpLoadBalancing {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs)
+	out := new(NetworkinstanceIpLoadBalancing)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupAuthentication) {
+func (in *NetworkinstanceIpLoadBalancingResilientHashPrefix) DeepCopyInto(out *NetworkinstanceIpLoadBalancingResilientHashPrefix) {
 	*out = *in
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
+	if in.HashBucketsPerPath != nil {
+		in, out := &in.HashBucketsPerPath, &out.HashBucketsPerPath
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.IpPrefix != nil {
+		in, out := &in.IpPrefix, &out.IpPrefix
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaxPaths != nil {
+		in, out := &in.MaxPaths, &out.MaxPaths
+		*out = new(uint8)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupAuthentication.
-func (in *NetworkinstanceProtocolsBgpGroupAuthentication) DeepCopy() *NetworkinstanceProtocolsBgpGroupAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceIpLoadBalancingResilientHashPrefix.
+func (in *NetworkinstanceIpLoadBalancingResilientHashPrefix) DeepCopy() *NetworkinstanceIpLoadBalancingResilientHashPrefix {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupAuthentication)
+	out := new(NetworkinstanceIpLoadBalancingResilientHashPrefix)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupEvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupEvpn) {
+func (in *NetworkinstanceMpls) DeepCopyInto(out *NetworkinstanceMpls) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertiseIpv6NextHops != nil {
-		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
+	if in.StaticMplsEntry != nil {
+		in, out := &in.StaticMplsEntry, &out.StaticMplsEntry
+		*out = make([]*NetworkinstanceMplsStaticMplsEntry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceMplsStaticMplsEntry)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TtlPropagation != nil {
+		in, out := &in.TtlPropagation, &out.TtlPropagation
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PrefixLimit != nil {
-		in, out := &in.PrefixLimit, &out.PrefixLimit
-		*out = new(NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupEvpn.
-func (in *NetworkinstanceProtocolsBgpGroupEvpn) DeepCopy() *NetworkinstanceProtocolsBgpGroupEvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceMpls.
+func (in *NetworkinstanceMpls) DeepCopy() *NetworkinstanceMpls {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupEvpn)
+	out := new(NetworkinstanceMpls)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit) {
+func (in *NetworkinstanceMplsStaticMplsEntry) DeepCopyInto(out *NetworkinstanceMplsStaticMplsEntry) {
 	*out = *in
-	if in.MaxReceivedRoutes != nil {
-		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
-		*out = new(uint32)
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
 		**out = **in
 	}
-	if in.WarningThresholdPct != nil {
-		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
+	if in.NextHopGroup != nil {
+		in, out := &in.NextHopGroup, &out.NextHopGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
 		*out = new(uint8)
 		**out = **in
 	}
+	if in.TopLabel != nil {
+		in, out := &in.TopLabel, &out.TopLabel
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit.
-func (in *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceMplsStaticMplsEntry.
+func (in *NetworkinstanceMplsStaticMplsEntry) DeepCopy() *NetworkinstanceMplsStaticMplsEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit)
+	out := new(NetworkinstanceMplsStaticMplsEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupFailureDetection) {
+func (in *NetworkinstanceMtu) DeepCopyInto(out *NetworkinstanceMtu) {
 	*out = *in
-	if in.EnableBfd != nil {
-		in, out := &in.EnableBfd, &out.EnableBfd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.FastFailover != nil {
-		in, out := &in.FastFailover, &out.FastFailover
+	if in.PathMtuDiscovery != nil {
+		in, out := &in.PathMtuDiscovery, &out.PathMtuDiscovery
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupFailureDetection.
-func (in *NetworkinstanceProtocolsBgpGroupFailureDetection) DeepCopy() *NetworkinstanceProtocolsBgpGroupFailureDetection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceMtu.
+func (in *NetworkinstanceMtu) DeepCopy() *NetworkinstanceMtu {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupFailureDetection)
+	out := new(NetworkinstanceMtu)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupGracefulRestart) {
+func (in *NetworkinstanceNexthopgroups) DeepCopyInto(out *NetworkinstanceNexthopgroups) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.StaleRoutesTime != nil {
-		in, out := &in.StaleRoutesTime, &out.StaleRoutesTime
-		*out = new(uint16)
-		**out = **in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*NetworkinstanceNexthopgroupsGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceNexthopgroupsGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupGracefulRestart.
-func (in *NetworkinstanceProtocolsBgpGroupGracefulRestart) DeepCopy() *NetworkinstanceProtocolsBgpGroupGracefulRestart {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroups.
+func (in *NetworkinstanceNexthopgroups) DeepCopy() *NetworkinstanceNexthopgroups {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupGracefulRestart)
+	out := new(NetworkinstanceNexthopgroups)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv4Unicast) {
+func (in *NetworkinstanceNexthopgroupsGroup) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroup) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertiseIpv6NextHops != nil {
-		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PrefixLimit != nil {
-		in, out := &in.PrefixLimit, &out.PrefixLimit
-		*out = new(NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit)
+	if in.Blackhole != nil {
+		in, out := &in.Blackhole, &out.Blackhole
+		*out = new(NetworkinstanceNexthopgroupsGroupBlackhole)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReceiveIpv6NextHops != nil {
-		in, out := &in.ReceiveIpv6NextHops, &out.ReceiveIpv6NextHops
-		*out = new(bool)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
+	if in.Nexthop != nil {
+		in, out := &in.Nexthop, &out.Nexthop
+		*out = make([]*NetworkinstanceNexthopgroupsGroupNexthop, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceNexthopgroupsGroupNexthop)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv4Unicast.
-func (in *NetworkinstanceProtocolsBgpGroupIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv4Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroup.
+func (in *NetworkinstanceNexthopgroupsGroup) DeepCopy() *NetworkinstanceNexthopgroupsGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupIpv4Unicast)
+	out := new(NetworkinstanceNexthopgroupsGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit) {
+func (in *NetworkinstanceNexthopgroupsGroupBlackhole) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupBlackhole) {
 	*out = *in
-	if in.MaxReceivedRoutes != nil {
-		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.WarningThresholdPct != nil {
-		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
-		*out = new(uint8)
+	if in.GenerateIcmp != nil {
+		in, out := &in.GenerateIcmp, &out.GenerateIcmp
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit.
-func (in *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupBlackhole.
+func (in *NetworkinstanceNexthopgroupsGroupBlackhole) DeepCopy() *NetworkinstanceNexthopgroupsGroupBlackhole {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit)
+	out := new(NetworkinstanceNexthopgroupsGroupBlackhole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv6Unicast) {
+func (in *NetworkinstanceNexthopgroupsGroupNexthop) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupNexthop) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrefixLimit != nil {
-		in, out := &in.PrefixLimit, &out.PrefixLimit
-		*out = new(NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit)
+	if in.FailureDetection != nil {
+		in, out := &in.FailureDetection, &out.FailureDetection
+		*out = new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.IpAddress != nil {
+		in, out := &in.IpAddress, &out.IpAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PushedMplsLabelStack != nil {
+		in, out := &in.PushedMplsLabelStack, &out.PushedMplsLabelStack
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resolve != nil {
+		in, out := &in.Resolve, &out.Resolve
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv6Unicast.
-func (in *NetworkinstanceProtocolsBgpGroupIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv6Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupNexthop.
+func (in *NetworkinstanceNexthopgroupsGroupNexthop) DeepCopy() *NetworkinstanceNexthopgroupsGroupNexthop {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupIpv6Unicast)
+	out := new(NetworkinstanceNexthopgroupsGroupNexthop)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit) {
+func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection) {
 	*out = *in
-	if in.MaxReceivedRoutes != nil {
-		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.WarningThresholdPct != nil {
-		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
-		*out = new(uint8)
-		**out = **in
+	if in.EnableBfd != nil {
+		in, out := &in.EnableBfd, &out.EnableBfd
+		*out = new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit.
-func (in *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupNexthopFailureDetection.
+func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection) DeepCopy() *NetworkinstanceNexthopgroupsGroupNexthopFailureDetection {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit)
+	out := new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupLocalAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupLocalAs) {
+func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd) DeepCopyInto(out *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd) {
 	*out = *in
-	if in.AsNumber != nil {
-		in, out := &in.AsNumber, &out.AsNumber
-		*out = new(uint32)
+	if in.LocalAddress != nil {
+		in, out := &in.LocalAddress, &out.LocalAddress
+		*out = new(string)
 		**out = **in
 	}
-	if in.PrependGlobalAs != nil {
-		in, out := &in.PrependGlobalAs, &out.PrependGlobalAs
-		*out = new(bool)
+	if in.LocalDiscriminator != nil {
+		in, out := &in.LocalDiscriminator, &out.LocalDiscriminator
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.PrependLocalAs != nil {
-		in, out := &in.PrependLocalAs, &out.PrependLocalAs
-		*out = new(bool)
+	if in.RemoteDiscriminator != nil {
+		in, out := &in.RemoteDiscriminator, &out.RemoteDiscriminator
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupLocalAs.
-func (in *NetworkinstanceProtocolsBgpGroupLocalAs) DeepCopy() *NetworkinstanceProtocolsBgpGroupLocalAs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd.
+func (in *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd) DeepCopy() *NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupLocalAs)
+	out := new(NetworkinstanceNexthopgroupsGroupNexthopFailureDetectionEnableBfd)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupRouteReflector) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupRouteReflector) {
+func (in *NetworkinstanceNexthopgroupsObservation) DeepCopyInto(out *NetworkinstanceNexthopgroupsObservation) {
 	*out = *in
-	if in.Client != nil {
-		in, out := &in.Client, &out.Client
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ClusterId != nil {
-		in, out := &in.ClusterId, &out.ClusterId
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupRouteReflector.
-func (in *NetworkinstanceProtocolsBgpGroupRouteReflector) DeepCopy() *NetworkinstanceProtocolsBgpGroupRouteReflector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsObservation.
+func (in *NetworkinstanceNexthopgroupsObservation) DeepCopy() *NetworkinstanceNexthopgroupsObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupRouteReflector)
+	out := new(NetworkinstanceNexthopgroupsObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupSendCommunity) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupSendCommunity) {
+func (in *NetworkinstanceNexthopgroupsParameters) DeepCopyInto(out *NetworkinstanceNexthopgroupsParameters) {
 	*out = *in
-	if in.Large != nil {
-		in, out := &in.Large, &out.Large
-		*out = new(bool)
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
 		**out = **in
 	}
-	if in.Standard != nil {
-		in, out := &in.Standard, &out.Standard
-		*out = new(bool)
-		**out = **in
+	if in.SrlNetworkinstanceNexthopgroups != nil {
+		in, out := &in.SrlNetworkinstanceNexthopgroups, &out.SrlNetworkinstanceNexthopgroups
+		*out = new(NetworkinstanceNexthopgroups)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupSendCommunity.
-func (in *NetworkinstanceProtocolsBgpGroupSendCommunity) DeepCopy() *NetworkinstanceProtocolsBgpGroupSendCommunity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsParameters.
+func (in *NetworkinstanceNexthopgroupsParameters) DeepCopy() *NetworkinstanceNexthopgroupsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupSendCommunity)
+	out := new(NetworkinstanceNexthopgroupsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupSendDefaultRoute) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupSendDefaultRoute) {
+func (in *NetworkinstanceNexthopgroupsSpec) DeepCopyInto(out *NetworkinstanceNexthopgroupsSpec) {
 	*out = *in
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ipv4Unicast != nil {
-		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ipv6Unicast != nil {
-		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
-		*out = new(bool)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupSendDefaultRoute.
-func (in *NetworkinstanceProtocolsBgpGroupSendDefaultRoute) DeepCopy() *NetworkinstanceProtocolsBgpGroupSendDefaultRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsSpec.
+func (in *NetworkinstanceNexthopgroupsSpec) DeepCopy() *NetworkinstanceNexthopgroupsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupSendDefaultRoute)
+	out := new(NetworkinstanceNexthopgroupsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupTimers) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTimers) {
+func (in *NetworkinstanceNexthopgroupsStatus) DeepCopyInto(out *NetworkinstanceNexthopgroupsStatus) {
 	*out = *in
-	if in.ConnectRetry != nil {
-		in, out := &in.ConnectRetry, &out.ConnectRetry
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.KeepaliveInterval != nil {
-		in, out := &in.KeepaliveInterval, &out.KeepaliveInterval
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.MinimumAdvertisementInterval != nil {
-		in, out := &in.MinimumAdvertisementInterval, &out.MinimumAdvertisementInterval
-		*out = new(uint16)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTimers.
-func (in *NetworkinstanceProtocolsBgpGroupTimers) DeepCopy() *NetworkinstanceProtocolsBgpGroupTimers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceNexthopgroupsStatus.
+func (in *NetworkinstanceNexthopgroupsStatus) DeepCopy() *NetworkinstanceNexthopgroupsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupTimers)
+	out := new(NetworkinstanceNexthopgroupsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTraceOptions) {
+func (in *NetworkinstanceObservation) DeepCopyInto(out *NetworkinstanceObservation) {
 	*out = *in
-	if in.Flag != nil {
-		in, out := &in.Flag, &out.Flag
-		*out = make([]*NetworkinstanceProtocolsBgpGroupTraceOptionsFlag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpGroupTraceOptionsFlag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTraceOptions.
-func (in *NetworkinstanceProtocolsBgpGroupTraceOptions) DeepCopy() *NetworkinstanceProtocolsBgpGroupTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceObservation.
+func (in *NetworkinstanceObservation) DeepCopy() *NetworkinstanceObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupTraceOptions)
+	out := new(NetworkinstanceObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag) {
+func (in *NetworkinstanceParameters) DeepCopyInto(out *NetworkinstanceParameters) {
 	*out = *in
-	if in.Modifier != nil {
-		in, out := &in.Modifier, &out.Modifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	if in.SrlNetworkinstance != nil {
+		in, out := &in.SrlNetworkinstance, &out.SrlNetworkinstance
+		*out = new(Networkinstance)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTraceOptionsFlag.
-func (in *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag) DeepCopy() *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceParameters.
+func (in *NetworkinstanceParameters) DeepCopy() *NetworkinstanceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupTraceOptionsFlag)
+	out := new(NetworkinstanceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpGroupTransport) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTransport) {
+func (in *NetworkinstanceProtocolsBgp) DeepCopyInto(out *NetworkinstanceProtocolsBgp) {
 	*out = *in
-	if in.LocalAddress != nil {
-		in, out := &in.LocalAddress, &out.LocalAddress
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.PassiveMode != nil {
-		in, out := &in.PassiveMode, &out.PassiveMode
-		*out = new(bool)
+	if in.AsPathOptions != nil {
+		in, out := &in.AsPathOptions, &out.AsPathOptions
+		*out = new(NetworkinstanceProtocolsBgpAsPathOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsBgpAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutonomousSystem != nil {
+		in, out := &in.AutonomousSystem, &out.AutonomousSystem
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.TcpMss != nil {
-		in, out := &in.TcpMss, &out.TcpMss
-		*out = new(uint16)
+	if in.AutonomousSystemPoolRef != nil {
+		in, out := &in.AutonomousSystemPoolRef, &out.AutonomousSystemPoolRef
+		*out = new(PoolReference)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTransport.
-func (in *NetworkinstanceProtocolsBgpGroupTransport) DeepCopy() *NetworkinstanceProtocolsBgpGroupTransport {
-	if in == nil {
-		return nil
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(NetworkinstanceProtocolsBgpConvergence)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(NetworkinstanceProtocolsBgpGroupTransport)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv4Unicast) {
-	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.DynamicNeighbors != nil {
+		in, out := &in.DynamicNeighbors, &out.DynamicNeighbors
+		*out = new(NetworkinstanceProtocolsBgpDynamicNeighbors)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EbgpDefaultPolicy != nil {
+		in, out := &in.EbgpDefaultPolicy, &out.EbgpDefaultPolicy
+		*out = new(NetworkinstanceProtocolsBgpEbgpDefaultPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Evpn != nil {
+		in, out := &in.Evpn, &out.Evpn
+		*out = new(NetworkinstanceProtocolsBgpEvpn)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertiseIpv6NextHops != nil {
-		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
-		*out = new(bool)
+	if in.FailureDetection != nil {
+		in, out := &in.FailureDetection, &out.FailureDetection
+		*out = new(NetworkinstanceProtocolsBgpFailureDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracefulRestart != nil {
+		in, out := &in.GracefulRestart, &out.GracefulRestart
+		*out = new(NetworkinstanceProtocolsBgpGracefulRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*NetworkinstanceProtocolsBgpGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsBgpGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ImportPolicy != nil {
+		in, out := &in.ImportPolicy, &out.ImportPolicy
+		*out = new(string)
 		**out = **in
 	}
-	if in.Convergence != nil {
-		in, out := &in.Convergence, &out.Convergence
-		*out = new(NetworkinstanceProtocolsBgpIpv4UnicastConvergence)
+	if in.Ipv4Unicast != nil {
+		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
+		*out = new(NetworkinstanceProtocolsBgpIpv4Unicast)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Multipath != nil {
-		in, out := &in.Multipath, &out.Multipath
-		*out = new(NetworkinstanceProtocolsBgpIpv4UnicastMultipath)
+	if in.Ipv6Unicast != nil {
+		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
+		*out = new(NetworkinstanceProtocolsBgpIpv6Unicast)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReceiveIpv6NextHops != nil {
-		in, out := &in.ReceiveIpv6NextHops, &out.ReceiveIpv6NextHops
-		*out = new(bool)
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Neighbor != nil {
+		in, out := &in.Neighbor, &out.Neighbor
+		*out = make([]*NetworkinstanceProtocolsBgpNeighbor, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsBgpNeighbor)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(NetworkinstanceProtocolsBgpPreference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteAdvertisement != nil {
+		in, out := &in.RouteAdvertisement, &out.RouteAdvertisement
+		*out = new(NetworkinstanceProtocolsBgpRouteAdvertisement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteReflector != nil {
+		in, out := &in.RouteReflector, &out.RouteReflector
+		*out = new(NetworkinstanceProtocolsBgpRouteReflector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterId != nil {
+		in, out := &in.RouterId, &out.RouterId
+		*out = new(string)
 		**out = **in
 	}
+	if in.SendCommunity != nil {
+		in, out := &in.SendCommunity, &out.SendCommunity
+		*out = new(NetworkinstanceProtocolsBgpSendCommunity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(NetworkinstanceProtocolsBgpTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transport != nil {
+		in, out := &in.Transport, &out.Transport
+		*out = new(NetworkinstanceProtocolsBgpTransport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv4Unicast.
-func (in *NetworkinstanceProtocolsBgpIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsBgpIpv4Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgp.
+func (in *NetworkinstanceProtocolsBgp) DeepCopy() *NetworkinstanceProtocolsBgp {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpIpv4Unicast)
+	out := new(NetworkinstanceProtocolsBgp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpIpv4UnicastConvergence) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv4UnicastConvergence) {
+func (in *NetworkinstanceProtocolsBgpAsPathOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpAsPathOptions) {
 	*out = *in
-	if in.MaxWaitToAdvertise != nil {
-		in, out := &in.MaxWaitToAdvertise, &out.MaxWaitToAdvertise
-		*out = new(uint16)
+	if in.AllowOwnAs != nil {
+		in, out := &in.AllowOwnAs, &out.AllowOwnAs
+		*out = new(uint8)
 		**out = **in
 	}
+	if in.RemovePrivateAs != nil {
+		in, out := &in.RemovePrivateAs, &out.RemovePrivateAs
+		*out = new(NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv4UnicastConvergence.
-func (in *NetworkinstanceProtocolsBgpIpv4UnicastConvergence) DeepCopy() *NetworkinstanceProtocolsBgpIpv4UnicastConvergence {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpAsPathOptions.
+func (in *NetworkinstanceProtocolsBgpAsPathOptions) DeepCopy() *NetworkinstanceProtocolsBgpAsPathOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpIpv4UnicastConvergence)
+	out := new(NetworkinstanceProtocolsBgpAsPathOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpIpv4UnicastMultipath) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv4UnicastMultipath) {
+func (in *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs) {
 	*out = *in
-	if in.AllowMultipleAs != nil {
-		in, out := &in.AllowMultipleAs, &out.AllowMultipleAs
+	if in.IgnorePeerAs != nil {
+		in, out := &in.IgnorePeerAs, &out.IgnorePeerAs
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MaxPathsLevel1 != nil {
-		in, out := &in.MaxPathsLevel1, &out.MaxPathsLevel1
-		*out = new(uint32)
+	if in.LeadingOnly != nil {
+		in, out := &in.LeadingOnly, &out.LeadingOnly
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MaxPathsLevel2 != nil {
-		in, out := &in.MaxPathsLevel2, &out.MaxPathsLevel2
-		*out = new(uint32)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv4UnicastMultipath.
-func (in *NetworkinstanceProtocolsBgpIpv4UnicastMultipath) DeepCopy() *NetworkinstanceProtocolsBgpIpv4UnicastMultipath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs.
+func (in *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs) DeepCopy() *NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpIpv4UnicastMultipath)
+	out := new(NetworkinstanceProtocolsBgpAsPathOptionsRemovePrivateAs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv6Unicast) {
+func (in *NetworkinstanceProtocolsBgpAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsBgpAuthentication) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
 		*out = new(string)
 		**out = **in
 	}
-	if in.Convergence != nil {
-		in, out := &in.Convergence, &out.Convergence
-		*out = new(NetworkinstanceProtocolsBgpIpv6UnicastConvergence)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Multipath != nil {
-		in, out := &in.Multipath, &out.Multipath
-		*out = new(NetworkinstanceProtocolsBgpIpv6UnicastMultipath)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpAuthentication.
+func (in *NetworkinstanceProtocolsBgpAuthentication) DeepCopy() *NetworkinstanceProtocolsBgpAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpConvergence) DeepCopyInto(out *NetworkinstanceProtocolsBgpConvergence) {
+	*out = *in
+	if in.MinWaitToAdvertise != nil {
+		in, out := &in.MinWaitToAdvertise, &out.MinWaitToAdvertise
+		*out = new(uint16)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpConvergence.
+func (in *NetworkinstanceProtocolsBgpConvergence) DeepCopy() *NetworkinstanceProtocolsBgpConvergence {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpConvergence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpDynamicNeighbors) DeepCopyInto(out *NetworkinstanceProtocolsBgpDynamicNeighbors) {
+	*out = *in
+	if in.Accept != nil {
+		in, out := &in.Accept, &out.Accept
+		*out = new(NetworkinstanceProtocolsBgpDynamicNeighborsAccept)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv6Unicast.
-func (in *NetworkinstanceProtocolsBgpIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsBgpIpv6Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpDynamicNeighbors.
+func (in *NetworkinstanceProtocolsBgpDynamicNeighbors) DeepCopy() *NetworkinstanceProtocolsBgpDynamicNeighbors {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpIpv6Unicast)
+	out := new(NetworkinstanceProtocolsBgpDynamicNeighbors)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpIpv6UnicastConvergence) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv6UnicastConvergence) {
+func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAccept) DeepCopyInto(out *NetworkinstanceProtocolsBgpDynamicNeighborsAccept) {
 	*out = *in
-	if in.MaxWaitToAdvertise != nil {
-		in, out := &in.MaxWaitToAdvertise, &out.MaxWaitToAdvertise
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]*NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MaxSessions != nil {
+		in, out := &in.MaxSessions, &out.MaxSessions
 		*out = new(uint16)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv6UnicastConvergence.
-func (in *NetworkinstanceProtocolsBgpIpv6UnicastConvergence) DeepCopy() *NetworkinstanceProtocolsBgpIpv6UnicastConvergence {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpDynamicNeighborsAccept.
+func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAccept) DeepCopy() *NetworkinstanceProtocolsBgpDynamicNeighborsAccept {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpIpv6UnicastConvergence)
+	out := new(NetworkinstanceProtocolsBgpDynamicNeighborsAccept)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) {
+func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch) DeepCopyInto(out *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch) {
+	*out = *in
+	if in.AllowedPeerAs != nil {
+		in, out := &in.AllowedPeerAs, &out.AllowedPeerAs
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerGroup != nil {
+		in, out := &in.PeerGroup, &out.PeerGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch.
+func (in *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch) DeepCopy() *NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpDynamicNeighborsAcceptMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpEbgpDefaultPolicy) DeepCopyInto(out *NetworkinstanceProtocolsBgpEbgpDefaultPolicy) {
+	*out = *in
+	if in.ExportRejectAll != nil {
+		in, out := &in.ExportRejectAll, &out.ExportRejectAll
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImportRejectAll != nil {
+		in, out := &in.ImportRejectAll, &out.ImportRejectAll
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpEbgpDefaultPolicy.
+func (in *NetworkinstanceProtocolsBgpEbgpDefaultPolicy) DeepCopy() *NetworkinstanceProtocolsBgpEbgpDefaultPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpEbgpDefaultPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpEvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpEvpn) {
+	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertiseIpv6NextHops != nil {
+		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KeepAllRoutes != nil {
+		in, out := &in.KeepAllRoutes, &out.KeepAllRoutes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Multipath != nil {
+		in, out := &in.Multipath, &out.Multipath
+		*out = new(NetworkinstanceProtocolsBgpEvpnMultipath)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RapidUpdate != nil {
+		in, out := &in.RapidUpdate, &out.RapidUpdate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpEvpn.
+func (in *NetworkinstanceProtocolsBgpEvpn) DeepCopy() *NetworkinstanceProtocolsBgpEvpn {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpEvpn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpEvpnMultipath) DeepCopyInto(out *NetworkinstanceProtocolsBgpEvpnMultipath) {
 	*out = *in
 	if in.AllowMultipleAs != nil {
 		in, out := &in.AllowMultipleAs, &out.AllowMultipleAs
@@ -5016,18 +4828,68 @@ func (in *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) DeepCopyInto(out *Net
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv6UnicastMultipath.
-func (in *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) DeepCopy() *NetworkinstanceProtocolsBgpIpv6UnicastMultipath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpEvpnMultipath.
+func (in *NetworkinstanceProtocolsBgpEvpnMultipath) DeepCopy() *NetworkinstanceProtocolsBgpEvpnMultipath {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpIpv6UnicastMultipath)
+	out := new(NetworkinstanceProtocolsBgpEvpnMultipath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighbor) {
+func (in *NetworkinstanceProtocolsBgpFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsBgpFailureDetection) {
+	*out = *in
+	if in.EnableBfd != nil {
+		in, out := &in.EnableBfd, &out.EnableBfd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FastFailover != nil {
+		in, out := &in.FastFailover, &out.FastFailover
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpFailureDetection.
+func (in *NetworkinstanceProtocolsBgpFailureDetection) DeepCopy() *NetworkinstanceProtocolsBgpFailureDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpFailureDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpGracefulRestart) {
+	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.StaleRoutesTime != nil {
+		in, out := &in.StaleRoutesTime, &out.StaleRoutesTime
+		*out = new(uint16)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGracefulRestart.
+func (in *NetworkinstanceProtocolsBgpGracefulRestart) DeepCopy() *NetworkinstanceProtocolsBgpGracefulRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpGracefulRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpGroup) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroup) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -5036,12 +4898,12 @@ func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *Networkinstance
 	}
 	if in.AsPathOptions != nil {
 		in, out := &in.AsPathOptions, &out.AsPathOptions
-		*out = new(NetworkinstanceProtocolsBgpNeighborAsPathOptions)
+		*out = new(NetworkinstanceProtocolsBgpGroupAsPathOptions)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsBgpNeighborAuthentication)
+		*out = new(NetworkinstanceProtocolsBgpGroupAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Description != nil {
@@ -5051,7 +4913,7 @@ func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *Networkinstance
 	}
 	if in.Evpn != nil {
 		in, out := &in.Evpn, &out.Evpn
-		*out = new(NetworkinstanceProtocolsBgpNeighborEvpn)
+		*out = new(NetworkinstanceProtocolsBgpGroupEvpn)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ExportPolicy != nil {
@@ -5061,14 +4923,19 @@ func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *Networkinstance
 	}
 	if in.FailureDetection != nil {
 		in, out := &in.FailureDetection, &out.FailureDetection
-		*out = new(NetworkinstanceProtocolsBgpNeighborFailureDetection)
+		*out = new(NetworkinstanceProtocolsBgpGroupFailureDetection)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.GracefulRestart != nil {
 		in, out := &in.GracefulRestart, &out.GracefulRestart
-		*out = new(NetworkinstanceProtocolsBgpNeighborGracefulRestart)
+		*out = new(NetworkinstanceProtocolsBgpGroupGracefulRestart)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
 	if in.ImportPolicy != nil {
 		in, out := &in.ImportPolicy, &out.ImportPolicy
 		*out = new(string)
@@ -5076,21 +4943,21 @@ func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *Networkinstance
 	}
 	if in.Ipv4Unicast != nil {
 		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
-		*out = new(NetworkinstanceProtocolsBgpNeighborIpv4Unicast)
+		*out = new(NetworkinstanceProtocolsBgpGroupIpv4Unicast)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Ipv6Unicast != nil {
 		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
-		*out = new(NetworkinstanceProtocolsBgpNeighborIpv6Unicast)
+		*out = new(NetworkinstanceProtocolsBgpGroupIpv6Unicast)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LocalAs != nil {
 		in, out := &in.LocalAs, &out.LocalAs
-		*out = make([]*NetworkinstanceProtocolsBgpNeighborLocalAs, len(*in))
+		*out = make([]*NetworkinstanceProtocolsBgpGroupLocalAs, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpNeighborLocalAs)
+				*out = new(NetworkinstanceProtocolsBgpGroupLocalAs)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -5105,65 +4972,55 @@ func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *Networkinstance
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PeerAddress != nil {
-		in, out := &in.PeerAddress, &out.PeerAddress
-		*out = new(string)
-		**out = **in
-	}
 	if in.PeerAs != nil {
 		in, out := &in.PeerAs, &out.PeerAs
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.PeerGroup != nil {
-		in, out := &in.PeerGroup, &out.PeerGroup
-		*out = new(string)
-		**out = **in
-	}
 	if in.RouteReflector != nil {
 		in, out := &in.RouteReflector, &out.RouteReflector
-		*out = new(NetworkinstanceProtocolsBgpNeighborRouteReflector)
+		*out = new(NetworkinstanceProtocolsBgpGroupRouteReflector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SendCommunity != nil {
 		in, out := &in.SendCommunity, &out.SendCommunity
-		*out = new(NetworkinstanceProtocolsBgpNeighborSendCommunity)
+		*out = new(NetworkinstanceProtocolsBgpGroupSendCommunity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SendDefaultRoute != nil {
 		in, out := &in.SendDefaultRoute, &out.SendDefaultRoute
-		*out = new(NetworkinstanceProtocolsBgpNeighborSendDefaultRoute)
+		*out = new(NetworkinstanceProtocolsBgpGroupSendDefaultRoute)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Timers != nil {
 		in, out := &in.Timers, &out.Timers
-		*out = new(NetworkinstanceProtocolsBgpNeighborTimers)
+		*out = new(NetworkinstanceProtocolsBgpGroupTimers)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TraceOptions != nil {
 		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsBgpNeighborTraceOptions)
+		*out = new(NetworkinstanceProtocolsBgpGroupTraceOptions)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Transport != nil {
 		in, out := &in.Transport, &out.Transport
-		*out = new(NetworkinstanceProtocolsBgpNeighborTransport)
+		*out = new(NetworkinstanceProtocolsBgpGroupTransport)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighbor.
-func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopy() *NetworkinstanceProtocolsBgpNeighbor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroup.
+func (in *NetworkinstanceProtocolsBgpGroup) DeepCopy() *NetworkinstanceProtocolsBgpGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighbor)
+	out := new(NetworkinstanceProtocolsBgpGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborAsPathOptions) {
+func (in *NetworkinstanceProtocolsBgpGroupAsPathOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupAsPathOptions) {
 	*out = *in
 	if in.AllowOwnAs != nil {
 		in, out := &in.AllowOwnAs, &out.AllowOwnAs
@@ -5172,7 +5029,7 @@ func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptions) DeepCopyInto(out *Ne
 	}
 	if in.RemovePrivateAs != nil {
 		in, out := &in.RemovePrivateAs, &out.RemovePrivateAs
-		*out = new(NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs)
+		*out = new(NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ReplacePeerAs != nil {
@@ -5182,18 +5039,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptions) DeepCopyInto(out *Ne
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborAsPathOptions.
-func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptions) DeepCopy() *NetworkinstanceProtocolsBgpNeighborAsPathOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupAsPathOptions.
+func (in *NetworkinstanceProtocolsBgpGroupAsPathOptions) DeepCopy() *NetworkinstanceProtocolsBgpGroupAsPathOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborAsPathOptions)
+	out := new(NetworkinstanceProtocolsBgpGroupAsPathOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) {
+func (in *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs) {
 	*out = *in
 	if in.IgnorePeerAs != nil {
 		in, out := &in.IgnorePeerAs, &out.IgnorePeerAs
@@ -5212,18 +5069,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) DeepC
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs.
-func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) DeepCopy() *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs.
+func (in *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs) DeepCopy() *NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs)
+	out := new(NetworkinstanceProtocolsBgpGroupAsPathOptionsRemovePrivateAs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborAuthentication) {
+func (in *NetworkinstanceProtocolsBgpGroupAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupAuthentication) {
 	*out = *in
 	if in.Keychain != nil {
 		in, out := &in.Keychain, &out.Keychain
@@ -5232,18 +5089,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborAuthentication) DeepCopyInto(out *N
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborAuthentication.
-func (in *NetworkinstanceProtocolsBgpNeighborAuthentication) DeepCopy() *NetworkinstanceProtocolsBgpNeighborAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupAuthentication.
+func (in *NetworkinstanceProtocolsBgpGroupAuthentication) DeepCopy() *NetworkinstanceProtocolsBgpGroupAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborAuthentication)
+	out := new(NetworkinstanceProtocolsBgpGroupAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborEvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborEvpn) {
+func (in *NetworkinstanceProtocolsBgpGroupEvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupEvpn) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -5257,23 +5114,23 @@ func (in *NetworkinstanceProtocolsBgpNeighborEvpn) DeepCopyInto(out *Networkinst
 	}
 	if in.PrefixLimit != nil {
 		in, out := &in.PrefixLimit, &out.PrefixLimit
-		*out = new(NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit)
+		*out = new(NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborEvpn.
-func (in *NetworkinstanceProtocolsBgpNeighborEvpn) DeepCopy() *NetworkinstanceProtocolsBgpNeighborEvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupEvpn.
+func (in *NetworkinstanceProtocolsBgpGroupEvpn) DeepCopy() *NetworkinstanceProtocolsBgpGroupEvpn {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborEvpn)
+	out := new(NetworkinstanceProtocolsBgpGroupEvpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) {
+func (in *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit) {
 	*out = *in
 	if in.MaxReceivedRoutes != nil {
 		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
@@ -5287,18 +5144,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) DeepCopyInto(out *
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit.
-func (in *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit.
+func (in *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit)
+	out := new(NetworkinstanceProtocolsBgpGroupEvpnPrefixLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborFailureDetection) {
+func (in *NetworkinstanceProtocolsBgpGroupFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupFailureDetection) {
 	*out = *in
 	if in.EnableBfd != nil {
 		in, out := &in.EnableBfd, &out.EnableBfd
@@ -5312,18 +5169,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborFailureDetection) DeepCopyInto(out
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborFailureDetection.
-func (in *NetworkinstanceProtocolsBgpNeighborFailureDetection) DeepCopy() *NetworkinstanceProtocolsBgpNeighborFailureDetection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupFailureDetection.
+func (in *NetworkinstanceProtocolsBgpGroupFailureDetection) DeepCopy() *NetworkinstanceProtocolsBgpGroupFailureDetection {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborFailureDetection)
+	out := new(NetworkinstanceProtocolsBgpGroupFailureDetection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborGracefulRestart) {
+func (in *NetworkinstanceProtocolsBgpGroupGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupGracefulRestart) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -5335,45 +5192,20 @@ func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestart) DeepCopyInto(out *
 		*out = new(uint16)
 		**out = **in
 	}
-	if in.WarmRestart != nil {
-		in, out := &in.WarmRestart, &out.WarmRestart
-		*out = new(NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborGracefulRestart.
-func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestart) DeepCopy() *NetworkinstanceProtocolsBgpNeighborGracefulRestart {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsBgpNeighborGracefulRestart)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart) {
-	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart.
-func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart) DeepCopy() *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupGracefulRestart.
+func (in *NetworkinstanceProtocolsBgpGroupGracefulRestart) DeepCopy() *NetworkinstanceProtocolsBgpGroupGracefulRestart {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart)
+	out := new(NetworkinstanceProtocolsBgpGroupGracefulRestart)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) {
+func (in *NetworkinstanceProtocolsBgpGroupIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv4Unicast) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -5387,7 +5219,7 @@ func (in *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) DeepCopyInto(out *Netw
 	}
 	if in.PrefixLimit != nil {
 		in, out := &in.PrefixLimit, &out.PrefixLimit
-		*out = new(NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit)
+		*out = new(NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ReceiveIpv6NextHops != nil {
@@ -5397,18 +5229,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) DeepCopyInto(out *Netw
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv4Unicast.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv4Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv4Unicast.
+func (in *NetworkinstanceProtocolsBgpGroupIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv4Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborIpv4Unicast)
+	out := new(NetworkinstanceProtocolsBgpGroupIpv4Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) {
+func (in *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit) {
 	*out = *in
 	if in.MaxReceivedRoutes != nil {
 		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
@@ -5422,18 +5254,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) DeepCopyInt
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit.
+func (in *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit)
+	out := new(NetworkinstanceProtocolsBgpGroupIpv4UnicastPrefixLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) {
+func (in *NetworkinstanceProtocolsBgpGroupIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv6Unicast) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -5442,23 +5274,23 @@ func (in *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) DeepCopyInto(out *Netw
 	}
 	if in.PrefixLimit != nil {
 		in, out := &in.PrefixLimit, &out.PrefixLimit
-		*out = new(NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit)
+		*out = new(NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv6Unicast.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv6Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv6Unicast.
+func (in *NetworkinstanceProtocolsBgpGroupIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv6Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborIpv6Unicast)
+	out := new(NetworkinstanceProtocolsBgpGroupIpv6Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) {
+func (in *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit) {
 	*out = *in
 	if in.MaxReceivedRoutes != nil {
 		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
@@ -5472,18 +5304,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) DeepCopyInt
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit.
-func (in *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit.
+func (in *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit)
+	out := new(NetworkinstanceProtocolsBgpGroupIpv6UnicastPrefixLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborLocalAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborLocalAs) {
+func (in *NetworkinstanceProtocolsBgpGroupLocalAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupLocalAs) {
 	*out = *in
 	if in.AsNumber != nil {
 		in, out := &in.AsNumber, &out.AsNumber
@@ -5502,18 +5334,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborLocalAs) DeepCopyInto(out *Networki
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborLocalAs.
-func (in *NetworkinstanceProtocolsBgpNeighborLocalAs) DeepCopy() *NetworkinstanceProtocolsBgpNeighborLocalAs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupLocalAs.
+func (in *NetworkinstanceProtocolsBgpGroupLocalAs) DeepCopy() *NetworkinstanceProtocolsBgpGroupLocalAs {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborLocalAs)
+	out := new(NetworkinstanceProtocolsBgpGroupLocalAs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborRouteReflector) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborRouteReflector) {
+func (in *NetworkinstanceProtocolsBgpGroupRouteReflector) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupRouteReflector) {
 	*out = *in
 	if in.Client != nil {
 		in, out := &in.Client, &out.Client
@@ -5527,18 +5359,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborRouteReflector) DeepCopyInto(out *N
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborRouteReflector.
-func (in *NetworkinstanceProtocolsBgpNeighborRouteReflector) DeepCopy() *NetworkinstanceProtocolsBgpNeighborRouteReflector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupRouteReflector.
+func (in *NetworkinstanceProtocolsBgpGroupRouteReflector) DeepCopy() *NetworkinstanceProtocolsBgpGroupRouteReflector {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborRouteReflector)
+	out := new(NetworkinstanceProtocolsBgpGroupRouteReflector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborSendCommunity) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborSendCommunity) {
+func (in *NetworkinstanceProtocolsBgpGroupSendCommunity) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupSendCommunity) {
 	*out = *in
 	if in.Large != nil {
 		in, out := &in.Large, &out.Large
@@ -5552,18 +5384,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborSendCommunity) DeepCopyInto(out *Ne
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborSendCommunity.
-func (in *NetworkinstanceProtocolsBgpNeighborSendCommunity) DeepCopy() *NetworkinstanceProtocolsBgpNeighborSendCommunity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupSendCommunity.
+func (in *NetworkinstanceProtocolsBgpGroupSendCommunity) DeepCopy() *NetworkinstanceProtocolsBgpGroupSendCommunity {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborSendCommunity)
+	out := new(NetworkinstanceProtocolsBgpGroupSendCommunity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) {
+func (in *NetworkinstanceProtocolsBgpGroupSendDefaultRoute) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupSendDefaultRoute) {
 	*out = *in
 	if in.ExportPolicy != nil {
 		in, out := &in.ExportPolicy, &out.ExportPolicy
@@ -5582,18 +5414,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) DeepCopyInto(out
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborSendDefaultRoute.
-func (in *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) DeepCopy() *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupSendDefaultRoute.
+func (in *NetworkinstanceProtocolsBgpGroupSendDefaultRoute) DeepCopy() *NetworkinstanceProtocolsBgpGroupSendDefaultRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborSendDefaultRoute)
+	out := new(NetworkinstanceProtocolsBgpGroupSendDefaultRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborTimers) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTimers) {
+func (in *NetworkinstanceProtocolsBgpGroupTimers) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTimers) {
 	*out = *in
 	if in.ConnectRetry != nil {
 		in, out := &in.ConnectRetry, &out.ConnectRetry
@@ -5617,44 +5449,44 @@ func (in *NetworkinstanceProtocolsBgpNeighborTimers) DeepCopyInto(out *Networkin
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTimers.
-func (in *NetworkinstanceProtocolsBgpNeighborTimers) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTimers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTimers.
+func (in *NetworkinstanceProtocolsBgpGroupTimers) DeepCopy() *NetworkinstanceProtocolsBgpGroupTimers {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborTimers)
+	out := new(NetworkinstanceProtocolsBgpGroupTimers)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTraceOptions) {
+func (in *NetworkinstanceProtocolsBgpGroupTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTraceOptions) {
 	*out = *in
 	if in.Flag != nil {
 		in, out := &in.Flag, &out.Flag
-		*out = make([]*NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag, len(*in))
+		*out = make([]*NetworkinstanceProtocolsBgpGroupTraceOptionsFlag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag)
+				*out = new(NetworkinstanceProtocolsBgpGroupTraceOptionsFlag)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTraceOptions.
-func (in *NetworkinstanceProtocolsBgpNeighborTraceOptions) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTraceOptions.
+func (in *NetworkinstanceProtocolsBgpGroupTraceOptions) DeepCopy() *NetworkinstanceProtocolsBgpGroupTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborTraceOptions)
+	out := new(NetworkinstanceProtocolsBgpGroupTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) {
+func (in *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag) {
 	*out = *in
 	if in.Modifier != nil {
 		in, out := &in.Modifier, &out.Modifier
@@ -5668,18 +5500,18 @@ func (in *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) DeepCopyInto(out
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag.
-func (in *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTraceOptionsFlag.
+func (in *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag) DeepCopy() *NetworkinstanceProtocolsBgpGroupTraceOptionsFlag {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag)
+	out := new(NetworkinstanceProtocolsBgpGroupTraceOptionsFlag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpNeighborTransport) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTransport) {
+func (in *NetworkinstanceProtocolsBgpGroupTransport) DeepCopyInto(out *NetworkinstanceProtocolsBgpGroupTransport) {
 	*out = *in
 	if in.LocalAddress != nil {
 		in, out := &in.LocalAddress, &out.LocalAddress
@@ -5698,2555 +5530,4008 @@ func (in *NetworkinstanceProtocolsBgpNeighborTransport) DeepCopyInto(out *Networ
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTransport.
-func (in *NetworkinstanceProtocolsBgpNeighborTransport) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTransport {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsBgpNeighborTransport)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpObservation) DeepCopyInto(out *NetworkinstanceProtocolsBgpObservation) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpObservation.
-func (in *NetworkinstanceProtocolsBgpObservation) DeepCopy() *NetworkinstanceProtocolsBgpObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpGroupTransport.
+func (in *NetworkinstanceProtocolsBgpGroupTransport) DeepCopy() *NetworkinstanceProtocolsBgpGroupTransport {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpObservation)
+	out := new(NetworkinstanceProtocolsBgpGroupTransport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpParameters) DeepCopyInto(out *NetworkinstanceProtocolsBgpParameters) {
+func (in *NetworkinstanceProtocolsBgpIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv4Unicast) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.SrlNetworkinstanceProtocolsBgp != nil {
-		in, out := &in.SrlNetworkinstanceProtocolsBgp, &out.SrlNetworkinstanceProtocolsBgp
-		*out = new(NetworkinstanceProtocolsBgp)
+	if in.AdvertiseIpv6NextHops != nil {
+		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(NetworkinstanceProtocolsBgpIpv4UnicastConvergence)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Multipath != nil {
+		in, out := &in.Multipath, &out.Multipath
+		*out = new(NetworkinstanceProtocolsBgpIpv4UnicastMultipath)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReceiveIpv6NextHops != nil {
+		in, out := &in.ReceiveIpv6NextHops, &out.ReceiveIpv6NextHops
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpParameters.
-func (in *NetworkinstanceProtocolsBgpParameters) DeepCopy() *NetworkinstanceProtocolsBgpParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv4Unicast.
+func (in *NetworkinstanceProtocolsBgpIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsBgpIpv4Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpParameters)
+	out := new(NetworkinstanceProtocolsBgpIpv4Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpPreference) DeepCopyInto(out *NetworkinstanceProtocolsBgpPreference) {
+func (in *NetworkinstanceProtocolsBgpIpv4UnicastConvergence) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv4UnicastConvergence) {
 	*out = *in
-	if in.Ebgp != nil {
-		in, out := &in.Ebgp, &out.Ebgp
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Ibgp != nil {
-		in, out := &in.Ibgp, &out.Ibgp
-		*out = new(uint8)
+	if in.MaxWaitToAdvertise != nil {
+		in, out := &in.MaxWaitToAdvertise, &out.MaxWaitToAdvertise
+		*out = new(uint16)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpPreference.
-func (in *NetworkinstanceProtocolsBgpPreference) DeepCopy() *NetworkinstanceProtocolsBgpPreference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv4UnicastConvergence.
+func (in *NetworkinstanceProtocolsBgpIpv4UnicastConvergence) DeepCopy() *NetworkinstanceProtocolsBgpIpv4UnicastConvergence {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpPreference)
+	out := new(NetworkinstanceProtocolsBgpIpv4UnicastConvergence)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpRouteAdvertisement) DeepCopyInto(out *NetworkinstanceProtocolsBgpRouteAdvertisement) {
+func (in *NetworkinstanceProtocolsBgpIpv4UnicastMultipath) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv4UnicastMultipath) {
 	*out = *in
-	if in.RapidWithdrawal != nil {
-		in, out := &in.RapidWithdrawal, &out.RapidWithdrawal
-		*out = new(bool)
-		**out = **in
-	}
-	if in.WaitForFibInstall != nil {
-		in, out := &in.WaitForFibInstall, &out.WaitForFibInstall
+	if in.AllowMultipleAs != nil {
+		in, out := &in.AllowMultipleAs, &out.AllowMultipleAs
 		*out = new(bool)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpRouteAdvertisement.
-func (in *NetworkinstanceProtocolsBgpRouteAdvertisement) DeepCopy() *NetworkinstanceProtocolsBgpRouteAdvertisement {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsBgpRouteAdvertisement)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpRouteReflector) DeepCopyInto(out *NetworkinstanceProtocolsBgpRouteReflector) {
-	*out = *in
-	if in.Client != nil {
-		in, out := &in.Client, &out.Client
-		*out = new(bool)
+	if in.MaxPathsLevel1 != nil {
+		in, out := &in.MaxPathsLevel1, &out.MaxPathsLevel1
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.ClusterId != nil {
-		in, out := &in.ClusterId, &out.ClusterId
-		*out = new(string)
+	if in.MaxPathsLevel2 != nil {
+		in, out := &in.MaxPathsLevel2, &out.MaxPathsLevel2
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpRouteReflector.
-func (in *NetworkinstanceProtocolsBgpRouteReflector) DeepCopy() *NetworkinstanceProtocolsBgpRouteReflector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv4UnicastMultipath.
+func (in *NetworkinstanceProtocolsBgpIpv4UnicastMultipath) DeepCopy() *NetworkinstanceProtocolsBgpIpv4UnicastMultipath {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpRouteReflector)
+	out := new(NetworkinstanceProtocolsBgpIpv4UnicastMultipath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpSendCommunity) DeepCopyInto(out *NetworkinstanceProtocolsBgpSendCommunity) {
+func (in *NetworkinstanceProtocolsBgpIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv6Unicast) {
 	*out = *in
-	if in.Large != nil {
-		in, out := &in.Large, &out.Large
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Standard != nil {
-		in, out := &in.Standard, &out.Standard
-		*out = new(bool)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpSendCommunity.
-func (in *NetworkinstanceProtocolsBgpSendCommunity) DeepCopy() *NetworkinstanceProtocolsBgpSendCommunity {
-	if in == nil {
-		return nil
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(NetworkinstanceProtocolsBgpIpv6UnicastConvergence)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(NetworkinstanceProtocolsBgpSendCommunity)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpSpec) DeepCopyInto(out *NetworkinstanceProtocolsBgpSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpSpec.
-func (in *NetworkinstanceProtocolsBgpSpec) DeepCopy() *NetworkinstanceProtocolsBgpSpec {
-	if in == nil {
-		return nil
+	if in.Multipath != nil {
+		in, out := &in.Multipath, &out.Multipath
+		*out = new(NetworkinstanceProtocolsBgpIpv6UnicastMultipath)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(NetworkinstanceProtocolsBgpSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpStatus) DeepCopyInto(out *NetworkinstanceProtocolsBgpStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpStatus.
-func (in *NetworkinstanceProtocolsBgpStatus) DeepCopy() *NetworkinstanceProtocolsBgpStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv6Unicast.
+func (in *NetworkinstanceProtocolsBgpIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsBgpIpv6Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpStatus)
+	out := new(NetworkinstanceProtocolsBgpIpv6Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpTraceOptions) {
+func (in *NetworkinstanceProtocolsBgpIpv6UnicastConvergence) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv6UnicastConvergence) {
 	*out = *in
-	if in.Flag != nil {
-		in, out := &in.Flag, &out.Flag
-		*out = make([]*NetworkinstanceProtocolsBgpTraceOptionsFlag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpTraceOptionsFlag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.MaxWaitToAdvertise != nil {
+		in, out := &in.MaxWaitToAdvertise, &out.MaxWaitToAdvertise
+		*out = new(uint16)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpTraceOptions.
-func (in *NetworkinstanceProtocolsBgpTraceOptions) DeepCopy() *NetworkinstanceProtocolsBgpTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv6UnicastConvergence.
+func (in *NetworkinstanceProtocolsBgpIpv6UnicastConvergence) DeepCopy() *NetworkinstanceProtocolsBgpIpv6UnicastConvergence {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpTraceOptions)
+	out := new(NetworkinstanceProtocolsBgpIpv6UnicastConvergence)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpTraceOptionsFlag) DeepCopyInto(out *NetworkinstanceProtocolsBgpTraceOptionsFlag) {
+func (in *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) DeepCopyInto(out *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) {
 	*out = *in
-	if in.Modifier != nil {
-		in, out := &in.Modifier, &out.Modifier
-		*out = new(string)
+	if in.AllowMultipleAs != nil {
+		in, out := &in.AllowMultipleAs, &out.AllowMultipleAs
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.MaxPathsLevel1 != nil {
+		in, out := &in.MaxPathsLevel1, &out.MaxPathsLevel1
+		*out = new(uint32)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpTraceOptionsFlag.
-func (in *NetworkinstanceProtocolsBgpTraceOptionsFlag) DeepCopy() *NetworkinstanceProtocolsBgpTraceOptionsFlag {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsBgpTraceOptionsFlag)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpTransport) DeepCopyInto(out *NetworkinstanceProtocolsBgpTransport) {
-	*out = *in
-	if in.TcpMss != nil {
-		in, out := &in.TcpMss, &out.TcpMss
-		*out = new(uint16)
+	if in.MaxPathsLevel2 != nil {
+		in, out := &in.MaxPathsLevel2, &out.MaxPathsLevel2
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpTransport.
-func (in *NetworkinstanceProtocolsBgpTransport) DeepCopy() *NetworkinstanceProtocolsBgpTransport {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsBgpTransport)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpn) {
-	*out = *in
-	if in.BgpInstance != nil {
-		in, out := &in.BgpInstance, &out.BgpInstance
-		*out = make([]*NetworkinstanceProtocolsBgpevpnBgpInstance, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpevpnBgpInstance)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpn.
-func (in *NetworkinstanceProtocolsBgpevpn) DeepCopy() *NetworkinstanceProtocolsBgpevpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpIpv6UnicastMultipath.
+func (in *NetworkinstanceProtocolsBgpIpv6UnicastMultipath) DeepCopy() *NetworkinstanceProtocolsBgpIpv6UnicastMultipath {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpn)
+	out := new(NetworkinstanceProtocolsBgpIpv6UnicastMultipath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstance) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstance) {
+func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighbor) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.DefaultAdminTag != nil {
-		in, out := &in.DefaultAdminTag, &out.DefaultAdminTag
-		*out = new(uint32)
-		**out = **in
+	if in.AsPathOptions != nil {
+		in, out := &in.AsPathOptions, &out.AsPathOptions
+		*out = new(NetworkinstanceProtocolsBgpNeighborAsPathOptions)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Ecmp != nil {
-		in, out := &in.Ecmp, &out.Ecmp
-		*out = new(uint8)
-		**out = **in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsBgpNeighborAuthentication)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.EncapsulationType != nil {
-		in, out := &in.EncapsulationType, &out.EncapsulationType
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Evi != nil {
-		in, out := &in.Evi, &out.Evi
-		*out = new(uint32)
-		**out = **in
+	if in.Evpn != nil {
+		in, out := &in.Evpn, &out.Evpn
+		*out = new(NetworkinstanceProtocolsBgpNeighborEvpn)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Id != nil {
-		in, out := &in.Id, &out.Id
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes)
+	if in.FailureDetection != nil {
+		in, out := &in.FailureDetection, &out.FailureDetection
+		*out = new(NetworkinstanceProtocolsBgpNeighborFailureDetection)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.VxlanInterface != nil {
-		in, out := &in.VxlanInterface, &out.VxlanInterface
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstance.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstance) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstance {
-	if in == nil {
-		return nil
+	if in.GracefulRestart != nil {
+		in, out := &in.GracefulRestart, &out.GracefulRestart
+		*out = new(NetworkinstanceProtocolsBgpNeighborGracefulRestart)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstance)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) {
-	*out = *in
-	if in.BridgeTable != nil {
-		in, out := &in.BridgeTable, &out.BridgeTable
-		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable)
+	if in.ImportPolicy != nil {
+		in, out := &in.ImportPolicy, &out.ImportPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv4Unicast != nil {
+		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
+		*out = new(NetworkinstanceProtocolsBgpNeighborIpv4Unicast)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RouteTable != nil {
-		in, out := &in.RouteTable, &out.RouteTable
-		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable)
+	if in.Ipv6Unicast != nil {
+		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
+		*out = new(NetworkinstanceProtocolsBgpNeighborIpv6Unicast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalAs != nil {
+		in, out := &in.LocalAs, &out.LocalAs
+		*out = make([]*NetworkinstanceProtocolsBgpNeighborLocalAs, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsBgpNeighborLocalAs)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NextHopSelf != nil {
+		in, out := &in.NextHopSelf, &out.NextHopSelf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PeerAddress != nil {
+		in, out := &in.PeerAddress, &out.PeerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerAs != nil {
+		in, out := &in.PeerAs, &out.PeerAs
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PeerGroup != nil {
+		in, out := &in.PeerGroup, &out.PeerGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteReflector != nil {
+		in, out := &in.RouteReflector, &out.RouteReflector
+		*out = new(NetworkinstanceProtocolsBgpNeighborRouteReflector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendCommunity != nil {
+		in, out := &in.SendCommunity, &out.SendCommunity
+		*out = new(NetworkinstanceProtocolsBgpNeighborSendCommunity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendDefaultRoute != nil {
+		in, out := &in.SendDefaultRoute, &out.SendDefaultRoute
+		*out = new(NetworkinstanceProtocolsBgpNeighborSendDefaultRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timers != nil {
+		in, out := &in.Timers, &out.Timers
+		*out = new(NetworkinstanceProtocolsBgpNeighborTimers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(NetworkinstanceProtocolsBgpNeighborTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transport != nil {
+		in, out := &in.Transport, &out.Transport
+		*out = new(NetworkinstanceProtocolsBgpNeighborTransport)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighbor.
+func (in *NetworkinstanceProtocolsBgpNeighbor) DeepCopy() *NetworkinstanceProtocolsBgpNeighbor {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes)
+	out := new(NetworkinstanceProtocolsBgpNeighbor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable) {
+func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborAsPathOptions) {
 	*out = *in
-	if in.InclusiveMcast != nil {
-		in, out := &in.InclusiveMcast, &out.InclusiveMcast
-		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast)
-		(*in).DeepCopyInto(*out)
+	if in.AllowOwnAs != nil {
+		in, out := &in.AllowOwnAs, &out.AllowOwnAs
+		*out = new(uint8)
+		**out = **in
 	}
-	if in.MacIp != nil {
-		in, out := &in.MacIp, &out.MacIp
-		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp)
+	if in.RemovePrivateAs != nil {
+		in, out := &in.RemovePrivateAs, &out.RemovePrivateAs
+		*out = new(NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NextHop != nil {
-		in, out := &in.NextHop, &out.NextHop
-		*out = new(string)
+	if in.ReplacePeerAs != nil {
+		in, out := &in.ReplacePeerAs, &out.ReplacePeerAs
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborAsPathOptions.
+func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptions) DeepCopy() *NetworkinstanceProtocolsBgpNeighborAsPathOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable)
+	out := new(NetworkinstanceProtocolsBgpNeighborAsPathOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast) {
+func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) {
 	*out = *in
-	if in.Advertise != nil {
-		in, out := &in.Advertise, &out.Advertise
+	if in.IgnorePeerAs != nil {
+		in, out := &in.IgnorePeerAs, &out.IgnorePeerAs
 		*out = new(bool)
 		**out = **in
 	}
-	if in.OriginatingIp != nil {
-		in, out := &in.OriginatingIp, &out.OriginatingIp
+	if in.LeadingOnly != nil {
+		in, out := &in.LeadingOnly, &out.LeadingOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs.
+func (in *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs) DeepCopy() *NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast)
+	out := new(NetworkinstanceProtocolsBgpNeighborAsPathOptionsRemovePrivateAs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp) {
+func (in *NetworkinstanceProtocolsBgpNeighborAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborAuthentication) {
 	*out = *in
-	if in.Advertise != nil {
-		in, out := &in.Advertise, &out.Advertise
-		*out = new(bool)
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborAuthentication.
+func (in *NetworkinstanceProtocolsBgpNeighborAuthentication) DeepCopy() *NetworkinstanceProtocolsBgpNeighborAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp)
+	out := new(NetworkinstanceProtocolsBgpNeighborAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable) {
+func (in *NetworkinstanceProtocolsBgpNeighborEvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborEvpn) {
 	*out = *in
-	if in.MacIp != nil {
-		in, out := &in.MacIp, &out.MacIp
-		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertiseIpv6NextHops != nil {
+		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrefixLimit != nil {
+		in, out := &in.PrefixLimit, &out.PrefixLimit
+		*out = new(NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborEvpn.
+func (in *NetworkinstanceProtocolsBgpNeighborEvpn) DeepCopy() *NetworkinstanceProtocolsBgpNeighborEvpn {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable)
+	out := new(NetworkinstanceProtocolsBgpNeighborEvpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp) {
+func (in *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) {
 	*out = *in
-	if in.AdvertiseGatewayMac != nil {
-		in, out := &in.AdvertiseGatewayMac, &out.AdvertiseGatewayMac
-		*out = new(bool)
+	if in.MaxReceivedRoutes != nil {
+		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.WarningThresholdPct != nil {
+		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
+		*out = new(uint8)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp.
-func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit.
+func (in *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp)
+	out := new(NetworkinstanceProtocolsBgpNeighborEvpnPrefixLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnObservation) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnObservation) {
+func (in *NetworkinstanceProtocolsBgpNeighborFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborFailureDetection) {
 	*out = *in
+	if in.EnableBfd != nil {
+		in, out := &in.EnableBfd, &out.EnableBfd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FastFailover != nil {
+		in, out := &in.FastFailover, &out.FastFailover
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnObservation.
-func (in *NetworkinstanceProtocolsBgpevpnObservation) DeepCopy() *NetworkinstanceProtocolsBgpevpnObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborFailureDetection.
+func (in *NetworkinstanceProtocolsBgpNeighborFailureDetection) DeepCopy() *NetworkinstanceProtocolsBgpNeighborFailureDetection {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnObservation)
+	out := new(NetworkinstanceProtocolsBgpNeighborFailureDetection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnParameters) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnParameters) {
+func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborGracefulRestart) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.SrlNetworkinstanceProtocolsBgpevpn != nil {
-		in, out := &in.SrlNetworkinstanceProtocolsBgpevpn, &out.SrlNetworkinstanceProtocolsBgpevpn
-		*out = new(NetworkinstanceProtocolsBgpevpn)
+	if in.StaleRoutesTime != nil {
+		in, out := &in.StaleRoutesTime, &out.StaleRoutesTime
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.WarmRestart != nil {
+		in, out := &in.WarmRestart, &out.WarmRestart
+		*out = new(NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnParameters.
-func (in *NetworkinstanceProtocolsBgpevpnParameters) DeepCopy() *NetworkinstanceProtocolsBgpevpnParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborGracefulRestart.
+func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestart) DeepCopy() *NetworkinstanceProtocolsBgpNeighborGracefulRestart {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnParameters)
+	out := new(NetworkinstanceProtocolsBgpNeighborGracefulRestart)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnSpec) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnSpec) {
+func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnSpec.
-func (in *NetworkinstanceProtocolsBgpevpnSpec) DeepCopy() *NetworkinstanceProtocolsBgpevpnSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart.
+func (in *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart) DeepCopy() *NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnSpec)
+	out := new(NetworkinstanceProtocolsBgpNeighborGracefulRestartWarmRestart)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpevpnStatus) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnStatus) {
+func (in *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertiseIpv6NextHops != nil {
+		in, out := &in.AdvertiseIpv6NextHops, &out.AdvertiseIpv6NextHops
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrefixLimit != nil {
+		in, out := &in.PrefixLimit, &out.PrefixLimit
+		*out = new(NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReceiveIpv6NextHops != nil {
+		in, out := &in.ReceiveIpv6NextHops, &out.ReceiveIpv6NextHops
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnStatus.
-func (in *NetworkinstanceProtocolsBgpevpnStatus) DeepCopy() *NetworkinstanceProtocolsBgpevpnStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv4Unicast.
+func (in *NetworkinstanceProtocolsBgpNeighborIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv4Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpevpnStatus)
+	out := new(NetworkinstanceProtocolsBgpNeighborIpv4Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpn) {
+func (in *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) {
 	*out = *in
-	if in.BgpInstance != nil {
-		in, out := &in.BgpInstance, &out.BgpInstance
-		*out = make([]*NetworkinstanceProtocolsBgpvpnBgpInstance, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsBgpvpnBgpInstance)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.MaxReceivedRoutes != nil {
+		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.WarningThresholdPct != nil {
+		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
+		*out = new(uint8)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpn.
-func (in *NetworkinstanceProtocolsBgpvpn) DeepCopy() *NetworkinstanceProtocolsBgpvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit.
+func (in *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpn)
+	out := new(NetworkinstanceProtocolsBgpNeighborIpv4UnicastPrefixLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnBgpInstance) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnBgpInstance) {
+func (in *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) {
 	*out = *in
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.Id != nil {
-		in, out := &in.Id, &out.Id
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.ImportPolicy != nil {
-		in, out := &in.ImportPolicy, &out.ImportPolicy
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.RouteDistinguisher != nil {
-		in, out := &in.RouteDistinguisher, &out.RouteDistinguisher
-		*out = new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RouteTarget != nil {
-		in, out := &in.RouteTarget, &out.RouteTarget
-		*out = new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget)
+	if in.PrefixLimit != nil {
+		in, out := &in.PrefixLimit, &out.PrefixLimit
+		*out = new(NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnBgpInstance.
-func (in *NetworkinstanceProtocolsBgpvpnBgpInstance) DeepCopy() *NetworkinstanceProtocolsBgpvpnBgpInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv6Unicast.
+func (in *NetworkinstanceProtocolsBgpNeighborIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv6Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnBgpInstance)
+	out := new(NetworkinstanceProtocolsBgpNeighborIpv6Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher) {
+func (in *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) {
 	*out = *in
-	if in.Rd != nil {
-		in, out := &in.Rd, &out.Rd
-		*out = new(string)
+	if in.MaxReceivedRoutes != nil {
+		in, out := &in.MaxReceivedRoutes, &out.MaxReceivedRoutes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.WarningThresholdPct != nil {
+		in, out := &in.WarningThresholdPct, &out.WarningThresholdPct
+		*out = new(uint8)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher.
-func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher) DeepCopy() *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit.
+func (in *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit) DeepCopy() *NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher)
+	out := new(NetworkinstanceProtocolsBgpNeighborIpv6UnicastPrefixLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget) {
+func (in *NetworkinstanceProtocolsBgpNeighborLocalAs) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborLocalAs) {
 	*out = *in
-	if in.ExportRt != nil {
-		in, out := &in.ExportRt, &out.ExportRt
-		*out = new(string)
+	if in.AsNumber != nil {
+		in, out := &in.AsNumber, &out.AsNumber
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.ImportRt != nil {
-		in, out := &in.ImportRt, &out.ImportRt
-		*out = new(string)
+	if in.PrependGlobalAs != nil {
+		in, out := &in.PrependGlobalAs, &out.PrependGlobalAs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrependLocalAs != nil {
+		in, out := &in.PrependLocalAs, &out.PrependLocalAs
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget.
-func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget) DeepCopy() *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborLocalAs.
+func (in *NetworkinstanceProtocolsBgpNeighborLocalAs) DeepCopy() *NetworkinstanceProtocolsBgpNeighborLocalAs {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget)
+	out := new(NetworkinstanceProtocolsBgpNeighborLocalAs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnObservation) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnObservation) {
+func (in *NetworkinstanceProtocolsBgpNeighborRouteReflector) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborRouteReflector) {
 	*out = *in
+	if in.Client != nil {
+		in, out := &in.Client, &out.Client
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterId != nil {
+		in, out := &in.ClusterId, &out.ClusterId
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnObservation.
-func (in *NetworkinstanceProtocolsBgpvpnObservation) DeepCopy() *NetworkinstanceProtocolsBgpvpnObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborRouteReflector.
+func (in *NetworkinstanceProtocolsBgpNeighborRouteReflector) DeepCopy() *NetworkinstanceProtocolsBgpNeighborRouteReflector {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnObservation)
+	out := new(NetworkinstanceProtocolsBgpNeighborRouteReflector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnParameters) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnParameters) {
+func (in *NetworkinstanceProtocolsBgpNeighborSendCommunity) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborSendCommunity) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
-		*out = new(string)
+	if in.Large != nil {
+		in, out := &in.Large, &out.Large
+		*out = new(bool)
 		**out = **in
 	}
-	if in.SrlNetworkinstanceProtocolsBgpvpn != nil {
-		in, out := &in.SrlNetworkinstanceProtocolsBgpvpn, &out.SrlNetworkinstanceProtocolsBgpvpn
-		*out = new(NetworkinstanceProtocolsBgpvpn)
-		(*in).DeepCopyInto(*out)
+	if in.Standard != nil {
+		in, out := &in.Standard, &out.Standard
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnParameters.
-func (in *NetworkinstanceProtocolsBgpvpnParameters) DeepCopy() *NetworkinstanceProtocolsBgpvpnParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborSendCommunity.
+func (in *NetworkinstanceProtocolsBgpNeighborSendCommunity) DeepCopy() *NetworkinstanceProtocolsBgpNeighborSendCommunity {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnParameters)
+	out := new(NetworkinstanceProtocolsBgpNeighborSendCommunity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnSpec) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnSpec) {
+func (in *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv4Unicast != nil {
+		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ipv6Unicast != nil {
+		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnSpec.
-func (in *NetworkinstanceProtocolsBgpvpnSpec) DeepCopy() *NetworkinstanceProtocolsBgpvpnSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborSendDefaultRoute.
+func (in *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute) DeepCopy() *NetworkinstanceProtocolsBgpNeighborSendDefaultRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnSpec)
+	out := new(NetworkinstanceProtocolsBgpNeighborSendDefaultRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsBgpvpnStatus) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnStatus) {
+func (in *NetworkinstanceProtocolsBgpNeighborTimers) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTimers) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.ConnectRetry != nil {
+		in, out := &in.ConnectRetry, &out.ConnectRetry
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.KeepaliveInterval != nil {
+		in, out := &in.KeepaliveInterval, &out.KeepaliveInterval
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.MinimumAdvertisementInterval != nil {
+		in, out := &in.MinimumAdvertisementInterval, &out.MinimumAdvertisementInterval
+		*out = new(uint16)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnStatus.
-func (in *NetworkinstanceProtocolsBgpvpnStatus) DeepCopy() *NetworkinstanceProtocolsBgpvpnStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTimers.
+func (in *NetworkinstanceProtocolsBgpNeighborTimers) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTimers {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsBgpvpnStatus)
+	out := new(NetworkinstanceProtocolsBgpNeighborTimers)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsis) DeepCopyInto(out *NetworkinstanceProtocolsIsis) {
+func (in *NetworkinstanceProtocolsBgpNeighborTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTraceOptions) {
 	*out = *in
-	if in.Instance != nil {
-		in, out := &in.Instance, &out.Instance
-		*out = make([]*NetworkinstanceProtocolsIsisInstance, len(*in))
+	if in.Flag != nil {
+		in, out := &in.Flag, &out.Flag
+		*out = make([]*NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsIsisInstance)
+				*out = new(NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsis.
-func (in *NetworkinstanceProtocolsIsis) DeepCopy() *NetworkinstanceProtocolsIsis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTraceOptions.
+func (in *NetworkinstanceProtocolsBgpNeighborTraceOptions) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsis)
+	out := new(NetworkinstanceProtocolsBgpNeighborTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstance) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstance) {
+func (in *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.AttachedBit != nil {
-		in, out := &in.AttachedBit, &out.AttachedBit
-		*out = new(NetworkinstanceProtocolsIsisInstanceAttachedBit)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsIsisInstanceAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AutoCost != nil {
-		in, out := &in.AutoCost, &out.AutoCost
-		*out = new(NetworkinstanceProtocolsIsisInstanceAutoCost)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.GracefulRestart != nil {
-		in, out := &in.GracefulRestart, &out.GracefulRestart
-		*out = new(NetworkinstanceProtocolsIsisInstanceGracefulRestart)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.InterLevelPropagationPolicies != nil {
-		in, out := &in.InterLevelPropagationPolicies, &out.InterLevelPropagationPolicies
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Interface != nil {
-		in, out := &in.Interface, &out.Interface
-		*out = make([]*NetworkinstanceProtocolsIsisInstanceInterface, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsIsisInstanceInterface)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Ipv4Unicast != nil {
-		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
-		*out = new(NetworkinstanceProtocolsIsisInstanceIpv4Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ipv6Unicast != nil {
-		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
-		*out = new(NetworkinstanceProtocolsIsisInstanceIpv6Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LdpSynchronization != nil {
-		in, out := &in.LdpSynchronization, &out.LdpSynchronization
-		*out = new(NetworkinstanceProtocolsIsisInstanceLdpSynchronization)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = make([]*NetworkinstanceProtocolsIsisInstanceLevel, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsIsisInstanceLevel)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.LevelCapability != nil {
-		in, out := &in.LevelCapability, &out.LevelCapability
+	if in.Modifier != nil {
+		in, out := &in.Modifier, &out.Modifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxEcmpPaths != nil {
-		in, out := &in.MaxEcmpPaths, &out.MaxEcmpPaths
-		*out = new(uint8)
-		**out = **in
-	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Net != nil {
-		in, out := &in.Net, &out.Net
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag.
+func (in *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsBgpNeighborTraceOptionsFlag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpNeighborTransport) DeepCopyInto(out *NetworkinstanceProtocolsBgpNeighborTransport) {
+	*out = *in
+	if in.LocalAddress != nil {
+		in, out := &in.LocalAddress, &out.LocalAddress
 		*out = new(string)
 		**out = **in
 	}
-	if in.Overload != nil {
-		in, out := &in.Overload, &out.Overload
-		*out = new(NetworkinstanceProtocolsIsisInstanceOverload)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PoiTlv != nil {
-		in, out := &in.PoiTlv, &out.PoiTlv
+	if in.PassiveMode != nil {
+		in, out := &in.PassiveMode, &out.PassiveMode
 		*out = new(bool)
 		**out = **in
 	}
-	if in.TeDatabaseInstall != nil {
-		in, out := &in.TeDatabaseInstall, &out.TeDatabaseInstall
-		*out = new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Timers != nil {
-		in, out := &in.Timers, &out.Timers
-		*out = new(NetworkinstanceProtocolsIsisInstanceTimers)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsIsisInstanceTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TrafficEngineering != nil {
-		in, out := &in.TrafficEngineering, &out.TrafficEngineering
-		*out = new(NetworkinstanceProtocolsIsisInstanceTrafficEngineering)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Transport != nil {
-		in, out := &in.Transport, &out.Transport
-		*out = new(NetworkinstanceProtocolsIsisInstanceTransport)
-		(*in).DeepCopyInto(*out)
+	if in.TcpMss != nil {
+		in, out := &in.TcpMss, &out.TcpMss
+		*out = new(uint16)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstance.
-func (in *NetworkinstanceProtocolsIsisInstance) DeepCopy() *NetworkinstanceProtocolsIsisInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpNeighborTransport.
+func (in *NetworkinstanceProtocolsBgpNeighborTransport) DeepCopy() *NetworkinstanceProtocolsBgpNeighborTransport {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstance)
+	out := new(NetworkinstanceProtocolsBgpNeighborTransport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceAttachedBit) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceAttachedBit) {
+func (in *NetworkinstanceProtocolsBgpObservation) DeepCopyInto(out *NetworkinstanceProtocolsBgpObservation) {
 	*out = *in
-	if in.Ignore != nil {
-		in, out := &in.Ignore, &out.Ignore
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Suppress != nil {
-		in, out := &in.Suppress, &out.Suppress
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceAttachedBit.
-func (in *NetworkinstanceProtocolsIsisInstanceAttachedBit) DeepCopy() *NetworkinstanceProtocolsIsisInstanceAttachedBit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpObservation.
+func (in *NetworkinstanceProtocolsBgpObservation) DeepCopy() *NetworkinstanceProtocolsBgpObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceAttachedBit)
+	out := new(NetworkinstanceProtocolsBgpObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceAuthentication) {
+func (in *NetworkinstanceProtocolsBgpParameters) DeepCopyInto(out *NetworkinstanceProtocolsBgpParameters) {
 	*out = *in
-	if in.CsnpAuthentication != nil {
-		in, out := &in.CsnpAuthentication, &out.CsnpAuthentication
-		*out = new(bool)
-		**out = **in
-	}
-	if in.HelloAuthentication != nil {
-		in, out := &in.HelloAuthentication, &out.HelloAuthentication
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
 		*out = new(string)
 		**out = **in
 	}
-	if in.PsnpAuthentication != nil {
-		in, out := &in.PsnpAuthentication, &out.PsnpAuthentication
-		*out = new(bool)
-		**out = **in
+	if in.SrlNetworkinstanceProtocolsBgp != nil {
+		in, out := &in.SrlNetworkinstanceProtocolsBgp, &out.SrlNetworkinstanceProtocolsBgp
+		*out = new(NetworkinstanceProtocolsBgp)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceAuthentication.
-func (in *NetworkinstanceProtocolsIsisInstanceAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpParameters.
+func (in *NetworkinstanceProtocolsBgpParameters) DeepCopy() *NetworkinstanceProtocolsBgpParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceAuthentication)
+	out := new(NetworkinstanceProtocolsBgpParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceAutoCost) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceAutoCost) {
+func (in *NetworkinstanceProtocolsBgpPreference) DeepCopyInto(out *NetworkinstanceProtocolsBgpPreference) {
 	*out = *in
-	if in.ReferenceBandwidth != nil {
-		in, out := &in.ReferenceBandwidth, &out.ReferenceBandwidth
-		*out = new(uint64)
+	if in.Ebgp != nil {
+		in, out := &in.Ebgp, &out.Ebgp
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Ibgp != nil {
+		in, out := &in.Ibgp, &out.Ibgp
+		*out = new(uint8)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceAutoCost.
-func (in *NetworkinstanceProtocolsIsisInstanceAutoCost) DeepCopy() *NetworkinstanceProtocolsIsisInstanceAutoCost {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpPreference.
+func (in *NetworkinstanceProtocolsBgpPreference) DeepCopy() *NetworkinstanceProtocolsBgpPreference {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceAutoCost)
+	out := new(NetworkinstanceProtocolsBgpPreference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceGracefulRestart) {
+func (in *NetworkinstanceProtocolsBgpRouteAdvertisement) DeepCopyInto(out *NetworkinstanceProtocolsBgpRouteAdvertisement) {
 	*out = *in
-	if in.HelperMode != nil {
-		in, out := &in.HelperMode, &out.HelperMode
+	if in.RapidWithdrawal != nil {
+		in, out := &in.RapidWithdrawal, &out.RapidWithdrawal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WaitForFibInstall != nil {
+		in, out := &in.WaitForFibInstall, &out.WaitForFibInstall
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceGracefulRestart.
-func (in *NetworkinstanceProtocolsIsisInstanceGracefulRestart) DeepCopy() *NetworkinstanceProtocolsIsisInstanceGracefulRestart {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpRouteAdvertisement.
+func (in *NetworkinstanceProtocolsBgpRouteAdvertisement) DeepCopy() *NetworkinstanceProtocolsBgpRouteAdvertisement {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceGracefulRestart)
+	out := new(NetworkinstanceProtocolsBgpRouteAdvertisement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies) {
+func (in *NetworkinstanceProtocolsBgpRouteReflector) DeepCopyInto(out *NetworkinstanceProtocolsBgpRouteReflector) {
 	*out = *in
-	if in.Level1ToLevel2 != nil {
-		in, out := &in.Level1ToLevel2, &out.Level1ToLevel2
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2)
-		(*in).DeepCopyInto(*out)
+	if in.Client != nil {
+		in, out := &in.Client, &out.Client
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterId != nil {
+		in, out := &in.ClusterId, &out.ClusterId
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies.
-func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpRouteReflector.
+func (in *NetworkinstanceProtocolsBgpRouteReflector) DeepCopy() *NetworkinstanceProtocolsBgpRouteReflector {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies)
+	out := new(NetworkinstanceProtocolsBgpRouteReflector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2) {
+func (in *NetworkinstanceProtocolsBgpSendCommunity) DeepCopyInto(out *NetworkinstanceProtocolsBgpSendCommunity) {
 	*out = *in
-	if in.SummaryAddress != nil {
-		in, out := &in.SummaryAddress, &out.SummaryAddress
-		*out = make([]*NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Large != nil {
+		in, out := &in.Large, &out.Large
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Standard != nil {
+		in, out := &in.Standard, &out.Standard
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2.
-func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpSendCommunity.
+func (in *NetworkinstanceProtocolsBgpSendCommunity) DeepCopy() *NetworkinstanceProtocolsBgpSendCommunity {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2)
+	out := new(NetworkinstanceProtocolsBgpSendCommunity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress) {
+func (in *NetworkinstanceProtocolsBgpSpec) DeepCopyInto(out *NetworkinstanceProtocolsBgpSpec) {
 	*out = *in
-	if in.IpPrefix != nil {
-		in, out := &in.IpPrefix, &out.IpPrefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.RouteTag != nil {
-		in, out := &in.RouteTag, &out.RouteTag
-		*out = new(uint32)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress.
-func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpSpec.
+func (in *NetworkinstanceProtocolsBgpSpec) DeepCopy() *NetworkinstanceProtocolsBgpSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress)
+	out := new(NetworkinstanceProtocolsBgpSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterface) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterface) {
+func (in *NetworkinstanceProtocolsBgpStatus) DeepCopyInto(out *NetworkinstanceProtocolsBgpStatus) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CircuitType != nil {
-		in, out := &in.CircuitType, &out.CircuitType
-		*out = new(string)
-		**out = **in
-	}
-	if in.HelloPadding != nil {
-		in, out := &in.HelloPadding, &out.HelloPadding
-		*out = new(string)
-		**out = **in
-	}
-	if in.InterfaceName != nil {
-		in, out := &in.InterfaceName, &out.InterfaceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ipv4Unicast != nil {
-		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ipv6Unicast != nil {
-		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LdpSynchronization != nil {
-		in, out := &in.LdpSynchronization, &out.LdpSynchronization
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization)
-		(*in).DeepCopyInto(*out)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpStatus.
+func (in *NetworkinstanceProtocolsBgpStatus) DeepCopy() *NetworkinstanceProtocolsBgpStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = make([]*NetworkinstanceProtocolsIsisInstanceInterfaceLevel, len(*in))
+	out := new(NetworkinstanceProtocolsBgpStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsBgpTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsBgpTraceOptions) {
+	*out = *in
+	if in.Flag != nil {
+		in, out := &in.Flag, &out.Flag
+		*out = make([]*NetworkinstanceProtocolsBgpTraceOptionsFlag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLevel)
+				*out = new(NetworkinstanceProtocolsBgpTraceOptionsFlag)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Passive != nil {
-		in, out := &in.Passive, &out.Passive
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Timers != nil {
-		in, out := &in.Timers, &out.Timers
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceTimers)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterface.
-func (in *NetworkinstanceProtocolsIsisInstanceInterface) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpTraceOptions.
+func (in *NetworkinstanceProtocolsBgpTraceOptions) DeepCopy() *NetworkinstanceProtocolsBgpTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterface)
+	out := new(NetworkinstanceProtocolsBgpTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication) {
+func (in *NetworkinstanceProtocolsBgpTraceOptionsFlag) DeepCopyInto(out *NetworkinstanceProtocolsBgpTraceOptionsFlag) {
 	*out = *in
-	if in.HelloAuthentication != nil {
-		in, out := &in.HelloAuthentication, &out.HelloAuthentication
-		*out = new(bool)
+	if in.Modifier != nil {
+		in, out := &in.Modifier, &out.Modifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpTraceOptionsFlag.
+func (in *NetworkinstanceProtocolsBgpTraceOptionsFlag) DeepCopy() *NetworkinstanceProtocolsBgpTraceOptionsFlag {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication)
+	out := new(NetworkinstanceProtocolsBgpTraceOptionsFlag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast) {
+func (in *NetworkinstanceProtocolsBgpTransport) DeepCopyInto(out *NetworkinstanceProtocolsBgpTransport) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.EnableBfd != nil {
-		in, out := &in.EnableBfd, &out.EnableBfd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IncludeBfdTlv != nil {
-		in, out := &in.IncludeBfdTlv, &out.IncludeBfdTlv
-		*out = new(bool)
+	if in.TcpMss != nil {
+		in, out := &in.TcpMss, &out.TcpMss
+		*out = new(uint16)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpTransport.
+func (in *NetworkinstanceProtocolsBgpTransport) DeepCopy() *NetworkinstanceProtocolsBgpTransport {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast)
+	out := new(NetworkinstanceProtocolsBgpTransport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast) {
+func (in *NetworkinstanceProtocolsBgpevpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpn) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.EnableBfd != nil {
-		in, out := &in.EnableBfd, &out.EnableBfd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IncludeBfdTlv != nil {
-		in, out := &in.IncludeBfdTlv, &out.IncludeBfdTlv
-		*out = new(bool)
-		**out = **in
+	if in.BgpInstance != nil {
+		in, out := &in.BgpInstance, &out.BgpInstance
+		*out = make([]*NetworkinstanceProtocolsBgpevpnBgpInstance, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsBgpevpnBgpInstance)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpn.
+func (in *NetworkinstanceProtocolsBgpevpn) DeepCopy() *NetworkinstanceProtocolsBgpevpn {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast)
+	out := new(NetworkinstanceProtocolsBgpevpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstance) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstance) {
 	*out = *in
-	if in.Disable != nil {
-		in, out := &in.Disable, &out.Disable
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.EndOfLib != nil {
-		in, out := &in.EndOfLib, &out.EndOfLib
-		*out = new(bool)
+	if in.DefaultAdminTag != nil {
+		in, out := &in.DefaultAdminTag, &out.DefaultAdminTag
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.HoldDownTimer != nil {
-		in, out := &in.HoldDownTimer, &out.HoldDownTimer
-		*out = new(uint16)
+	if in.Ecmp != nil {
+		in, out := &in.Ecmp, &out.Ecmp
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.EncapsulationType != nil {
+		in, out := &in.EncapsulationType, &out.EncapsulationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Evi != nil {
+		in, out := &in.Evi, &out.Evi
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VxlanInterface != nil {
+		in, out := &in.VxlanInterface, &out.VxlanInterface
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstance.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstance) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevel) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLevel) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) {
 	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication)
+	if in.BridgeTable != nil {
+		in, out := &in.BridgeTable, &out.BridgeTable
+		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Disable != nil {
-		in, out := &in.Disable, &out.Disable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ipv6UnicastMetric != nil {
-		in, out := &in.Ipv6UnicastMetric, &out.Ipv6UnicastMetric
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.LevelNumber != nil {
-		in, out := &in.LevelNumber, &out.LevelNumber
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Metric != nil {
-		in, out := &in.Metric, &out.Metric
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Timers != nil {
-		in, out := &in.Timers, &out.Timers
-		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers)
+	if in.RouteTable != nil {
+		in, out := &in.RouteTable, &out.RouteTable
+		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLevel.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevel) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLevel)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable) {
 	*out = *in
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
+	if in.InclusiveMcast != nil {
+		in, out := &in.InclusiveMcast, &out.InclusiveMcast
+		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MacIp != nil {
+		in, out := &in.MacIp, &out.MacIp
+		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextHop != nil {
+		in, out := &in.NextHop, &out.NextHop
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast) {
 	*out = *in
-	if in.HelloInterval != nil {
-		in, out := &in.HelloInterval, &out.HelloInterval
-		*out = new(uint32)
+	if in.Advertise != nil {
+		in, out := &in.Advertise, &out.Advertise
+		*out = new(bool)
 		**out = **in
 	}
-	if in.HelloMultiplier != nil {
-		in, out := &in.HelloMultiplier, &out.HelloMultiplier
-		*out = new(uint8)
+	if in.OriginatingIp != nil {
+		in, out := &in.OriginatingIp, &out.OriginatingIp
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableInclusiveMcast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTimers) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceTimers) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp) {
 	*out = *in
-	if in.CsnpInterval != nil {
-		in, out := &in.CsnpInterval, &out.CsnpInterval
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.LspPacingInterval != nil {
-		in, out := &in.LspPacingInterval, &out.LspPacingInterval
-		*out = new(uint64)
+	if in.Advertise != nil {
+		in, out := &in.Advertise, &out.Advertise
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceTimers.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTimers) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceTimers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceTimers)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesBridgeTableMacIp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable) {
 	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(string)
-		**out = **in
+	if in.MacIp != nil {
+		in, out := &in.MacIp, &out.MacIp
+		*out = new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions.
-func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceIpv4Unicast) {
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
+	if in.AdvertiseGatewayMac != nil {
+		in, out := &in.AdvertiseGatewayMac, &out.AdvertiseGatewayMac
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceIpv4Unicast.
-func (in *NetworkinstanceProtocolsIsisInstanceIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceIpv4Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp.
+func (in *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp) DeepCopy() *NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceIpv4Unicast)
+	out := new(NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceIpv6Unicast) {
+func (in *NetworkinstanceProtocolsBgpevpnObservation) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnObservation) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.MultiTopology != nil {
-		in, out := &in.MultiTopology, &out.MultiTopology
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceIpv6Unicast.
-func (in *NetworkinstanceProtocolsIsisInstanceIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceIpv6Unicast {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnObservation.
+func (in *NetworkinstanceProtocolsBgpevpnObservation) DeepCopy() *NetworkinstanceProtocolsBgpevpnObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceIpv6Unicast)
+	out := new(NetworkinstanceProtocolsBgpevpnObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceLdpSynchronization) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLdpSynchronization) {
+func (in *NetworkinstanceProtocolsBgpevpnParameters) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnParameters) {
 	*out = *in
-	if in.EndOfLib != nil {
-		in, out := &in.EndOfLib, &out.EndOfLib
-		*out = new(bool)
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
 		**out = **in
 	}
-	if in.HoldDownTimer != nil {
-		in, out := &in.HoldDownTimer, &out.HoldDownTimer
-		*out = new(uint16)
-		**out = **in
+	if in.SrlNetworkinstanceProtocolsBgpevpn != nil {
+		in, out := &in.SrlNetworkinstanceProtocolsBgpevpn, &out.SrlNetworkinstanceProtocolsBgpevpn
+		*out = new(NetworkinstanceProtocolsBgpevpn)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLdpSynchronization.
-func (in *NetworkinstanceProtocolsIsisInstanceLdpSynchronization) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLdpSynchronization {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnParameters.
+func (in *NetworkinstanceProtocolsBgpevpnParameters) DeepCopy() *NetworkinstanceProtocolsBgpevpnParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceLdpSynchronization)
+	out := new(NetworkinstanceProtocolsBgpevpnParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceLevel) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevel) {
+func (in *NetworkinstanceProtocolsBgpevpnSpec) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnSpec) {
 	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsIsisInstanceLevelAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BgpLsExclude != nil {
-		in, out := &in.BgpLsExclude, &out.BgpLsExclude
-		*out = new(bool)
-		**out = **in
-	}
-	if in.LevelNumber != nil {
-		in, out := &in.LevelNumber, &out.LevelNumber
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.MetricStyle != nil {
-		in, out := &in.MetricStyle, &out.MetricStyle
-		*out = new(string)
-		**out = **in
-	}
-	if in.RoutePreference != nil {
-		in, out := &in.RoutePreference, &out.RoutePreference
-		*out = new(NetworkinstanceProtocolsIsisInstanceLevelRoutePreference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsIsisInstanceLevelTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevel.
-func (in *NetworkinstanceProtocolsIsisInstanceLevel) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnSpec.
+func (in *NetworkinstanceProtocolsBgpevpnSpec) DeepCopy() *NetworkinstanceProtocolsBgpevpnSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceLevel)
+	out := new(NetworkinstanceProtocolsBgpevpnSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceLevelAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevelAuthentication) {
+func (in *NetworkinstanceProtocolsBgpevpnStatus) DeepCopyInto(out *NetworkinstanceProtocolsBgpevpnStatus) {
 	*out = *in
-	if in.CsnpAuthentication != nil {
-		in, out := &in.CsnpAuthentication, &out.CsnpAuthentication
-		*out = new(bool)
-		**out = **in
-	}
-	if in.HelloAuthentication != nil {
-		in, out := &in.HelloAuthentication, &out.HelloAuthentication
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
-		*out = new(string)
-		**out = **in
-	}
-	if in.PsnpAuthentication != nil {
-		in, out := &in.PsnpAuthentication, &out.PsnpAuthentication
-		*out = new(bool)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevelAuthentication.
-func (in *NetworkinstanceProtocolsIsisInstanceLevelAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevelAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpevpnStatus.
+func (in *NetworkinstanceProtocolsBgpevpnStatus) DeepCopy() *NetworkinstanceProtocolsBgpevpnStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceLevelAuthentication)
+	out := new(NetworkinstanceProtocolsBgpevpnStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference) {
+func (in *NetworkinstanceProtocolsBgpvpn) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpn) {
 	*out = *in
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Internal != nil {
-		in, out := &in.Internal, &out.Internal
-		*out = new(uint8)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevelRoutePreference.
-func (in *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkinstanceProtocolsIsisInstanceLevelRoutePreference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions) {
-	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(string)
-		**out = **in
+	if in.BgpInstance != nil {
+		in, out := &in.BgpInstance, &out.BgpInstance
+		*out = make([]*NetworkinstanceProtocolsBgpvpnBgpInstance, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsBgpvpnBgpInstance)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevelTraceOptions.
-func (in *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpn.
+func (in *NetworkinstanceProtocolsBgpvpn) DeepCopy() *NetworkinstanceProtocolsBgpvpn {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceLevelTraceOptions)
+	out := new(NetworkinstanceProtocolsBgpvpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceOverload) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceOverload) {
+func (in *NetworkinstanceProtocolsBgpvpnBgpInstance) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnBgpInstance) {
 	*out = *in
-	if in.AdvertiseExternal != nil {
-		in, out := &in.AdvertiseExternal, &out.AdvertiseExternal
-		*out = new(bool)
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
+		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertiseInterlevel != nil {
-		in, out := &in.AdvertiseInterlevel, &out.AdvertiseInterlevel
-		*out = new(bool)
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.Immediate != nil {
-		in, out := &in.Immediate, &out.Immediate
-		*out = new(NetworkinstanceProtocolsIsisInstanceOverloadImmediate)
+	if in.ImportPolicy != nil {
+		in, out := &in.ImportPolicy, &out.ImportPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteDistinguisher != nil {
+		in, out := &in.RouteDistinguisher, &out.RouteDistinguisher
+		*out = new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OnBoot != nil {
-		in, out := &in.OnBoot, &out.OnBoot
-		*out = new(NetworkinstanceProtocolsIsisInstanceOverloadOnBoot)
+	if in.RouteTarget != nil {
+		in, out := &in.RouteTarget, &out.RouteTarget
+		*out = new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceOverload.
-func (in *NetworkinstanceProtocolsIsisInstanceOverload) DeepCopy() *NetworkinstanceProtocolsIsisInstanceOverload {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnBgpInstance.
+func (in *NetworkinstanceProtocolsBgpvpnBgpInstance) DeepCopy() *NetworkinstanceProtocolsBgpvpnBgpInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceOverload)
+	out := new(NetworkinstanceProtocolsBgpvpnBgpInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceOverloadImmediate) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceOverloadImmediate) {
+func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher) {
 	*out = *in
-	if in.MaxMetric != nil {
-		in, out := &in.MaxMetric, &out.MaxMetric
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SetBit != nil {
-		in, out := &in.SetBit, &out.SetBit
-		*out = new(bool)
+	if in.Rd != nil {
+		in, out := &in.Rd, &out.Rd
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceOverloadImmediate.
-func (in *NetworkinstanceProtocolsIsisInstanceOverloadImmediate) DeepCopy() *NetworkinstanceProtocolsIsisInstanceOverloadImmediate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher.
+func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher) DeepCopy() *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceOverloadImmediate)
+	out := new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteDistinguisher)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot) {
+func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget) {
 	*out = *in
-	if in.MaxMetric != nil {
-		in, out := &in.MaxMetric, &out.MaxMetric
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SetBit != nil {
-		in, out := &in.SetBit, &out.SetBit
-		*out = new(bool)
+	if in.ExportRt != nil {
+		in, out := &in.ExportRt, &out.ExportRt
+		*out = new(string)
 		**out = **in
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(uint16)
+	if in.ImportRt != nil {
+		in, out := &in.ImportRt, &out.ImportRt
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceOverloadOnBoot.
-func (in *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot) DeepCopy() *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget.
+func (in *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget) DeepCopy() *NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceOverloadOnBoot)
+	out := new(NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall) {
+func (in *NetworkinstanceProtocolsBgpvpnObservation) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnObservation) {
 	*out = *in
-	if in.BgpLs != nil {
-		in, out := &in.BgpLs, &out.BgpLs
-		*out = new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall.
-func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnObservation.
+func (in *NetworkinstanceProtocolsBgpvpnObservation) DeepCopy() *NetworkinstanceProtocolsBgpvpnObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall)
+	out := new(NetworkinstanceProtocolsBgpvpnObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs) {
+func (in *NetworkinstanceProtocolsBgpvpnParameters) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnParameters) {
 	*out = *in
-	if in.BgpLsIdentifier != nil {
-		in, out := &in.BgpLsIdentifier, &out.BgpLsIdentifier
-		*out = new(uint32)
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
 		**out = **in
 	}
-	if in.IgpIdentifier != nil {
-		in, out := &in.IgpIdentifier, &out.IgpIdentifier
-		*out = new(uint64)
-		**out = **in
+	if in.SrlNetworkinstanceProtocolsBgpvpn != nil {
+		in, out := &in.SrlNetworkinstanceProtocolsBgpvpn, &out.SrlNetworkinstanceProtocolsBgpvpn
+		*out = new(NetworkinstanceProtocolsBgpvpn)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs.
-func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnParameters.
+func (in *NetworkinstanceProtocolsBgpvpnParameters) DeepCopy() *NetworkinstanceProtocolsBgpvpnParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs)
+	out := new(NetworkinstanceProtocolsBgpvpnParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTimers) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimers) {
+func (in *NetworkinstanceProtocolsBgpvpnSpec) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnSpec) {
 	*out = *in
-	if in.LspGeneration != nil {
-		in, out := &in.LspGeneration, &out.LspGeneration
-		*out = new(NetworkinstanceProtocolsIsisInstanceTimersLspGeneration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LspLifetime != nil {
-		in, out := &in.LspLifetime, &out.LspLifetime
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.LspRefresh != nil {
-		in, out := &in.LspRefresh, &out.LspRefresh
-		*out = new(NetworkinstanceProtocolsIsisInstanceTimersLspRefresh)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Spf != nil {
-		in, out := &in.Spf, &out.Spf
-		*out = new(NetworkinstanceProtocolsIsisInstanceTimersSpf)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimers.
-func (in *NetworkinstanceProtocolsIsisInstanceTimers) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnSpec.
+func (in *NetworkinstanceProtocolsBgpvpnSpec) DeepCopy() *NetworkinstanceProtocolsBgpvpnSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTimers)
+	out := new(NetworkinstanceProtocolsBgpvpnSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration) {
+func (in *NetworkinstanceProtocolsBgpvpnStatus) DeepCopyInto(out *NetworkinstanceProtocolsBgpvpnStatus) {
 	*out = *in
-	if in.InitialWait != nil {
-		in, out := &in.InitialWait, &out.InitialWait
-		*out = new(uint64)
-		**out = **in
-	}
-	if in.MaxWait != nil {
-		in, out := &in.MaxWait, &out.MaxWait
-		*out = new(uint64)
-		**out = **in
-	}
-	if in.SecondWait != nil {
-		in, out := &in.SecondWait, &out.SecondWait
-		*out = new(uint64)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimersLspGeneration.
-func (in *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsBgpvpnStatus.
+func (in *NetworkinstanceProtocolsBgpvpnStatus) DeepCopy() *NetworkinstanceProtocolsBgpvpnStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTimersLspGeneration)
+	out := new(NetworkinstanceProtocolsBgpvpnStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh) {
+func (in *NetworkinstanceProtocolsIsis) DeepCopyInto(out *NetworkinstanceProtocolsIsis) {
 	*out = *in
-	if in.HalfLifetime != nil {
-		in, out := &in.HalfLifetime, &out.HalfLifetime
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(uint16)
-		**out = **in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = make([]*NetworkinstanceProtocolsIsisInstance, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsIsisInstance)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimersLspRefresh.
-func (in *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsis.
+func (in *NetworkinstanceProtocolsIsis) DeepCopy() *NetworkinstanceProtocolsIsis {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTimersLspRefresh)
+	out := new(NetworkinstanceProtocolsIsis)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTimersSpf) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimersSpf) {
+func (in *NetworkinstanceProtocolsIsisInstance) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstance) {
 	*out = *in
-	if in.InitialWait != nil {
-		in, out := &in.InitialWait, &out.InitialWait
-		*out = new(uint64)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxWait != nil {
-		in, out := &in.MaxWait, &out.MaxWait
-		*out = new(uint64)
-		**out = **in
+	if in.AttachedBit != nil {
+		in, out := &in.AttachedBit, &out.AttachedBit
+		*out = new(NetworkinstanceProtocolsIsisInstanceAttachedBit)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SecondWait != nil {
-		in, out := &in.SecondWait, &out.SecondWait
-		*out = new(uint64)
-		**out = **in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsIsisInstanceAuthentication)
+		(*in).DeepCopyInto(*out)
 	}
-}
+	if in.AutoCost != nil {
+		in, out := &in.AutoCost, &out.AutoCost
+		*out = new(NetworkinstanceProtocolsIsisInstanceAutoCost)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.GracefulRestart != nil {
+		in, out := &in.GracefulRestart, &out.GracefulRestart
+		*out = new(NetworkinstanceProtocolsIsisInstanceGracefulRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterLevelPropagationPolicies != nil {
+		in, out := &in.InterLevelPropagationPolicies, &out.InterLevelPropagationPolicies
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = make([]*NetworkinstanceProtocolsIsisInstanceInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsIsisInstanceInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Ipv4Unicast != nil {
+		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
+		*out = new(NetworkinstanceProtocolsIsisInstanceIpv4Unicast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ipv6Unicast != nil {
+		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
+		*out = new(NetworkinstanceProtocolsIsisInstanceIpv6Unicast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LdpSynchronization != nil {
+		in, out := &in.LdpSynchronization, &out.LdpSynchronization
+		*out = new(NetworkinstanceProtocolsIsisInstanceLdpSynchronization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = make([]*NetworkinstanceProtocolsIsisInstanceLevel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsIsisInstanceLevel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LevelCapability != nil {
+		in, out := &in.LevelCapability, &out.LevelCapability
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxEcmpPaths != nil {
+		in, out := &in.MaxEcmpPaths, &out.MaxEcmpPaths
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Net != nil {
+		in, out := &in.Net, &out.Net
+		*out = new(string)
+		**out = **in
+	}
+	if in.Overload != nil {
+		in, out := &in.Overload, &out.Overload
+		*out = new(NetworkinstanceProtocolsIsisInstanceOverload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PoiTlv != nil {
+		in, out := &in.PoiTlv, &out.PoiTlv
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TeDatabaseInstall != nil {
+		in, out := &in.TeDatabaseInstall, &out.TeDatabaseInstall
+		*out = new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timers != nil {
+		in, out := &in.Timers, &out.Timers
+		*out = new(NetworkinstanceProtocolsIsisInstanceTimers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(NetworkinstanceProtocolsIsisInstanceTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficEngineering != nil {
+		in, out := &in.TrafficEngineering, &out.TrafficEngineering
+		*out = new(NetworkinstanceProtocolsIsisInstanceTrafficEngineering)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transport != nil {
+		in, out := &in.Transport, &out.Transport
+		*out = new(NetworkinstanceProtocolsIsisInstanceTransport)
+		(*in).DeepCopyInto(*out)
+	}
+}
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimersSpf.
-func (in *NetworkinstanceProtocolsIsisInstanceTimersSpf) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimersSpf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstance.
+func (in *NetworkinstanceProtocolsIsisInstance) DeepCopy() *NetworkinstanceProtocolsIsisInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTimersSpf)
+	out := new(NetworkinstanceProtocolsIsisInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTraceOptions) {
+func (in *NetworkinstanceProtocolsIsisInstanceAttachedBit) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceAttachedBit) {
 	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(string)
+	if in.Ignore != nil {
+		in, out := &in.Ignore, &out.Ignore
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Suppress != nil {
+		in, out := &in.Suppress, &out.Suppress
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTraceOptions.
-func (in *NetworkinstanceProtocolsIsisInstanceTraceOptions) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceAttachedBit.
+func (in *NetworkinstanceProtocolsIsisInstanceAttachedBit) DeepCopy() *NetworkinstanceProtocolsIsisInstanceAttachedBit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTraceOptions)
+	out := new(NetworkinstanceProtocolsIsisInstanceAttachedBit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTrafficEngineering) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTrafficEngineering) {
+func (in *NetworkinstanceProtocolsIsisInstanceAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceAuthentication) {
 	*out = *in
-	if in.Advertisement != nil {
-		in, out := &in.Advertisement, &out.Advertisement
+	if in.CsnpAuthentication != nil {
+		in, out := &in.CsnpAuthentication, &out.CsnpAuthentication
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LegacyLinkAttributeAdvertisement != nil {
-		in, out := &in.LegacyLinkAttributeAdvertisement, &out.LegacyLinkAttributeAdvertisement
+	if in.HelloAuthentication != nil {
+		in, out := &in.HelloAuthentication, &out.HelloAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
+		*out = new(string)
+		**out = **in
+	}
+	if in.PsnpAuthentication != nil {
+		in, out := &in.PsnpAuthentication, &out.PsnpAuthentication
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTrafficEngineering.
-func (in *NetworkinstanceProtocolsIsisInstanceTrafficEngineering) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTrafficEngineering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceAuthentication.
+func (in *NetworkinstanceProtocolsIsisInstanceAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTrafficEngineering)
+	out := new(NetworkinstanceProtocolsIsisInstanceAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisInstanceTransport) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTransport) {
+func (in *NetworkinstanceProtocolsIsisInstanceAutoCost) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceAutoCost) {
 	*out = *in
-	if in.LspMtuSize != nil {
-		in, out := &in.LspMtuSize, &out.LspMtuSize
-		*out = new(uint16)
+	if in.ReferenceBandwidth != nil {
+		in, out := &in.ReferenceBandwidth, &out.ReferenceBandwidth
+		*out = new(uint64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTransport.
-func (in *NetworkinstanceProtocolsIsisInstanceTransport) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTransport {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceAutoCost.
+func (in *NetworkinstanceProtocolsIsisInstanceAutoCost) DeepCopy() *NetworkinstanceProtocolsIsisInstanceAutoCost {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisInstanceTransport)
+	out := new(NetworkinstanceProtocolsIsisInstanceAutoCost)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisObservation) DeepCopyInto(out *NetworkinstanceProtocolsIsisObservation) {
+func (in *NetworkinstanceProtocolsIsisInstanceGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceGracefulRestart) {
 	*out = *in
+	if in.HelperMode != nil {
+		in, out := &in.HelperMode, &out.HelperMode
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisObservation.
-func (in *NetworkinstanceProtocolsIsisObservation) DeepCopy() *NetworkinstanceProtocolsIsisObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceGracefulRestart.
+func (in *NetworkinstanceProtocolsIsisInstanceGracefulRestart) DeepCopy() *NetworkinstanceProtocolsIsisInstanceGracefulRestart {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisObservation)
+	out := new(NetworkinstanceProtocolsIsisInstanceGracefulRestart)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisParameters) DeepCopyInto(out *NetworkinstanceProtocolsIsisParameters) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SrlNetworkinstanceProtocolsIsis != nil {
-		in, out := &in.SrlNetworkinstanceProtocolsIsis, &out.SrlNetworkinstanceProtocolsIsis
-		*out = new(NetworkinstanceProtocolsIsis)
+	if in.Level1ToLevel2 != nil {
+		in, out := &in.Level1ToLevel2, &out.Level1ToLevel2
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisParameters.
-func (in *NetworkinstanceProtocolsIsisParameters) DeepCopy() *NetworkinstanceProtocolsIsisParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies.
+func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisParameters)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPolicies)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisSpec) DeepCopyInto(out *NetworkinstanceProtocolsIsisSpec) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.SummaryAddress != nil {
+		in, out := &in.SummaryAddress, &out.SummaryAddress
+		*out = make([]*NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisSpec.
-func (in *NetworkinstanceProtocolsIsisSpec) DeepCopy() *NetworkinstanceProtocolsIsisSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2.
+func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2 {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisSpec)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsIsisStatus) DeepCopyInto(out *NetworkinstanceProtocolsIsisStatus) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.IpPrefix != nil {
+		in, out := &in.IpPrefix, &out.IpPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteTag != nil {
+		in, out := &in.RouteTag, &out.RouteTag
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisStatus.
-func (in *NetworkinstanceProtocolsIsisStatus) DeepCopy() *NetworkinstanceProtocolsIsisStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress.
+func (in *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsIsisStatus)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterLevelPropagationPoliciesLevel1ToLevel2SummaryAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsLinux) DeepCopyInto(out *NetworkinstanceProtocolsLinux) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterface) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterface) {
 	*out = *in
-	if in.ExportNeighbors != nil {
-		in, out := &in.ExportNeighbors, &out.ExportNeighbors
-		*out = new(bool)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.ExportRoutes != nil {
-		in, out := &in.ExportRoutes, &out.ExportRoutes
-		*out = new(bool)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitType != nil {
+		in, out := &in.CircuitType, &out.CircuitType
+		*out = new(string)
 		**out = **in
 	}
-	if in.ImportRoutes != nil {
-		in, out := &in.ImportRoutes, &out.ImportRoutes
+	if in.HelloPadding != nil {
+		in, out := &in.HelloPadding, &out.HelloPadding
+		*out = new(string)
+		**out = **in
+	}
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv4Unicast != nil {
+		in, out := &in.Ipv4Unicast, &out.Ipv4Unicast
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ipv6Unicast != nil {
+		in, out := &in.Ipv6Unicast, &out.Ipv6Unicast
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LdpSynchronization != nil {
+		in, out := &in.LdpSynchronization, &out.LdpSynchronization
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = make([]*NetworkinstanceProtocolsIsisInstanceInterfaceLevel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLevel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Passive != nil {
+		in, out := &in.Passive, &out.Passive
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Timers != nil {
+		in, out := &in.Timers, &out.Timers
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceTimers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinux.
-func (in *NetworkinstanceProtocolsLinux) DeepCopy() *NetworkinstanceProtocolsLinux {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterface.
+func (in *NetworkinstanceProtocolsIsisInstanceInterface) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsLinux)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsLinuxObservation) DeepCopyInto(out *NetworkinstanceProtocolsLinuxObservation) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication) {
 	*out = *in
+	if in.HelloAuthentication != nil {
+		in, out := &in.HelloAuthentication, &out.HelloAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxObservation.
-func (in *NetworkinstanceProtocolsLinuxObservation) DeepCopy() *NetworkinstanceProtocolsLinuxObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsLinuxObservation)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsLinuxParameters) DeepCopyInto(out *NetworkinstanceProtocolsLinuxParameters) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.SrlNetworkinstanceProtocolsLinux != nil {
-		in, out := &in.SrlNetworkinstanceProtocolsLinux, &out.SrlNetworkinstanceProtocolsLinux
-		*out = new(NetworkinstanceProtocolsLinux)
-		(*in).DeepCopyInto(*out)
+	if in.EnableBfd != nil {
+		in, out := &in.EnableBfd, &out.EnableBfd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeBfdTlv != nil {
+		in, out := &in.IncludeBfdTlv, &out.IncludeBfdTlv
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxParameters.
-func (in *NetworkinstanceProtocolsLinuxParameters) DeepCopy() *NetworkinstanceProtocolsLinuxParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsLinuxParameters)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv4Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsLinuxSpec) DeepCopyInto(out *NetworkinstanceProtocolsLinuxSpec) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableBfd != nil {
+		in, out := &in.EnableBfd, &out.EnableBfd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeBfdTlv != nil {
+		in, out := &in.IncludeBfdTlv, &out.IncludeBfdTlv
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxSpec.
-func (in *NetworkinstanceProtocolsLinuxSpec) DeepCopy() *NetworkinstanceProtocolsLinuxSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsLinuxSpec)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceIpv6Unicast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsLinuxStatus) DeepCopyInto(out *NetworkinstanceProtocolsLinuxStatus) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(string)
+		**out = **in
+	}
+	if in.EndOfLib != nil {
+		in, out := &in.EndOfLib, &out.EndOfLib
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HoldDownTimer != nil {
+		in, out := &in.HoldDownTimer, &out.HoldDownTimer
+		*out = new(uint16)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxStatus.
-func (in *NetworkinstanceProtocolsLinuxStatus) DeepCopy() *NetworkinstanceProtocolsLinuxStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsLinuxStatus)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLdpSynchronization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspf) DeepCopyInto(out *NetworkinstanceProtocolsOspf) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevel) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLevel) {
 	*out = *in
-	if in.Instance != nil {
-		in, out := &in.Instance, &out.Instance
-		*out = make([]*NetworkinstanceProtocolsOspfInstance, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsOspfInstance)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ipv6UnicastMetric != nil {
+		in, out := &in.Ipv6UnicastMetric, &out.Ipv6UnicastMetric
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.LevelNumber != nil {
+		in, out := &in.LevelNumber, &out.LevelNumber
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Timers != nil {
+		in, out := &in.Timers, &out.Timers
+		*out = new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspf.
-func (in *NetworkinstanceProtocolsOspf) DeepCopy() *NetworkinstanceProtocolsOspf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLevel.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevel) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspf)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstance) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstance) {
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication) {
 	*out = *in
-	if in.AddressFamily != nil {
-		in, out := &in.AddressFamily, &out.AddressFamily
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers) {
+	*out = *in
+	if in.HelloInterval != nil {
+		in, out := &in.HelloInterval, &out.HelloInterval
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.AdvertiseRouterCapability != nil {
-		in, out := &in.AdvertiseRouterCapability, &out.AdvertiseRouterCapability
-		*out = new(string)
+	if in.HelloMultiplier != nil {
+		in, out := &in.HelloMultiplier, &out.HelloMultiplier
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.Area != nil {
-		in, out := &in.Area, &out.Area
-		*out = make([]*NetworkinstanceProtocolsOspfInstanceArea, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsOspfInstanceArea)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers {
+	if in == nil {
+		return nil
 	}
-	if in.Asbr != nil {
-		in, out := &in.Asbr, &out.Asbr
-		*out = new(NetworkinstanceProtocolsOspfInstanceAsbr)
-		(*in).DeepCopyInto(*out)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceLevelTimers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTimers) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceTimers) {
+	*out = *in
+	if in.CsnpInterval != nil {
+		in, out := &in.CsnpInterval, &out.CsnpInterval
+		*out = new(uint16)
+		**out = **in
 	}
-	if in.ExportLimit != nil {
-		in, out := &in.ExportLimit, &out.ExportLimit
-		*out = new(NetworkinstanceProtocolsOspfInstanceExportLimit)
-		(*in).DeepCopyInto(*out)
+	if in.LspPacingInterval != nil {
+		in, out := &in.LspPacingInterval, &out.LspPacingInterval
+		*out = new(uint64)
+		**out = **in
 	}
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceTimers.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTimers) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceTimers {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceTimers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions) {
+	*out = *in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
 		*out = new(string)
 		**out = **in
 	}
-	if in.ExternalDbOverflow != nil {
-		in, out := &in.ExternalDbOverflow, &out.ExternalDbOverflow
-		*out = new(NetworkinstanceProtocolsOspfInstanceExternalDbOverflow)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions.
+func (in *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions) DeepCopy() *NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions {
+	if in == nil {
+		return nil
 	}
-	if in.ExternalPreference != nil {
-		in, out := &in.ExternalPreference, &out.ExternalPreference
-		*out = new(uint8)
+	out := new(NetworkinstanceProtocolsIsisInstanceInterfaceTraceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceIpv4Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceIpv4Unicast) {
+	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.GracefulRestart != nil {
-		in, out := &in.GracefulRestart, &out.GracefulRestart
-		*out = new(NetworkinstanceProtocolsOspfInstanceGracefulRestart)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceIpv4Unicast.
+func (in *NetworkinstanceProtocolsIsisInstanceIpv4Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceIpv4Unicast {
+	if in == nil {
+		return nil
 	}
-	if in.InstanceId != nil {
-		in, out := &in.InstanceId, &out.InstanceId
-		*out = new(uint32)
+	out := new(NetworkinstanceProtocolsIsisInstanceIpv4Unicast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceIpv6Unicast) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceIpv6Unicast) {
+	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxEcmpPaths != nil {
-		in, out := &in.MaxEcmpPaths, &out.MaxEcmpPaths
-		*out = new(uint8)
+	if in.MultiTopology != nil {
+		in, out := &in.MultiTopology, &out.MultiTopology
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceIpv6Unicast.
+func (in *NetworkinstanceProtocolsIsisInstanceIpv6Unicast) DeepCopy() *NetworkinstanceProtocolsIsisInstanceIpv6Unicast {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceIpv6Unicast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceLdpSynchronization) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLdpSynchronization) {
+	*out = *in
+	if in.EndOfLib != nil {
+		in, out := &in.EndOfLib, &out.EndOfLib
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Overload != nil {
-		in, out := &in.Overload, &out.Overload
-		*out = new(NetworkinstanceProtocolsOspfInstanceOverload)
+	if in.HoldDownTimer != nil {
+		in, out := &in.HoldDownTimer, &out.HoldDownTimer
+		*out = new(uint16)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLdpSynchronization.
+func (in *NetworkinstanceProtocolsIsisInstanceLdpSynchronization) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLdpSynchronization {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceLdpSynchronization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceLevel) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevel) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsIsisInstanceLevelAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Preference != nil {
-		in, out := &in.Preference, &out.Preference
-		*out = new(uint8)
+	if in.BgpLsExclude != nil {
+		in, out := &in.BgpLsExclude, &out.BgpLsExclude
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ReferenceBandwidth != nil {
-		in, out := &in.ReferenceBandwidth, &out.ReferenceBandwidth
-		*out = new(uint64)
+	if in.LevelNumber != nil {
+		in, out := &in.LevelNumber, &out.LevelNumber
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.RouterId != nil {
-		in, out := &in.RouterId, &out.RouterId
+	if in.MetricStyle != nil {
+		in, out := &in.MetricStyle, &out.MetricStyle
 		*out = new(string)
 		**out = **in
 	}
-	if in.TeDatabaseInstall != nil {
-		in, out := &in.TeDatabaseInstall, &out.TeDatabaseInstall
-		*out = new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Timers != nil {
-		in, out := &in.Timers, &out.Timers
-		*out = new(NetworkinstanceProtocolsOspfInstanceTimers)
+	if in.RoutePreference != nil {
+		in, out := &in.RoutePreference, &out.RoutePreference
+		*out = new(NetworkinstanceProtocolsIsisInstanceLevelRoutePreference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TraceOptions != nil {
 		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptions)
+		*out = new(NetworkinstanceProtocolsIsisInstanceLevelTraceOptions)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TrafficEngineering != nil {
-		in, out := &in.TrafficEngineering, &out.TrafficEngineering
-		*out = new(NetworkinstanceProtocolsOspfInstanceTrafficEngineering)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevel.
+func (in *NetworkinstanceProtocolsIsisInstanceLevel) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevel {
+	if in == nil {
+		return nil
 	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
+	out := new(NetworkinstanceProtocolsIsisInstanceLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceLevelAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevelAuthentication) {
+	*out = *in
+	if in.CsnpAuthentication != nil {
+		in, out := &in.CsnpAuthentication, &out.CsnpAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HelloAuthentication != nil {
+		in, out := &in.HelloAuthentication, &out.HelloAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
 		*out = new(string)
 		**out = **in
 	}
+	if in.PsnpAuthentication != nil {
+		in, out := &in.PsnpAuthentication, &out.PsnpAuthentication
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstance.
-func (in *NetworkinstanceProtocolsOspfInstance) DeepCopy() *NetworkinstanceProtocolsOspfInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevelAuthentication.
+func (in *NetworkinstanceProtocolsIsisInstanceLevelAuthentication) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevelAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstance)
+	out := new(NetworkinstanceProtocolsIsisInstanceLevelAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceArea) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceArea) {
+func (in *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference) {
 	*out = *in
-	if in.AdvertiseRouterCapability != nil {
-		in, out := &in.AdvertiseRouterCapability, &out.AdvertiseRouterCapability
-		*out = new(bool)
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.AreaId != nil {
-		in, out := &in.AreaId, &out.AreaId
-		*out = new(string)
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.AreaRange != nil {
-		in, out := &in.AreaRange, &out.AreaRange
-		*out = make([]*NetworkinstanceProtocolsOspfInstanceAreaAreaRange, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsOspfInstanceAreaAreaRange)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevelRoutePreference.
+func (in *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevelRoutePreference {
+	if in == nil {
+		return nil
 	}
-	if in.BgpLsExclude != nil {
-		in, out := &in.BgpLsExclude, &out.BgpLsExclude
+	out := new(NetworkinstanceProtocolsIsisInstanceLevelRoutePreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions) {
+	*out = *in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceLevelTraceOptions.
+func (in *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions) DeepCopy() *NetworkinstanceProtocolsIsisInstanceLevelTraceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceLevelTraceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceOverload) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceOverload) {
+	*out = *in
+	if in.AdvertiseExternal != nil {
+		in, out := &in.AdvertiseExternal, &out.AdvertiseExternal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdvertiseInterlevel != nil {
+		in, out := &in.AdvertiseInterlevel, &out.AdvertiseInterlevel
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Immediate != nil {
+		in, out := &in.Immediate, &out.Immediate
+		*out = new(NetworkinstanceProtocolsIsisInstanceOverloadImmediate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnBoot != nil {
+		in, out := &in.OnBoot, &out.OnBoot
+		*out = new(NetworkinstanceProtocolsIsisInstanceOverloadOnBoot)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceOverload.
+func (in *NetworkinstanceProtocolsIsisInstanceOverload) DeepCopy() *NetworkinstanceProtocolsIsisInstanceOverload {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceOverload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceOverloadImmediate) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceOverloadImmediate) {
+	*out = *in
+	if in.MaxMetric != nil {
+		in, out := &in.MaxMetric, &out.MaxMetric
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SetBit != nil {
+		in, out := &in.SetBit, &out.SetBit
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceOverloadImmediate.
+func (in *NetworkinstanceProtocolsIsisInstanceOverloadImmediate) DeepCopy() *NetworkinstanceProtocolsIsisInstanceOverloadImmediate {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceOverloadImmediate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot) {
+	*out = *in
+	if in.MaxMetric != nil {
+		in, out := &in.MaxMetric, &out.MaxMetric
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SetBit != nil {
+		in, out := &in.SetBit, &out.SetBit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint16)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceOverloadOnBoot.
+func (in *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot) DeepCopy() *NetworkinstanceProtocolsIsisInstanceOverloadOnBoot {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceOverloadOnBoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall) {
+	*out = *in
+	if in.BgpLs != nil {
+		in, out := &in.BgpLs, &out.BgpLs
+		*out = new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall.
+func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs) {
+	*out = *in
+	if in.BgpLsIdentifier != nil {
+		in, out := &in.BgpLsIdentifier, &out.BgpLsIdentifier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IgpIdentifier != nil {
+		in, out := &in.IgpIdentifier, &out.IgpIdentifier
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs.
+func (in *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTeDatabaseInstallBgpLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTimers) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimers) {
+	*out = *in
+	if in.LspGeneration != nil {
+		in, out := &in.LspGeneration, &out.LspGeneration
+		*out = new(NetworkinstanceProtocolsIsisInstanceTimersLspGeneration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LspLifetime != nil {
+		in, out := &in.LspLifetime, &out.LspLifetime
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.LspRefresh != nil {
+		in, out := &in.LspRefresh, &out.LspRefresh
+		*out = new(NetworkinstanceProtocolsIsisInstanceTimersLspRefresh)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spf != nil {
+		in, out := &in.Spf, &out.Spf
+		*out = new(NetworkinstanceProtocolsIsisInstanceTimersSpf)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimers.
+func (in *NetworkinstanceProtocolsIsisInstanceTimers) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimers {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTimers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration) {
+	*out = *in
+	if in.InitialWait != nil {
+		in, out := &in.InitialWait, &out.InitialWait
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.SecondWait != nil {
+		in, out := &in.SecondWait, &out.SecondWait
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimersLspGeneration.
+func (in *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimersLspGeneration {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTimersLspGeneration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh) {
+	*out = *in
+	if in.HalfLifetime != nil {
+		in, out := &in.HalfLifetime, &out.HalfLifetime
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(uint16)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimersLspRefresh.
+func (in *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimersLspRefresh {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTimersLspRefresh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTimersSpf) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTimersSpf) {
+	*out = *in
+	if in.InitialWait != nil {
+		in, out := &in.InitialWait, &out.InitialWait
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.SecondWait != nil {
+		in, out := &in.SecondWait, &out.SecondWait
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTimersSpf.
+func (in *NetworkinstanceProtocolsIsisInstanceTimersSpf) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTimersSpf {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTimersSpf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTraceOptions) {
+	*out = *in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTraceOptions.
+func (in *NetworkinstanceProtocolsIsisInstanceTraceOptions) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTraceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTraceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTrafficEngineering) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTrafficEngineering) {
+	*out = *in
+	if in.Advertisement != nil {
+		in, out := &in.Advertisement, &out.Advertisement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LegacyLinkAttributeAdvertisement != nil {
+		in, out := &in.LegacyLinkAttributeAdvertisement, &out.LegacyLinkAttributeAdvertisement
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTrafficEngineering.
+func (in *NetworkinstanceProtocolsIsisInstanceTrafficEngineering) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTrafficEngineering {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTrafficEngineering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisInstanceTransport) DeepCopyInto(out *NetworkinstanceProtocolsIsisInstanceTransport) {
+	*out = *in
+	if in.LspMtuSize != nil {
+		in, out := &in.LspMtuSize, &out.LspMtuSize
+		*out = new(uint16)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisInstanceTransport.
+func (in *NetworkinstanceProtocolsIsisInstanceTransport) DeepCopy() *NetworkinstanceProtocolsIsisInstanceTransport {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisInstanceTransport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisObservation) DeepCopyInto(out *NetworkinstanceProtocolsIsisObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisObservation.
+func (in *NetworkinstanceProtocolsIsisObservation) DeepCopy() *NetworkinstanceProtocolsIsisObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisParameters) DeepCopyInto(out *NetworkinstanceProtocolsIsisParameters) {
+	*out = *in
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SrlNetworkinstanceProtocolsIsis != nil {
+		in, out := &in.SrlNetworkinstanceProtocolsIsis, &out.SrlNetworkinstanceProtocolsIsis
+		*out = new(NetworkinstanceProtocolsIsis)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisParameters.
+func (in *NetworkinstanceProtocolsIsisParameters) DeepCopy() *NetworkinstanceProtocolsIsisParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisSpec) DeepCopyInto(out *NetworkinstanceProtocolsIsisSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisSpec.
+func (in *NetworkinstanceProtocolsIsisSpec) DeepCopy() *NetworkinstanceProtocolsIsisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsIsisStatus) DeepCopyInto(out *NetworkinstanceProtocolsIsisStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsIsisStatus.
+func (in *NetworkinstanceProtocolsIsisStatus) DeepCopy() *NetworkinstanceProtocolsIsisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsIsisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsLinux) DeepCopyInto(out *NetworkinstanceProtocolsLinux) {
+	*out = *in
+	if in.ExportNeighbors != nil {
+		in, out := &in.ExportNeighbors, &out.ExportNeighbors
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExportRoutes != nil {
+		in, out := &in.ExportRoutes, &out.ExportRoutes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImportRoutes != nil {
+		in, out := &in.ImportRoutes, &out.ImportRoutes
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinux.
+func (in *NetworkinstanceProtocolsLinux) DeepCopy() *NetworkinstanceProtocolsLinux {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsLinux)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsLinuxObservation) DeepCopyInto(out *NetworkinstanceProtocolsLinuxObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxObservation.
+func (in *NetworkinstanceProtocolsLinuxObservation) DeepCopy() *NetworkinstanceProtocolsLinuxObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsLinuxObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsLinuxParameters) DeepCopyInto(out *NetworkinstanceProtocolsLinuxParameters) {
+	*out = *in
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SrlNetworkinstanceProtocolsLinux != nil {
+		in, out := &in.SrlNetworkinstanceProtocolsLinux, &out.SrlNetworkinstanceProtocolsLinux
+		*out = new(NetworkinstanceProtocolsLinux)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxParameters.
+func (in *NetworkinstanceProtocolsLinuxParameters) DeepCopy() *NetworkinstanceProtocolsLinuxParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsLinuxParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsLinuxSpec) DeepCopyInto(out *NetworkinstanceProtocolsLinuxSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxSpec.
+func (in *NetworkinstanceProtocolsLinuxSpec) DeepCopy() *NetworkinstanceProtocolsLinuxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsLinuxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsLinuxStatus) DeepCopyInto(out *NetworkinstanceProtocolsLinuxStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsLinuxStatus.
+func (in *NetworkinstanceProtocolsLinuxStatus) DeepCopy() *NetworkinstanceProtocolsLinuxStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsLinuxStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspf) DeepCopyInto(out *NetworkinstanceProtocolsOspf) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = make([]*NetworkinstanceProtocolsOspfInstance, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsOspfInstance)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspf.
+func (in *NetworkinstanceProtocolsOspf) DeepCopy() *NetworkinstanceProtocolsOspf {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstance) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstance) {
+	*out = *in
+	if in.AddressFamily != nil {
+		in, out := &in.AddressFamily, &out.AddressFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertiseRouterCapability != nil {
+		in, out := &in.AdvertiseRouterCapability, &out.AdvertiseRouterCapability
+		*out = new(string)
+		**out = **in
+	}
+	if in.Area != nil {
+		in, out := &in.Area, &out.Area
+		*out = make([]*NetworkinstanceProtocolsOspfInstanceArea, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsOspfInstanceArea)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Asbr != nil {
+		in, out := &in.Asbr, &out.Asbr
+		*out = new(NetworkinstanceProtocolsOspfInstanceAsbr)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportLimit != nil {
+		in, out := &in.ExportLimit, &out.ExportLimit
+		*out = new(NetworkinstanceProtocolsOspfInstanceExportLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalDbOverflow != nil {
+		in, out := &in.ExternalDbOverflow, &out.ExternalDbOverflow
+		*out = new(NetworkinstanceProtocolsOspfInstanceExternalDbOverflow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalPreference != nil {
+		in, out := &in.ExternalPreference, &out.ExternalPreference
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.GracefulRestart != nil {
+		in, out := &in.GracefulRestart, &out.GracefulRestart
+		*out = new(NetworkinstanceProtocolsOspfInstanceGracefulRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceId != nil {
+		in, out := &in.InstanceId, &out.InstanceId
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxEcmpPaths != nil {
+		in, out := &in.MaxEcmpPaths, &out.MaxEcmpPaths
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Overload != nil {
+		in, out := &in.Overload, &out.Overload
+		*out = new(NetworkinstanceProtocolsOspfInstanceOverload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.ReferenceBandwidth != nil {
+		in, out := &in.ReferenceBandwidth, &out.ReferenceBandwidth
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.RouterId != nil {
+		in, out := &in.RouterId, &out.RouterId
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeDatabaseInstall != nil {
+		in, out := &in.TeDatabaseInstall, &out.TeDatabaseInstall
+		*out = new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timers != nil {
+		in, out := &in.Timers, &out.Timers
+		*out = new(NetworkinstanceProtocolsOspfInstanceTimers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficEngineering != nil {
+		in, out := &in.TrafficEngineering, &out.TrafficEngineering
+		*out = new(NetworkinstanceProtocolsOspfInstanceTrafficEngineering)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstance.
+func (in *NetworkinstanceProtocolsOspfInstance) DeepCopy() *NetworkinstanceProtocolsOspfInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceArea) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceArea) {
+	*out = *in
+	if in.AdvertiseRouterCapability != nil {
+		in, out := &in.AdvertiseRouterCapability, &out.AdvertiseRouterCapability
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AreaId != nil {
+		in, out := &in.AreaId, &out.AreaId
+		*out = new(string)
+		**out = **in
+	}
+	if in.AreaRange != nil {
+		in, out := &in.AreaRange, &out.AreaRange
+		*out = make([]*NetworkinstanceProtocolsOspfInstanceAreaAreaRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsOspfInstanceAreaAreaRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BgpLsExclude != nil {
+		in, out := &in.BgpLsExclude, &out.BgpLsExclude
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlackholeAggregate != nil {
+		in, out := &in.BlackholeAggregate, &out.BlackholeAggregate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = make([]*NetworkinstanceProtocolsOspfInstanceAreaInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Nssa != nil {
+		in, out := &in.Nssa, &out.Nssa
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaNssa)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stub != nil {
+		in, out := &in.Stub, &out.Stub
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaStub)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceArea.
+func (in *NetworkinstanceProtocolsOspfInstanceArea) DeepCopy() *NetworkinstanceProtocolsOspfInstanceArea {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceArea)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaAreaRange) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaAreaRange) {
+	*out = *in
+	if in.Advertise != nil {
+		in, out := &in.Advertise, &out.Advertise
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IpPrefixMask != nil {
+		in, out := &in.IpPrefixMask, &out.IpPrefixMask
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaAreaRange.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaAreaRange) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaAreaRange {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaAreaRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterface) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterface) {
+	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertiseRouterCapability != nil {
+		in, out := &in.AdvertiseRouterCapability, &out.AdvertiseRouterCapability
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdvertiseSubnet != nil {
+		in, out := &in.AdvertiseSubnet, &out.AdvertiseSubnet
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadInterval != nil {
+		in, out := &in.DeadInterval, &out.DeadInterval
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.FailureDetection != nil {
+		in, out := &in.FailureDetection, &out.FailureDetection
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelloInterval != nil {
+		in, out := &in.HelloInterval, &out.HelloInterval
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.LsaFilterOut != nil {
+		in, out := &in.LsaFilterOut, &out.LsaFilterOut
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Passive != nil {
+		in, out := &in.Passive, &out.Passive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.RetransmitInterval != nil {
+		in, out := &in.RetransmitInterval, &out.RetransmitInterval
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TraceOptions != nil {
+		in, out := &in.TraceOptions, &out.TraceOptions
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitDelay != nil {
+		in, out := &in.TransitDelay, &out.TransitDelay
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterface.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterface) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication) {
+	*out = *in
+	if in.Keychain != nil {
+		in, out := &in.Keychain, &out.Keychain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection) {
+	*out = *in
+	if in.EnableBfd != nil {
+		in, out := &in.EnableBfd, &out.EnableBfd
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions) {
+	*out = *in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace) {
+	*out = *in
+	if in.Adjacencies != nil {
+		in, out := &in.Adjacencies, &out.Adjacencies
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = new(string)
+		**out = **in
+	}
+	if in.Packet != nil {
+		in, out := &in.Packet, &out.Packet
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket) {
+	*out = *in
+	if in.Detail != nil {
+		in, out := &in.Detail, &out.Detail
+		*out = new(string)
+		**out = **in
+	}
+	if in.Modifier != nil {
+		in, out := &in.Modifier, &out.Modifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaNssa) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaNssa) {
+	*out = *in
+	if in.AreaRange != nil {
+		in, out := &in.AreaRange, &out.AreaRange
+		*out = make([]*NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.OriginateDefaultRoute != nil {
+		in, out := &in.OriginateDefaultRoute, &out.OriginateDefaultRoute
+		*out = new(NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedistributeExternal != nil {
+		in, out := &in.RedistributeExternal, &out.RedistributeExternal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Summaries != nil {
+		in, out := &in.Summaries, &out.Summaries
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaNssa.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaNssa) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaNssa {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaNssa)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange) {
+	*out = *in
+	if in.Advertise != nil {
+		in, out := &in.Advertise, &out.Advertise
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IpPrefixMask != nil {
+		in, out := &in.IpPrefixMask, &out.IpPrefixMask
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute) {
+	*out = *in
+	if in.AdjacencyCheck != nil {
+		in, out := &in.AdjacencyCheck, &out.AdjacencyCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TypeNssa != nil {
+		in, out := &in.TypeNssa, &out.TypeNssa
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaStub) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaStub) {
+	*out = *in
+	if in.DefaultMetric != nil {
+		in, out := &in.DefaultMetric, &out.DefaultMetric
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.Summaries != nil {
+		in, out := &in.Summaries, &out.Summaries
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaStub.
+func (in *NetworkinstanceProtocolsOspfInstanceAreaStub) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaStub {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAreaStub)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceAsbr) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAsbr) {
+	*out = *in
+	if in.TracePath != nil {
+		in, out := &in.TracePath, &out.TracePath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAsbr.
+func (in *NetworkinstanceProtocolsOspfInstanceAsbr) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAsbr {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceAsbr)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceExportLimit) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceExportLimit) {
+	*out = *in
+	if in.LogPercent != nil {
+		in, out := &in.LogPercent, &out.LogPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Number != nil {
+		in, out := &in.Number, &out.Number
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceExportLimit.
+func (in *NetworkinstanceProtocolsOspfInstanceExportLimit) DeepCopy() *NetworkinstanceProtocolsOspfInstanceExportLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceExportLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceExternalDbOverflow.
+func (in *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow) DeepCopy() *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceExternalDbOverflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceGracefulRestart) {
+	*out = *in
+	if in.HelperMode != nil {
+		in, out := &in.HelperMode, &out.HelperMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StrictLsaChecking != nil {
+		in, out := &in.StrictLsaChecking, &out.StrictLsaChecking
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceGracefulRestart.
+func (in *NetworkinstanceProtocolsOspfInstanceGracefulRestart) DeepCopy() *NetworkinstanceProtocolsOspfInstanceGracefulRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceGracefulRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceOverload) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceOverload) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverloadIncludeExt1 != nil {
+		in, out := &in.OverloadIncludeExt1, &out.OverloadIncludeExt1
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverloadIncludeExt2 != nil {
+		in, out := &in.OverloadIncludeExt2, &out.OverloadIncludeExt2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverloadIncludeExtStub != nil {
+		in, out := &in.OverloadIncludeExtStub, &out.OverloadIncludeExtStub
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverloadOnBoot != nil {
+		in, out := &in.OverloadOnBoot, &out.OverloadOnBoot
+		*out = new(NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RtrAdvLsaLimit != nil {
+		in, out := &in.RtrAdvLsaLimit, &out.RtrAdvLsaLimit
+		*out = new(NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceOverload.
+func (in *NetworkinstanceProtocolsOspfInstanceOverload) DeepCopy() *NetworkinstanceProtocolsOspfInstanceOverload {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceOverload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot.
+func (in *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot) DeepCopy() *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit) {
+	*out = *in
+	if in.LogOnly != nil {
+		in, out := &in.LogOnly, &out.LogOnly
 		*out = new(bool)
 		**out = **in
 	}
-	if in.BlackholeAggregate != nil {
-		in, out := &in.BlackholeAggregate, &out.BlackholeAggregate
-		*out = new(bool)
+	if in.MaxLsaCount != nil {
+		in, out := &in.MaxLsaCount, &out.MaxLsaCount
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.ExportPolicy != nil {
-		in, out := &in.ExportPolicy, &out.ExportPolicy
-		*out = new(string)
+	if in.OverloadTimeout != nil {
+		in, out := &in.OverloadTimeout, &out.OverloadTimeout
+		*out = new(uint16)
 		**out = **in
 	}
-	if in.Interface != nil {
-		in, out := &in.Interface, &out.Interface
-		*out = make([]*NetworkinstanceProtocolsOspfInstanceAreaInterface, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterface)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.WarningThreshold != nil {
+		in, out := &in.WarningThreshold, &out.WarningThreshold
+		*out = new(uint8)
+		**out = **in
 	}
-	if in.Nssa != nil {
-		in, out := &in.Nssa, &out.Nssa
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaNssa)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit.
+func (in *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit) DeepCopy() *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit {
+	if in == nil {
+		return nil
 	}
-	if in.Stub != nil {
-		in, out := &in.Stub, &out.Stub
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaStub)
+	out := new(NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall) {
+	*out = *in
+	if in.BgpLs != nil {
+		in, out := &in.BgpLs, &out.BgpLs
+		*out = new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceArea.
-func (in *NetworkinstanceProtocolsOspfInstanceArea) DeepCopy() *NetworkinstanceProtocolsOspfInstanceArea {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall.
+func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceArea)
+	out := new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaAreaRange) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaAreaRange) {
+func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs) {
 	*out = *in
-	if in.Advertise != nil {
-		in, out := &in.Advertise, &out.Advertise
-		*out = new(bool)
+	if in.BgpLsIdentifier != nil {
+		in, out := &in.BgpLsIdentifier, &out.BgpLsIdentifier
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.IpPrefixMask != nil {
-		in, out := &in.IpPrefixMask, &out.IpPrefixMask
-		*out = new(string)
+	if in.IgpIdentifier != nil {
+		in, out := &in.IgpIdentifier, &out.IgpIdentifier
+		*out = new(uint64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaAreaRange.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaAreaRange) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaAreaRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs.
+func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaAreaRange)
+	out := new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterface) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterface) {
+func (in *NetworkinstanceProtocolsOspfInstanceTimers) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTimers) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.AdvertiseRouterCapability != nil {
-		in, out := &in.AdvertiseRouterCapability, &out.AdvertiseRouterCapability
-		*out = new(bool)
+	if in.IncrementalSpfWait != nil {
+		in, out := &in.IncrementalSpfWait, &out.IncrementalSpfWait
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.AdvertiseSubnet != nil {
-		in, out := &in.AdvertiseSubnet, &out.AdvertiseSubnet
-		*out = new(bool)
+	if in.LsaAccumulate != nil {
+		in, out := &in.LsaAccumulate, &out.LsaAccumulate
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DeadInterval != nil {
-		in, out := &in.DeadInterval, &out.DeadInterval
+	if in.LsaArrival != nil {
+		in, out := &in.LsaArrival, &out.LsaArrival
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.FailureDetection != nil {
-		in, out := &in.FailureDetection, &out.FailureDetection
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection)
+	if in.LsaGenerate != nil {
+		in, out := &in.LsaGenerate, &out.LsaGenerate
+		*out = new(NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.HelloInterval != nil {
-		in, out := &in.HelloInterval, &out.HelloInterval
+	if in.RedistributeDelay != nil {
+		in, out := &in.RedistributeDelay, &out.RedistributeDelay
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.InterfaceName != nil {
-		in, out := &in.InterfaceName, &out.InterfaceName
-		*out = new(string)
-		**out = **in
+	if in.SpfWait != nil {
+		in, out := &in.SpfWait, &out.SpfWait
+		*out = new(NetworkinstanceProtocolsOspfInstanceTimersSpfWait)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.InterfaceType != nil {
-		in, out := &in.InterfaceType, &out.InterfaceType
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTimers.
+func (in *NetworkinstanceProtocolsOspfInstanceTimers) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTimers {
+	if in == nil {
+		return nil
 	}
-	if in.LsaFilterOut != nil {
-		in, out := &in.LsaFilterOut, &out.LsaFilterOut
-		*out = new(string)
+	out := new(NetworkinstanceProtocolsOspfInstanceTimers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate) {
+	*out = *in
+	if in.LsaInitialWait != nil {
+		in, out := &in.LsaInitialWait, &out.LsaInitialWait
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Metric != nil {
-		in, out := &in.Metric, &out.Metric
-		*out = new(uint16)
+	if in.LsaSecondWait != nil {
+		in, out := &in.LsaSecondWait, &out.LsaSecondWait
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
+	if in.MaxLsaWait != nil {
+		in, out := &in.MaxLsaWait, &out.MaxLsaWait
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Passive != nil {
-		in, out := &in.Passive, &out.Passive
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate.
+func (in *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate {
+	if in == nil {
+		return nil
 	}
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(uint16)
+	out := new(NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfInstanceTimersSpfWait) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTimersSpfWait) {
+	*out = *in
+	if in.SpfInitialWait != nil {
+		in, out := &in.SpfInitialWait, &out.SpfInitialWait
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.RetransmitInterval != nil {
-		in, out := &in.RetransmitInterval, &out.RetransmitInterval
+	if in.SpfMaxWait != nil {
+		in, out := &in.SpfMaxWait, &out.SpfMaxWait
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.TraceOptions != nil {
-		in, out := &in.TraceOptions, &out.TraceOptions
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TransitDelay != nil {
-		in, out := &in.TransitDelay, &out.TransitDelay
+	if in.SpfSecondWait != nil {
+		in, out := &in.SpfSecondWait, &out.SpfSecondWait
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterface.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterface) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTimersSpfWait.
+func (in *NetworkinstanceProtocolsOspfInstanceTimersSpfWait) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTimersSpfWait {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterface)
+	out := new(NetworkinstanceProtocolsOspfInstanceTimersSpfWait)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication) {
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptions) {
 	*out = *in
-	if in.Keychain != nil {
-		in, out := &in.Keychain, &out.Keychain
-		*out = new(string)
-		**out = **in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptions.
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptions) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceAuthentication)
+	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection) {
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace) {
 	*out = *in
-	if in.EnableBfd != nil {
-		in, out := &in.EnableBfd, &out.EnableBfd
-		*out = new(bool)
+	if in.Adjacencies != nil {
+		in, out := &in.Adjacencies, &out.Adjacencies
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection {
-	if in == nil {
-		return nil
+	if in.GracefulRestart != nil {
+		in, out := &in.GracefulRestart, &out.GracefulRestart
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = new(string)
+		**out = **in
+	}
+	if in.Lsdb != nil {
+		in, out := &in.Lsdb, &out.Lsdb
+		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Misc != nil {
+		in, out := &in.Misc, &out.Misc
+		*out = new(string)
+		**out = **in
+	}
+	if in.Packet != nil {
+		in, out := &in.Packet, &out.Packet
+		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceFailureDetection)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions) {
-	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace)
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spf != nil {
+		in, out := &in.Spf, &out.Spf
+		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace.
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptions)
+	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace) {
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb) {
 	*out = *in
-	if in.Adjacencies != nil {
-		in, out := &in.Adjacencies, &out.Adjacencies
+	if in.LinkStateId != nil {
+		in, out := &in.LinkStateId, &out.LinkStateId
 		*out = new(string)
 		**out = **in
 	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
+	if in.RouterId != nil {
+		in, out := &in.RouterId, &out.RouterId
 		*out = new(string)
 		**out = **in
 	}
-	if in.Packet != nil {
-		in, out := &in.Packet, &out.Packet
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket)
-		(*in).DeepCopyInto(*out)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb.
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTrace)
+	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket) {
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket) {
 	*out = *in
 	if in.Detail != nil {
 		in, out := &in.Detail, &out.Detail
@@ -8265,1643 +9550,1575 @@ func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePack
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket.
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaInterfaceTraceOptionsTracePacket)
+	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaNssa) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaNssa) {
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes) {
 	*out = *in
-	if in.AreaRange != nil {
-		in, out := &in.AreaRange, &out.AreaRange
-		*out = make([]*NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.OriginateDefaultRoute != nil {
-		in, out := &in.OriginateDefaultRoute, &out.OriginateDefaultRoute
-		*out = new(NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RedistributeExternal != nil {
-		in, out := &in.RedistributeExternal, &out.RedistributeExternal
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Summaries != nil {
-		in, out := &in.Summaries, &out.Summaries
-		*out = new(bool)
+	if in.DestAddress != nil {
+		in, out := &in.DestAddress, &out.DestAddress
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaNssa.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaNssa) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaNssa {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes.
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaNssa)
+	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange) {
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf) {
 	*out = *in
-	if in.Advertise != nil {
-		in, out := &in.Advertise, &out.Advertise
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IpPrefixMask != nil {
-		in, out := &in.IpPrefixMask, &out.IpPrefixMask
+	if in.DestAddress != nil {
+		in, out := &in.DestAddress, &out.DestAddress
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf.
+func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaNssaAreaRange)
+	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute) {
+func (in *NetworkinstanceProtocolsOspfInstanceTrafficEngineering) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTrafficEngineering) {
 	*out = *in
-	if in.AdjacencyCheck != nil {
-		in, out := &in.AdjacencyCheck, &out.AdjacencyCheck
+	if in.Advertisement != nil {
+		in, out := &in.Advertisement, &out.Advertisement
 		*out = new(bool)
 		**out = **in
 	}
-	if in.TypeNssa != nil {
-		in, out := &in.TypeNssa, &out.TypeNssa
+	if in.LegacyLinkAttributeAdvertisement != nil {
+		in, out := &in.LegacyLinkAttributeAdvertisement, &out.LegacyLinkAttributeAdvertisement
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTrafficEngineering.
+func (in *NetworkinstanceProtocolsOspfInstanceTrafficEngineering) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTrafficEngineering {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaNssaOriginateDefaultRoute)
+	out := new(NetworkinstanceProtocolsOspfInstanceTrafficEngineering)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaStub) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAreaStub) {
+func (in *NetworkinstanceProtocolsOspfObservation) DeepCopyInto(out *NetworkinstanceProtocolsOspfObservation) {
 	*out = *in
-	if in.DefaultMetric != nil {
-		in, out := &in.DefaultMetric, &out.DefaultMetric
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.Summaries != nil {
-		in, out := &in.Summaries, &out.Summaries
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAreaStub.
-func (in *NetworkinstanceProtocolsOspfInstanceAreaStub) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAreaStub {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfObservation.
+func (in *NetworkinstanceProtocolsOspfObservation) DeepCopy() *NetworkinstanceProtocolsOspfObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAreaStub)
+	out := new(NetworkinstanceProtocolsOspfObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceAsbr) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceAsbr) {
+func (in *NetworkinstanceProtocolsOspfParameters) DeepCopyInto(out *NetworkinstanceProtocolsOspfParameters) {
 	*out = *in
-	if in.TracePath != nil {
-		in, out := &in.TracePath, &out.TracePath
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
 		*out = new(string)
 		**out = **in
 	}
+	if in.SrlNetworkinstanceProtocolsOspf != nil {
+		in, out := &in.SrlNetworkinstanceProtocolsOspf, &out.SrlNetworkinstanceProtocolsOspf
+		*out = new(NetworkinstanceProtocolsOspf)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceAsbr.
-func (in *NetworkinstanceProtocolsOspfInstanceAsbr) DeepCopy() *NetworkinstanceProtocolsOspfInstanceAsbr {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfParameters.
+func (in *NetworkinstanceProtocolsOspfParameters) DeepCopy() *NetworkinstanceProtocolsOspfParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceAsbr)
+	out := new(NetworkinstanceProtocolsOspfParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceExportLimit) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceExportLimit) {
+func (in *NetworkinstanceProtocolsOspfSpec) DeepCopyInto(out *NetworkinstanceProtocolsOspfSpec) {
 	*out = *in
-	if in.LogPercent != nil {
-		in, out := &in.LogPercent, &out.LogPercent
-		*out = new(uint32)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfSpec.
+func (in *NetworkinstanceProtocolsOspfSpec) DeepCopy() *NetworkinstanceProtocolsOspfSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Number != nil {
-		in, out := &in.Number, &out.Number
-		*out = new(uint32)
-		**out = **in
+	out := new(NetworkinstanceProtocolsOspfSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceProtocolsOspfStatus) DeepCopyInto(out *NetworkinstanceProtocolsOspfStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfStatus.
+func (in *NetworkinstanceProtocolsOspfStatus) DeepCopy() *NetworkinstanceProtocolsOspfStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(NetworkinstanceProtocolsOspfStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceExportLimit.
-func (in *NetworkinstanceProtocolsOspfInstanceExportLimit) DeepCopy() *NetworkinstanceProtocolsOspfInstanceExportLimit {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceSpec) DeepCopyInto(out *NetworkinstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceSpec.
+func (in *NetworkinstanceSpec) DeepCopy() *NetworkinstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceExportLimit)
+	out := new(NetworkinstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow) {
+func (in *NetworkinstanceStaticroutes) DeepCopyInto(out *NetworkinstanceStaticroutes) {
 	*out = *in
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(uint32)
-		**out = **in
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = make([]*NetworkinstanceStaticroutesRoute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceStaticroutesRoute)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Limit != nil {
-		in, out := &in.Limit, &out.Limit
-		*out = new(uint32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutes.
+func (in *NetworkinstanceStaticroutes) DeepCopy() *NetworkinstanceStaticroutes {
+	if in == nil {
+		return nil
 	}
+	out := new(NetworkinstanceStaticroutes)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceExternalDbOverflow.
-func (in *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow) DeepCopy() *NetworkinstanceProtocolsOspfInstanceExternalDbOverflow {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceStaticroutesObservation) DeepCopyInto(out *NetworkinstanceStaticroutesObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesObservation.
+func (in *NetworkinstanceStaticroutesObservation) DeepCopy() *NetworkinstanceStaticroutesObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceExternalDbOverflow)
+	out := new(NetworkinstanceStaticroutesObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceGracefulRestart) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceGracefulRestart) {
+func (in *NetworkinstanceStaticroutesParameters) DeepCopyInto(out *NetworkinstanceStaticroutesParameters) {
 	*out = *in
-	if in.HelperMode != nil {
-		in, out := &in.HelperMode, &out.HelperMode
-		*out = new(bool)
+	if in.NetworkInstanceName != nil {
+		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+		*out = new(string)
 		**out = **in
 	}
-	if in.StrictLsaChecking != nil {
-		in, out := &in.StrictLsaChecking, &out.StrictLsaChecking
-		*out = new(bool)
-		**out = **in
+	if in.SrlNetworkinstanceStaticroutes != nil {
+		in, out := &in.SrlNetworkinstanceStaticroutes, &out.SrlNetworkinstanceStaticroutes
+		*out = new(NetworkinstanceStaticroutes)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceGracefulRestart.
-func (in *NetworkinstanceProtocolsOspfInstanceGracefulRestart) DeepCopy() *NetworkinstanceProtocolsOspfInstanceGracefulRestart {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesParameters.
+func (in *NetworkinstanceStaticroutesParameters) DeepCopy() *NetworkinstanceStaticroutesParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceGracefulRestart)
+	out := new(NetworkinstanceStaticroutesParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceOverload) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceOverload) {
+func (in *NetworkinstanceStaticroutesRoute) DeepCopyInto(out *NetworkinstanceStaticroutesRoute) {
 	*out = *in
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.OverloadIncludeExt1 != nil {
-		in, out := &in.OverloadIncludeExt1, &out.OverloadIncludeExt1
-		*out = new(bool)
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.OverloadIncludeExt2 != nil {
-		in, out := &in.OverloadIncludeExt2, &out.OverloadIncludeExt2
-		*out = new(bool)
+	if in.NextHopGroup != nil {
+		in, out := &in.NextHopGroup, &out.NextHopGroup
+		*out = new(string)
 		**out = **in
 	}
-	if in.OverloadIncludeExtStub != nil {
-		in, out := &in.OverloadIncludeExtStub, &out.OverloadIncludeExtStub
-		*out = new(bool)
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.OverloadOnBoot != nil {
-		in, out := &in.OverloadOnBoot, &out.OverloadOnBoot
-		*out = new(NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RtrAdvLsaLimit != nil {
-		in, out := &in.RtrAdvLsaLimit, &out.RtrAdvLsaLimit
-		*out = new(NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit)
-		(*in).DeepCopyInto(*out)
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceOverload.
-func (in *NetworkinstanceProtocolsOspfInstanceOverload) DeepCopy() *NetworkinstanceProtocolsOspfInstanceOverload {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesRoute.
+func (in *NetworkinstanceStaticroutesRoute) DeepCopy() *NetworkinstanceStaticroutesRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceOverload)
+	out := new(NetworkinstanceStaticroutesRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot) {
+func (in *NetworkinstanceStaticroutesSpec) DeepCopyInto(out *NetworkinstanceStaticroutesSpec) {
 	*out = *in
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(uint32)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot.
-func (in *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot) DeepCopy() *NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesSpec.
+func (in *NetworkinstanceStaticroutesSpec) DeepCopy() *NetworkinstanceStaticroutesSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceOverloadOverloadOnBoot)
+	out := new(NetworkinstanceStaticroutesSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit) {
+func (in *NetworkinstanceStaticroutesStatus) DeepCopyInto(out *NetworkinstanceStaticroutesStatus) {
 	*out = *in
-	if in.LogOnly != nil {
-		in, out := &in.LogOnly, &out.LogOnly
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MaxLsaCount != nil {
-		in, out := &in.MaxLsaCount, &out.MaxLsaCount
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.OverloadTimeout != nil {
-		in, out := &in.OverloadTimeout, &out.OverloadTimeout
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.WarningThreshold != nil {
-		in, out := &in.WarningThreshold, &out.WarningThreshold
-		*out = new(uint8)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit.
-func (in *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit) DeepCopy() *NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesStatus.
+func (in *NetworkinstanceStaticroutesStatus) DeepCopy() *NetworkinstanceStaticroutesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceOverloadRtrAdvLsaLimit)
+	out := new(NetworkinstanceStaticroutesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall) {
+func (in *NetworkinstanceStatus) DeepCopyInto(out *NetworkinstanceStatus) {
 	*out = *in
-	if in.BgpLs != nil {
-		in, out := &in.BgpLs, &out.BgpLs
-		*out = new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall.
-func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStatus.
+func (in *NetworkinstanceStatus) DeepCopy() *NetworkinstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstall)
+	out := new(NetworkinstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs) {
+func (in *NetworkinstanceTrafficEngineering) DeepCopyInto(out *NetworkinstanceTrafficEngineering) {
 	*out = *in
-	if in.BgpLsIdentifier != nil {
-		in, out := &in.BgpLsIdentifier, &out.BgpLsIdentifier
+	if in.AdminGroups != nil {
+		in, out := &in.AdminGroups, &out.AdminGroups
+		*out = new(NetworkinstanceTrafficEngineeringAdminGroups)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutonomousSystem != nil {
+		in, out := &in.AutonomousSystem, &out.AutonomousSystem
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.IgpIdentifier != nil {
-		in, out := &in.IgpIdentifier, &out.IgpIdentifier
-		*out = new(uint64)
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = make([]*NetworkinstanceTrafficEngineeringInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceTrafficEngineeringInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Ipv4TeRouterId != nil {
+		in, out := &in.Ipv4TeRouterId, &out.Ipv4TeRouterId
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv6TeRouterId != nil {
+		in, out := &in.Ipv6TeRouterId, &out.Ipv6TeRouterId
+		*out = new(string)
 		**out = **in
 	}
+	if in.SharedRiskLinkGroups != nil {
+		in, out := &in.SharedRiskLinkGroups, &out.SharedRiskLinkGroups
+		*out = new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroups)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs.
-func (in *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineering.
+func (in *NetworkinstanceTrafficEngineering) DeepCopy() *NetworkinstanceTrafficEngineering {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTeDatabaseInstallBgpLs)
+	out := new(NetworkinstanceTrafficEngineering)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTimers) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTimers) {
+func (in *NetworkinstanceTrafficEngineeringAdminGroups) DeepCopyInto(out *NetworkinstanceTrafficEngineeringAdminGroups) {
 	*out = *in
-	if in.IncrementalSpfWait != nil {
-		in, out := &in.IncrementalSpfWait, &out.IncrementalSpfWait
-		*out = new(uint32)
-		**out = **in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*NetworkinstanceTrafficEngineeringAdminGroupsGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceTrafficEngineeringAdminGroupsGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.LsaAccumulate != nil {
-		in, out := &in.LsaAccumulate, &out.LsaAccumulate
-		*out = new(uint32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringAdminGroups.
+func (in *NetworkinstanceTrafficEngineeringAdminGroups) DeepCopy() *NetworkinstanceTrafficEngineeringAdminGroups {
+	if in == nil {
+		return nil
 	}
-	if in.LsaArrival != nil {
-		in, out := &in.LsaArrival, &out.LsaArrival
+	out := new(NetworkinstanceTrafficEngineeringAdminGroups)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkinstanceTrafficEngineeringAdminGroupsGroup) DeepCopyInto(out *NetworkinstanceTrafficEngineeringAdminGroupsGroup) {
+	*out = *in
+	if in.BitPosition != nil {
+		in, out := &in.BitPosition, &out.BitPosition
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.LsaGenerate != nil {
-		in, out := &in.LsaGenerate, &out.LsaGenerate
-		*out = new(NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RedistributeDelay != nil {
-		in, out := &in.RedistributeDelay, &out.RedistributeDelay
-		*out = new(uint32)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	if in.SpfWait != nil {
-		in, out := &in.SpfWait, &out.SpfWait
-		*out = new(NetworkinstanceProtocolsOspfInstanceTimersSpfWait)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTimers.
-func (in *NetworkinstanceProtocolsOspfInstanceTimers) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTimers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringAdminGroupsGroup.
+func (in *NetworkinstanceTrafficEngineeringAdminGroupsGroup) DeepCopy() *NetworkinstanceTrafficEngineeringAdminGroupsGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTimers)
+	out := new(NetworkinstanceTrafficEngineeringAdminGroupsGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate) {
+func (in *NetworkinstanceTrafficEngineeringInterface) DeepCopyInto(out *NetworkinstanceTrafficEngineeringInterface) {
 	*out = *in
-	if in.LsaInitialWait != nil {
-		in, out := &in.LsaInitialWait, &out.LsaInitialWait
-		*out = new(uint32)
+	if in.AdminGroup != nil {
+		in, out := &in.AdminGroup, &out.AdminGroup
+		*out = new(string)
 		**out = **in
 	}
-	if in.LsaSecondWait != nil {
-		in, out := &in.LsaSecondWait, &out.LsaSecondWait
-		*out = new(uint32)
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(NetworkinstanceTrafficEngineeringInterfaceDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxLsaWait != nil {
-		in, out := &in.MaxLsaWait, &out.MaxLsaWait
+	if in.SrlgMembership != nil {
+		in, out := &in.SrlgMembership, &out.SrlgMembership
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeMetric != nil {
+		in, out := &in.TeMetric, &out.TeMetric
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate.
-func (in *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringInterface.
+func (in *NetworkinstanceTrafficEngineeringInterface) DeepCopy() *NetworkinstanceTrafficEngineeringInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTimersLsaGenerate)
+	out := new(NetworkinstanceTrafficEngineeringInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTimersSpfWait) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTimersSpfWait) {
+func (in *NetworkinstanceTrafficEngineeringInterfaceDelay) DeepCopyInto(out *NetworkinstanceTrafficEngineeringInterfaceDelay) {
 	*out = *in
-	if in.SpfInitialWait != nil {
-		in, out := &in.SpfInitialWait, &out.SpfInitialWait
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SpfMaxWait != nil {
-		in, out := &in.SpfMaxWait, &out.SpfMaxWait
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SpfSecondWait != nil {
-		in, out := &in.SpfSecondWait, &out.SpfSecondWait
+	if in.Static != nil {
+		in, out := &in.Static, &out.Static
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTimersSpfWait.
-func (in *NetworkinstanceProtocolsOspfInstanceTimersSpfWait) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTimersSpfWait {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringInterfaceDelay.
+func (in *NetworkinstanceTrafficEngineeringInterfaceDelay) DeepCopy() *NetworkinstanceTrafficEngineeringInterfaceDelay {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTimersSpfWait)
+	out := new(NetworkinstanceTrafficEngineeringInterfaceDelay)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptions) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptions) {
+func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups) DeepCopyInto(out *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups) {
 	*out = *in
-	if in.Trace != nil {
-		in, out := &in.Trace, &out.Trace
-		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace)
-		(*in).DeepCopyInto(*out)
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptions.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptions) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringSharedRiskLinkGroups.
+func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups) DeepCopy() *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptions)
+	out := new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroups)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace) {
+func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup) DeepCopyInto(out *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup) {
 	*out = *in
-	if in.Adjacencies != nil {
-		in, out := &in.Adjacencies, &out.Adjacencies
-		*out = new(string)
-		**out = **in
-	}
-	if in.GracefulRestart != nil {
-		in, out := &in.GracefulRestart, &out.GracefulRestart
-		*out = new(string)
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Lsdb != nil {
-		in, out := &in.Lsdb, &out.Lsdb
-		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb)
-		(*in).DeepCopyInto(*out)
+	if in.StaticMember != nil {
+		in, out := &in.StaticMember, &out.StaticMember
+		*out = make([]*NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Misc != nil {
-		in, out := &in.Misc, &out.Misc
-		*out = new(string)
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Packet != nil {
-		in, out := &in.Packet, &out.Packet
-		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Spf != nil {
-		in, out := &in.Spf, &out.Spf
-		*out = new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup.
+func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup) DeepCopy() *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTrace)
+	out := new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb) {
+func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember) DeepCopyInto(out *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember) {
 	*out = *in
-	if in.LinkStateId != nil {
-		in, out := &in.LinkStateId, &out.LinkStateId
-		*out = new(string)
-		**out = **in
-	}
-	if in.RouterId != nil {
-		in, out := &in.RouterId, &out.RouterId
+	if in.FromAddress != nil {
+		in, out := &in.FromAddress, &out.FromAddress
 		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.ToAddress != nil {
+		in, out := &in.ToAddress, &out.ToAddress
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember.
+func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember) DeepCopy() *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceLsdb)
+	out := new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket) {
+func (in *NetworkinstanceVxlanInterface) DeepCopyInto(out *NetworkinstanceVxlanInterface) {
 	*out = *in
-	if in.Detail != nil {
-		in, out := &in.Detail, &out.Detail
-		*out = new(string)
-		**out = **in
-	}
-	if in.Modifier != nil {
-		in, out := &in.Modifier, &out.Modifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceVxlanInterface.
+func (in *NetworkinstanceVxlanInterface) DeepCopy() *NetworkinstanceVxlanInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTracePacket)
+	out := new(NetworkinstanceVxlanInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes) {
+func (in *PoolAllocation) DeepCopyInto(out *PoolAllocation) {
 	*out = *in
-	if in.DestAddress != nil {
-		in, out := &in.DestAddress, &out.DestAddress
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolAllocation.
+func (in *PoolAllocation) DeepCopy() *PoolAllocation {
+	if in == nil {
+		return nil
 	}
+	out := new(PoolAllocation)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolReference) DeepCopyInto(out *PoolReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolReference.
+func (in *PoolReference) DeepCopy() *PoolReference {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceRoutes)
+	out := new(PoolReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf) {
+func (in *PoolStatus) DeepCopyInto(out *PoolStatus) {
 	*out = *in
-	if in.DestAddress != nil {
-		in, out := &in.DestAddress, &out.DestAddress
-		*out = new(string)
-		**out = **in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]PoolAllocation, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf.
-func (in *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolStatus.
+func (in *PoolStatus) DeepCopy() *PoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTraceOptionsTraceSpf)
+	out := new(PoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfInstanceTrafficEngineering) DeepCopyInto(out *NetworkinstanceProtocolsOspfInstanceTrafficEngineering) {
+func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
-	if in.Advertisement != nil {
-		in, out := &in.Advertisement, &out.Advertisement
+	if in.Autopilot != nil {
+		in, out := &in.Autopilot, &out.Autopilot
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LegacyLinkAttributeAdvertisement != nil {
-		in, out := &in.LegacyLinkAttributeAdvertisement, &out.LegacyLinkAttributeAdvertisement
-		*out = new(bool)
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int)
+		**out = **in
+	}
+	in.DeviceDriver.DeepCopyInto(&out.DeviceDriver)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfInstanceTrafficEngineering.
-func (in *NetworkinstanceProtocolsOspfInstanceTrafficEngineering) DeepCopy() *NetworkinstanceProtocolsOspfInstanceTrafficEngineering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
+func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfInstanceTrafficEngineering)
+	out := new(ProviderConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfObservation) DeepCopyInto(out *NetworkinstanceProtocolsOspfObservation) {
+func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfObservation.
-func (in *NetworkinstanceProtocolsOspfObservation) DeepCopy() *NetworkinstanceProtocolsOspfObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
+func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfObservation)
+	out := new(ProviderConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfParameters) DeepCopyInto(out *NetworkinstanceProtocolsOspfParameters) {
+func (in *Registration) DeepCopyInto(out *Registration) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registration.
+func (in *Registration) DeepCopy() *Registration {
+	if in == nil {
+		return nil
 	}
-	if in.SrlNetworkinstanceProtocolsOspf != nil {
-		in, out := &in.SrlNetworkinstanceProtocolsOspf, &out.SrlNetworkinstanceProtocolsOspf
-		*out = new(NetworkinstanceProtocolsOspf)
-		(*in).DeepCopyInto(*out)
+	out := new(Registration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Registration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfParameters.
-func (in *NetworkinstanceProtocolsOspfParameters) DeepCopy() *NetworkinstanceProtocolsOspfParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrationList) DeepCopyInto(out *RegistrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Registration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationList.
+func (in *RegistrationList) DeepCopy() *RegistrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfParameters)
+	out := new(RegistrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfSpec) DeepCopyInto(out *NetworkinstanceProtocolsOspfSpec) {
+func (in *RegistrationObservation) DeepCopyInto(out *RegistrationObservation) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfSpec.
-func (in *NetworkinstanceProtocolsOspfSpec) DeepCopy() *NetworkinstanceProtocolsOspfSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationObservation.
+func (in *RegistrationObservation) DeepCopy() *RegistrationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfSpec)
+	out := new(RegistrationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceProtocolsOspfStatus) DeepCopyInto(out *NetworkinstanceProtocolsOspfStatus) {
+func (in *RegistrationParameters) DeepCopyInto(out *RegistrationParameters) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	in.Register.DeepCopyInto(&out.Register)
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(RegistrationSubscriptionOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceProtocolsOspfStatus.
-func (in *NetworkinstanceProtocolsOspfStatus) DeepCopy() *NetworkinstanceProtocolsOspfStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationParameters.
+func (in *RegistrationParameters) DeepCopy() *RegistrationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceProtocolsOspfStatus)
+	out := new(RegistrationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceSpec) DeepCopyInto(out *NetworkinstanceSpec) {
+func (in *RegistrationSpec) DeepCopyInto(out *RegistrationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceSpec.
-func (in *NetworkinstanceSpec) DeepCopy() *NetworkinstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSpec.
+func (in *RegistrationSpec) DeepCopy() *RegistrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceSpec)
+	out := new(RegistrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStaticroutes) DeepCopyInto(out *NetworkinstanceStaticroutes) {
+func (in *RegistrationStatus) DeepCopyInto(out *RegistrationStatus) {
 	*out = *in
-	if in.Route != nil {
-		in, out := &in.Route, &out.Route
-		*out = make([]*NetworkinstanceStaticroutesRoute, len(*in))
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]RegistrationSubscription, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceStaticroutesRoute)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutes.
-func (in *NetworkinstanceStaticroutes) DeepCopy() *NetworkinstanceStaticroutes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationStatus.
+func (in *RegistrationStatus) DeepCopy() *RegistrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStaticroutes)
+	out := new(RegistrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStaticroutesObservation) DeepCopyInto(out *NetworkinstanceStaticroutesObservation) {
+func (in *RegistrationSubscription) DeepCopyInto(out *RegistrationSubscription) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesObservation.
-func (in *NetworkinstanceStaticroutesObservation) DeepCopy() *NetworkinstanceStaticroutesObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSubscription.
+func (in *RegistrationSubscription) DeepCopy() *RegistrationSubscription {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStaticroutesObservation)
+	out := new(RegistrationSubscription)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStaticroutesParameters) DeepCopyInto(out *NetworkinstanceStaticroutesParameters) {
+func (in *RegistrationSubscriptionOptions) DeepCopyInto(out *RegistrationSubscriptionOptions) {
 	*out = *in
-	if in.NetworkInstanceName != nil {
-		in, out := &in.NetworkInstanceName, &out.NetworkInstanceName
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
-	if in.SrlNetworkinstanceStaticroutes != nil {
-		in, out := &in.SrlNetworkinstanceStaticroutes, &out.SrlNetworkinstanceStaticroutes
-		*out = new(NetworkinstanceStaticroutes)
-		(*in).DeepCopyInto(*out)
+	if in.SampleInterval != nil {
+		in, out := &in.SampleInterval, &out.SampleInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HeartbeatInterval != nil {
+		in, out := &in.HeartbeatInterval, &out.HeartbeatInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SuppressRedundant != nil {
+		in, out := &in.SuppressRedundant, &out.SuppressRedundant
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesParameters.
-func (in *NetworkinstanceStaticroutesParameters) DeepCopy() *NetworkinstanceStaticroutesParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSubscriptionOptions.
+func (in *RegistrationSubscriptionOptions) DeepCopy() *RegistrationSubscriptionOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStaticroutesParameters)
+	out := new(RegistrationSubscriptionOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStaticroutesRoute) DeepCopyInto(out *NetworkinstanceStaticroutesRoute) {
+func (in *RoutingpolicyAspathset) DeepCopyInto(out *RoutingpolicyAspathset) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Metric != nil {
-		in, out := &in.Metric, &out.Metric
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.NextHopGroup != nil {
-		in, out := &in.NextHopGroup, &out.NextHopGroup
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
 		*out = new(string)
 		**out = **in
 	}
-	if in.Preference != nil {
-		in, out := &in.Preference, &out.Preference
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesRoute.
-func (in *NetworkinstanceStaticroutesRoute) DeepCopy() *NetworkinstanceStaticroutesRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathset.
+func (in *RoutingpolicyAspathset) DeepCopy() *RoutingpolicyAspathset {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStaticroutesRoute)
+	out := new(RoutingpolicyAspathset)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStaticroutesSpec) DeepCopyInto(out *NetworkinstanceStaticroutesSpec) {
+func (in *RoutingpolicyAspathsetObservation) DeepCopyInto(out *RoutingpolicyAspathsetObservation) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesSpec.
-func (in *NetworkinstanceStaticroutesSpec) DeepCopy() *NetworkinstanceStaticroutesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetObservation.
+func (in *RoutingpolicyAspathsetObservation) DeepCopy() *RoutingpolicyAspathsetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStaticroutesSpec)
+	out := new(RoutingpolicyAspathsetObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStaticroutesStatus) DeepCopyInto(out *NetworkinstanceStaticroutesStatus) {
+func (in *RoutingpolicyAspathsetParameters) DeepCopyInto(out *RoutingpolicyAspathsetParameters) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.SrlRoutingpolicyAspathset != nil {
+		in, out := &in.SrlRoutingpolicyAspathset, &out.SrlRoutingpolicyAspathset
+		*out = new(RoutingpolicyAspathset)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStaticroutesStatus.
-func (in *NetworkinstanceStaticroutesStatus) DeepCopy() *NetworkinstanceStaticroutesStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetParameters.
+func (in *RoutingpolicyAspathsetParameters) DeepCopy() *RoutingpolicyAspathsetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStaticroutesStatus)
+	out := new(RoutingpolicyAspathsetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceStatus) DeepCopyInto(out *NetworkinstanceStatus) {
+func (in *RoutingpolicyAspathsetSpec) DeepCopyInto(out *RoutingpolicyAspathsetSpec) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceStatus.
-func (in *NetworkinstanceStatus) DeepCopy() *NetworkinstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetSpec.
+func (in *RoutingpolicyAspathsetSpec) DeepCopy() *RoutingpolicyAspathsetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceStatus)
+	out := new(RoutingpolicyAspathsetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineering) DeepCopyInto(out *NetworkinstanceTrafficEngineering) {
+func (in *RoutingpolicyAspathsetStatus) DeepCopyInto(out *RoutingpolicyAspathsetStatus) {
 	*out = *in
-	if in.AdminGroups != nil {
-		in, out := &in.AdminGroups, &out.AdminGroups
-		*out = new(NetworkinstanceTrafficEngineeringAdminGroups)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AutonomousSystem != nil {
-		in, out := &in.AutonomousSystem, &out.AutonomousSystem
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Interface != nil {
-		in, out := &in.Interface, &out.Interface
-		*out = make([]*NetworkinstanceTrafficEngineeringInterface, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceTrafficEngineeringInterface)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Ipv4TeRouterId != nil {
-		in, out := &in.Ipv4TeRouterId, &out.Ipv4TeRouterId
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ipv6TeRouterId != nil {
-		in, out := &in.Ipv6TeRouterId, &out.Ipv6TeRouterId
-		*out = new(string)
-		**out = **in
-	}
-	if in.SharedRiskLinkGroups != nil {
-		in, out := &in.SharedRiskLinkGroups, &out.SharedRiskLinkGroups
-		*out = new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroups)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineering.
-func (in *NetworkinstanceTrafficEngineering) DeepCopy() *NetworkinstanceTrafficEngineering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetStatus.
+func (in *RoutingpolicyAspathsetStatus) DeepCopy() *RoutingpolicyAspathsetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineering)
+	out := new(RoutingpolicyAspathsetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringAdminGroups) DeepCopyInto(out *NetworkinstanceTrafficEngineeringAdminGroups) {
+func (in *RoutingpolicyCommunityset) DeepCopyInto(out *RoutingpolicyCommunityset) {
 	*out = *in
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*NetworkinstanceTrafficEngineeringAdminGroupsGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceTrafficEngineeringAdminGroupsGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Member != nil {
+		in, out := &in.Member, &out.Member
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringAdminGroups.
-func (in *NetworkinstanceTrafficEngineeringAdminGroups) DeepCopy() *NetworkinstanceTrafficEngineeringAdminGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunityset.
+func (in *RoutingpolicyCommunityset) DeepCopy() *RoutingpolicyCommunityset {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineeringAdminGroups)
+	out := new(RoutingpolicyCommunityset)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringAdminGroupsGroup) DeepCopyInto(out *NetworkinstanceTrafficEngineeringAdminGroupsGroup) {
+func (in *RoutingpolicyCommunitysetObservation) DeepCopyInto(out *RoutingpolicyCommunitysetObservation) {
 	*out = *in
-	if in.BitPosition != nil {
-		in, out := &in.BitPosition, &out.BitPosition
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringAdminGroupsGroup.
-func (in *NetworkinstanceTrafficEngineeringAdminGroupsGroup) DeepCopy() *NetworkinstanceTrafficEngineeringAdminGroupsGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetObservation.
+func (in *RoutingpolicyCommunitysetObservation) DeepCopy() *RoutingpolicyCommunitysetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineeringAdminGroupsGroup)
+	out := new(RoutingpolicyCommunitysetObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringInterface) DeepCopyInto(out *NetworkinstanceTrafficEngineeringInterface) {
+func (in *RoutingpolicyCommunitysetParameters) DeepCopyInto(out *RoutingpolicyCommunitysetParameters) {
 	*out = *in
-	if in.AdminGroup != nil {
-		in, out := &in.AdminGroup, &out.AdminGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.Delay != nil {
-		in, out := &in.Delay, &out.Delay
-		*out = new(NetworkinstanceTrafficEngineeringInterfaceDelay)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.InterfaceName != nil {
-		in, out := &in.InterfaceName, &out.InterfaceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SrlgMembership != nil {
-		in, out := &in.SrlgMembership, &out.SrlgMembership
-		*out = new(string)
-		**out = **in
-	}
-	if in.TeMetric != nil {
-		in, out := &in.TeMetric, &out.TeMetric
-		*out = new(uint32)
-		**out = **in
+	if in.SrlRoutingpolicyCommunityset != nil {
+		in, out := &in.SrlRoutingpolicyCommunityset, &out.SrlRoutingpolicyCommunityset
+		*out = new(RoutingpolicyCommunityset)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringInterface.
-func (in *NetworkinstanceTrafficEngineeringInterface) DeepCopy() *NetworkinstanceTrafficEngineeringInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetParameters.
+func (in *RoutingpolicyCommunitysetParameters) DeepCopy() *RoutingpolicyCommunitysetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineeringInterface)
+	out := new(RoutingpolicyCommunitysetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringInterfaceDelay) DeepCopyInto(out *NetworkinstanceTrafficEngineeringInterfaceDelay) {
+func (in *RoutingpolicyCommunitysetSpec) DeepCopyInto(out *RoutingpolicyCommunitysetSpec) {
 	*out = *in
-	if in.Static != nil {
-		in, out := &in.Static, &out.Static
-		*out = new(uint32)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringInterfaceDelay.
-func (in *NetworkinstanceTrafficEngineeringInterfaceDelay) DeepCopy() *NetworkinstanceTrafficEngineeringInterfaceDelay {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetSpec.
+func (in *RoutingpolicyCommunitysetSpec) DeepCopy() *RoutingpolicyCommunitysetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineeringInterfaceDelay)
+	out := new(RoutingpolicyCommunitysetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups) DeepCopyInto(out *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups) {
+func (in *RoutingpolicyCommunitysetStatus) DeepCopyInto(out *RoutingpolicyCommunitysetStatus) {
 	*out = *in
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	if in.NetworkNodes != nil {
+		in, out := &in.NetworkNodes, &out.NetworkNodes
+		*out = make([]NetworkNodeSyncStatus, len(*in))
+		copy(*out, *in)
 	}
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringSharedRiskLinkGroups.
-func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups) DeepCopy() *NetworkinstanceTrafficEngineeringSharedRiskLinkGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetStatus.
+func (in *RoutingpolicyCommunitysetStatus) DeepCopy() *RoutingpolicyCommunitysetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroups)
+	out := new(RoutingpolicyCommunitysetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup) DeepCopyInto(out *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup) {
+func (in *RoutingpolicyPolicy) DeepCopyInto(out *RoutingpolicyPolicy) {
 	*out = *in
-	if in.Cost != nil {
-		in, out := &in.Cost, &out.Cost
-		*out = new(uint32)
-		**out = **in
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(RoutingpolicyPolicyDefaultAction)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.StaticMember != nil {
-		in, out := &in.StaticMember, &out.StaticMember
-		*out = make([]*NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember, len(*in))
+	if in.Statement != nil {
+		in, out := &in.Statement, &out.Statement
+		*out = make([]*RoutingpolicyPolicyStatement, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember)
+				*out = new(RoutingpolicyPolicyStatement)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup.
-func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup) DeepCopy() *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicy.
+func (in *RoutingpolicyPolicy) DeepCopy() *RoutingpolicyPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroup)
+	out := new(RoutingpolicyPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember) DeepCopyInto(out *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember) {
+func (in *RoutingpolicyPolicyDefaultAction) DeepCopyInto(out *RoutingpolicyPolicyDefaultAction) {
 	*out = *in
-	if in.FromAddress != nil {
-		in, out := &in.FromAddress, &out.FromAddress
-		*out = new(string)
-		**out = **in
+	if in.Accept != nil {
+		in, out := &in.Accept, &out.Accept
+		*out = new(RoutingpolicyPolicyDefaultActionAccept)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ToAddress != nil {
-		in, out := &in.ToAddress, &out.ToAddress
-		*out = new(string)
+	if in.NextEntry != nil {
+		in, out := &in.NextEntry, &out.NextEntry
+		*out = new(RoutingpolicyPolicyDefaultActionNextEntry)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember.
-func (in *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember) DeepCopy() *NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember {
-	if in == nil {
-		return nil
+	if in.NextPolicy != nil {
+		in, out := &in.NextPolicy, &out.NextPolicy
+		*out = new(RoutingpolicyPolicyDefaultActionNextPolicy)
+		**out = **in
 	}
-	out := new(NetworkinstanceTrafficEngineeringSharedRiskLinkGroupsGroupStaticMember)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkinstanceVxlanInterface) DeepCopyInto(out *NetworkinstanceVxlanInterface) {
-	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Reject != nil {
+		in, out := &in.Reject, &out.Reject
+		*out = new(RoutingpolicyPolicyDefaultActionReject)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkinstanceVxlanInterface.
-func (in *NetworkinstanceVxlanInterface) DeepCopy() *NetworkinstanceVxlanInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultAction.
+func (in *RoutingpolicyPolicyDefaultAction) DeepCopy() *RoutingpolicyPolicyDefaultAction {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkinstanceVxlanInterface)
+	out := new(RoutingpolicyPolicyDefaultAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Registration) DeepCopyInto(out *Registration) {
+func (in *RoutingpolicyPolicyDefaultActionAccept) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAccept) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Bgp != nil {
+		in, out := &in.Bgp, &out.Bgp
+		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgp)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registration.
-func (in *Registration) DeepCopy() *Registration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAccept.
+func (in *RoutingpolicyPolicyDefaultActionAccept) DeepCopy() *RoutingpolicyPolicyDefaultActionAccept {
 	if in == nil {
 		return nil
 	}
-	out := new(Registration)
+	out := new(RoutingpolicyPolicyDefaultActionAccept)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Registration) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationList) DeepCopyInto(out *RegistrationList) {
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgp) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgp) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Registration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AsPath != nil {
+		in, out := &in.AsPath, &out.AsPath
+		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPath)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationList.
-func (in *RegistrationList) DeepCopy() *RegistrationList {
-	if in == nil {
-		return nil
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpCommunities)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(RegistrationList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RegistrationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationObservation) DeepCopyInto(out *RegistrationObservation) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationObservation.
-func (in *RegistrationObservation) DeepCopy() *RegistrationObservation {
-	if in == nil {
-		return nil
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpOrigin)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(RegistrationObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationParameters) DeepCopyInto(out *RegistrationParameters) {
-	*out = *in
-	in.Register.DeepCopyInto(&out.Register)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationParameters.
-func (in *RegistrationParameters) DeepCopy() *RegistrationParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgp.
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgp) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgp {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationParameters)
+	out := new(RoutingpolicyPolicyDefaultActionAcceptBgp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationSpec) DeepCopyInto(out *RegistrationSpec) {
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.Prepend != nil {
+		in, out := &in.Prepend, &out.Prepend
+		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Replace != nil {
+		in, out := &in.Replace, &out.Replace
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSpec.
-func (in *RegistrationSpec) DeepCopy() *RegistrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpAsPath.
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationSpec)
+	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationStatus) DeepCopyInto(out *RegistrationStatus) {
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.AsNumber != nil {
+		in, out := &in.AsNumber, &out.AsNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepeatN != nil {
+		in, out := &in.RepeatN, &out.RepeatN
+		*out = new(uint8)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationStatus.
-func (in *RegistrationStatus) DeepCopy() *RegistrationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend.
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationStatus)
+	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyAspathset) DeepCopyInto(out *RoutingpolicyAspathset) {
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) {
 	*out = *in
-	if in.Expression != nil {
-		in, out := &in.Expression, &out.Expression
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replace != nil {
+		in, out := &in.Replace, &out.Replace
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathset.
-func (in *RoutingpolicyAspathset) DeepCopy() *RoutingpolicyAspathset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpCommunities.
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyAspathset)
+	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpCommunities)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyAspathsetObservation) DeepCopyInto(out *RoutingpolicyAspathsetObservation) {
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference) {
 	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetObservation.
-func (in *RoutingpolicyAspathsetObservation) DeepCopy() *RoutingpolicyAspathsetObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference.
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyAspathsetObservation)
+	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyAspathsetParameters) DeepCopyInto(out *RoutingpolicyAspathsetParameters) {
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin) {
 	*out = *in
-	if in.SrlRoutingpolicyAspathset != nil {
-		in, out := &in.SrlRoutingpolicyAspathset, &out.SrlRoutingpolicyAspathset
-		*out = new(RoutingpolicyAspathset)
-		(*in).DeepCopyInto(*out)
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetParameters.
-func (in *RoutingpolicyAspathsetParameters) DeepCopy() *RoutingpolicyAspathsetParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpOrigin.
+func (in *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyAspathsetParameters)
+	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpOrigin)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyAspathsetSpec) DeepCopyInto(out *RoutingpolicyAspathsetSpec) {
+func (in *RoutingpolicyPolicyDefaultActionNextEntry) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionNextEntry) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetSpec.
-func (in *RoutingpolicyAspathsetSpec) DeepCopy() *RoutingpolicyAspathsetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionNextEntry.
+func (in *RoutingpolicyPolicyDefaultActionNextEntry) DeepCopy() *RoutingpolicyPolicyDefaultActionNextEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyAspathsetSpec)
+	out := new(RoutingpolicyPolicyDefaultActionNextEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyAspathsetStatus) DeepCopyInto(out *RoutingpolicyAspathsetStatus) {
+func (in *RoutingpolicyPolicyDefaultActionNextPolicy) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionNextPolicy) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyAspathsetStatus.
-func (in *RoutingpolicyAspathsetStatus) DeepCopy() *RoutingpolicyAspathsetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionNextPolicy.
+func (in *RoutingpolicyPolicyDefaultActionNextPolicy) DeepCopy() *RoutingpolicyPolicyDefaultActionNextPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyAspathsetStatus)
+	out := new(RoutingpolicyPolicyDefaultActionNextPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyCommunityset) DeepCopyInto(out *RoutingpolicyCommunityset) {
+func (in *RoutingpolicyPolicyDefaultActionReject) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionReject) {
 	*out = *in
-	if in.Member != nil {
-		in, out := &in.Member, &out.Member
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunityset.
-func (in *RoutingpolicyCommunityset) DeepCopy() *RoutingpolicyCommunityset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionReject.
+func (in *RoutingpolicyPolicyDefaultActionReject) DeepCopy() *RoutingpolicyPolicyDefaultActionReject {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyCommunityset)
+	out := new(RoutingpolicyPolicyDefaultActionReject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyCommunitysetObservation) DeepCopyInto(out *RoutingpolicyCommunitysetObservation) {
+func (in *RoutingpolicyPolicyObservation) DeepCopyInto(out *RoutingpolicyPolicyObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetObservation.
-func (in *RoutingpolicyCommunitysetObservation) DeepCopy() *RoutingpolicyCommunitysetObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyObservation.
+func (in *RoutingpolicyPolicyObservation) DeepCopy() *RoutingpolicyPolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyCommunitysetObservation)
+	out := new(RoutingpolicyPolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyCommunitysetParameters) DeepCopyInto(out *RoutingpolicyCommunitysetParameters) {
+func (in *RoutingpolicyPolicyParameters) DeepCopyInto(out *RoutingpolicyPolicyParameters) {
 	*out = *in
-	if in.SrlRoutingpolicyCommunityset != nil {
-		in, out := &in.SrlRoutingpolicyCommunityset, &out.SrlRoutingpolicyCommunityset
-		*out = new(RoutingpolicyCommunityset)
+	if in.SrlRoutingpolicyPolicy != nil {
+		in, out := &in.SrlRoutingpolicyPolicy, &out.SrlRoutingpolicyPolicy
+		*out = new(RoutingpolicyPolicy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetParameters.
-func (in *RoutingpolicyCommunitysetParameters) DeepCopy() *RoutingpolicyCommunitysetParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyParameters.
+func (in *RoutingpolicyPolicyParameters) DeepCopy() *RoutingpolicyPolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyCommunitysetParameters)
+	out := new(RoutingpolicyPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyCommunitysetSpec) DeepCopyInto(out *RoutingpolicyCommunitysetSpec) {
+func (in *RoutingpolicyPolicySpec) DeepCopyInto(out *RoutingpolicyPolicySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetSpec.
-func (in *RoutingpolicyCommunitysetSpec) DeepCopy() *RoutingpolicyCommunitysetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicySpec.
+func (in *RoutingpolicyPolicySpec) DeepCopy() *RoutingpolicyPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyCommunitysetSpec)
+	out := new(RoutingpolicyPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyCommunitysetStatus) DeepCopyInto(out *RoutingpolicyCommunitysetStatus) {
+func (in *RoutingpolicyPolicyStatement) DeepCopyInto(out *RoutingpolicyPolicyStatement) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyCommunitysetStatus.
-func (in *RoutingpolicyCommunitysetStatus) DeepCopy() *RoutingpolicyCommunitysetStatus {
-	if in == nil {
-		return nil
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(RoutingpolicyPolicyStatementAction)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(RoutingpolicyCommunitysetStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicy) DeepCopyInto(out *RoutingpolicyPolicy) {
-	*out = *in
-	if in.DefaultAction != nil {
-		in, out := &in.DefaultAction, &out.DefaultAction
-		*out = new(RoutingpolicyPolicyDefaultAction)
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(RoutingpolicyPolicyStatementMatch)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.SequenceId != nil {
+		in, out := &in.SequenceId, &out.SequenceId
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Statement != nil {
-		in, out := &in.Statement, &out.Statement
-		*out = make([]*RoutingpolicyPolicyStatement, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RoutingpolicyPolicyStatement)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicy.
-func (in *RoutingpolicyPolicy) DeepCopy() *RoutingpolicyPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatement.
+func (in *RoutingpolicyPolicyStatement) DeepCopy() *RoutingpolicyPolicyStatement {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicy)
+	out := new(RoutingpolicyPolicyStatement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultAction) DeepCopyInto(out *RoutingpolicyPolicyDefaultAction) {
+func (in *RoutingpolicyPolicyStatementAction) DeepCopyInto(out *RoutingpolicyPolicyStatementAction) {
 	*out = *in
 	if in.Accept != nil {
 		in, out := &in.Accept, &out.Accept
-		*out = new(RoutingpolicyPolicyDefaultActionAccept)
+		*out = new(RoutingpolicyPolicyStatementActionAccept)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NextEntry != nil {
 		in, out := &in.NextEntry, &out.NextEntry
-		*out = new(RoutingpolicyPolicyDefaultActionNextEntry)
+		*out = new(RoutingpolicyPolicyStatementActionNextEntry)
 		**out = **in
 	}
 	if in.NextPolicy != nil {
 		in, out := &in.NextPolicy, &out.NextPolicy
-		*out = new(RoutingpolicyPolicyDefaultActionNextPolicy)
+		*out = new(RoutingpolicyPolicyStatementActionNextPolicy)
 		**out = **in
 	}
 	if in.Reject != nil {
 		in, out := &in.Reject, &out.Reject
-		*out = new(RoutingpolicyPolicyDefaultActionReject)
+		*out = new(RoutingpolicyPolicyStatementActionReject)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultAction.
-func (in *RoutingpolicyPolicyDefaultAction) DeepCopy() *RoutingpolicyPolicyDefaultAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementAction.
+func (in *RoutingpolicyPolicyStatementAction) DeepCopy() *RoutingpolicyPolicyStatementAction {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultAction)
+	out := new(RoutingpolicyPolicyStatementAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAccept) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAccept) {
+func (in *RoutingpolicyPolicyStatementActionAccept) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAccept) {
 	*out = *in
 	if in.Bgp != nil {
 		in, out := &in.Bgp, &out.Bgp
-		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgp)
+		*out = new(RoutingpolicyPolicyStatementActionAcceptBgp)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAccept.
-func (in *RoutingpolicyPolicyDefaultActionAccept) DeepCopy() *RoutingpolicyPolicyDefaultActionAccept {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAccept.
+func (in *RoutingpolicyPolicyStatementActionAccept) DeepCopy() *RoutingpolicyPolicyStatementActionAccept {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAccept)
+	out := new(RoutingpolicyPolicyStatementActionAccept)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgp) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgp) {
+func (in *RoutingpolicyPolicyStatementActionAcceptBgp) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgp) {
 	*out = *in
 	if in.AsPath != nil {
 		in, out := &in.AsPath, &out.AsPath
-		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPath)
+		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpAsPath)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Communities != nil {
 		in, out := &in.Communities, &out.Communities
-		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpCommunities)
+		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpCommunities)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LocalPreference != nil {
 		in, out := &in.LocalPreference, &out.LocalPreference
-		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference)
+		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Origin != nil {
 		in, out := &in.Origin, &out.Origin
-		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpOrigin)
+		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpOrigin)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgp.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgp) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgp.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgp) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgp {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAcceptBgp)
+	out := new(RoutingpolicyPolicyStatementActionAcceptBgp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) {
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPath) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpAsPath) {
 	*out = *in
 	if in.Prepend != nil {
 		in, out := &in.Prepend, &out.Prepend
-		*out = new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend)
+		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Remove != nil {
@@ -9916,18 +11133,18 @@ func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) DeepCopyInto(out *Rou
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpAsPath.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpAsPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpAsPath.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPath) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpAsPath {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPath)
+	out := new(RoutingpolicyPolicyStatementActionAcceptBgpAsPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) {
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend) {
 	*out = *in
 	if in.AsNumber != nil {
 		in, out := &in.AsNumber, &out.AsNumber
@@ -9941,18 +11158,18 @@ func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) DeepCopyInto(o
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpAsPathPrepend)
+	out := new(RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) {
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpCommunities) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpCommunities) {
 	*out = *in
 	if in.Add != nil {
 		in, out := &in.Add, &out.Add
@@ -9971,772 +11188,854 @@ func (in *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) DeepCopyInto(out
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpCommunities.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpCommunities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpCommunities.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpCommunities) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpCommunities {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpCommunities)
+	out := new(RoutingpolicyPolicyStatementActionAcceptBgpCommunities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference) {
+	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference) {
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpOrigin) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpOrigin) {
 	*out = *in
 	if in.Set != nil {
 		in, out := &in.Set, &out.Set
-		*out = new(uint32)
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpOrigin.
+func (in *RoutingpolicyPolicyStatementActionAcceptBgpOrigin) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpOrigin {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpLocalPreference)
+	out := new(RoutingpolicyPolicyStatementActionAcceptBgpOrigin)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin) {
+func (in *RoutingpolicyPolicyStatementActionNextEntry) DeepCopyInto(out *RoutingpolicyPolicyStatementActionNextEntry) {
 	*out = *in
-	if in.Set != nil {
-		in, out := &in.Set, &out.Set
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionAcceptBgpOrigin.
-func (in *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin) DeepCopy() *RoutingpolicyPolicyDefaultActionAcceptBgpOrigin {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionNextEntry.
+func (in *RoutingpolicyPolicyStatementActionNextEntry) DeepCopy() *RoutingpolicyPolicyStatementActionNextEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionAcceptBgpOrigin)
+	out := new(RoutingpolicyPolicyStatementActionNextEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionNextEntry) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionNextEntry) {
+func (in *RoutingpolicyPolicyStatementActionNextPolicy) DeepCopyInto(out *RoutingpolicyPolicyStatementActionNextPolicy) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionNextEntry.
-func (in *RoutingpolicyPolicyDefaultActionNextEntry) DeepCopy() *RoutingpolicyPolicyDefaultActionNextEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionNextPolicy.
+func (in *RoutingpolicyPolicyStatementActionNextPolicy) DeepCopy() *RoutingpolicyPolicyStatementActionNextPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionNextEntry)
+	out := new(RoutingpolicyPolicyStatementActionNextPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionNextPolicy) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionNextPolicy) {
+func (in *RoutingpolicyPolicyStatementActionReject) DeepCopyInto(out *RoutingpolicyPolicyStatementActionReject) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionNextPolicy.
-func (in *RoutingpolicyPolicyDefaultActionNextPolicy) DeepCopy() *RoutingpolicyPolicyDefaultActionNextPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionReject.
+func (in *RoutingpolicyPolicyStatementActionReject) DeepCopy() *RoutingpolicyPolicyStatementActionReject {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionNextPolicy)
+	out := new(RoutingpolicyPolicyStatementActionReject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyDefaultActionReject) DeepCopyInto(out *RoutingpolicyPolicyDefaultActionReject) {
+func (in *RoutingpolicyPolicyStatementMatch) DeepCopyInto(out *RoutingpolicyPolicyStatementMatch) {
 	*out = *in
+	if in.Bgp != nil {
+		in, out := &in.Bgp, &out.Bgp
+		*out = new(RoutingpolicyPolicyStatementMatchBgp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(string)
+		**out = **in
+	}
+	if in.Isis != nil {
+		in, out := &in.Isis, &out.Isis
+		*out = new(RoutingpolicyPolicyStatementMatchIsis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ospf != nil {
+		in, out := &in.Ospf, &out.Ospf
+		*out = new(RoutingpolicyPolicyStatementMatchOspf)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixSet != nil {
+		in, out := &in.PrefixSet, &out.PrefixSet
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyDefaultActionReject.
-func (in *RoutingpolicyPolicyDefaultActionReject) DeepCopy() *RoutingpolicyPolicyDefaultActionReject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatch.
+func (in *RoutingpolicyPolicyStatementMatch) DeepCopy() *RoutingpolicyPolicyStatementMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyDefaultActionReject)
+	out := new(RoutingpolicyPolicyStatementMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyObservation) DeepCopyInto(out *RoutingpolicyPolicyObservation) {
+func (in *RoutingpolicyPolicyStatementMatchBgp) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchBgp) {
 	*out = *in
+	if in.AsPathLength != nil {
+		in, out := &in.AsPathLength, &out.AsPathLength
+		*out = new(RoutingpolicyPolicyStatementMatchBgpAsPathLength)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AsPathSet != nil {
+		in, out := &in.AsPathSet, &out.AsPathSet
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommunitySet != nil {
+		in, out := &in.CommunitySet, &out.CommunitySet
+		*out = new(string)
+		**out = **in
+	}
+	if in.Evpn != nil {
+		in, out := &in.Evpn, &out.Evpn
+		*out = new(RoutingpolicyPolicyStatementMatchBgpEvpn)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyObservation.
-func (in *RoutingpolicyPolicyObservation) DeepCopy() *RoutingpolicyPolicyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchBgp.
+func (in *RoutingpolicyPolicyStatementMatchBgp) DeepCopy() *RoutingpolicyPolicyStatementMatchBgp {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyObservation)
+	out := new(RoutingpolicyPolicyStatementMatchBgp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyParameters) DeepCopyInto(out *RoutingpolicyPolicyParameters) {
+func (in *RoutingpolicyPolicyStatementMatchBgpAsPathLength) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchBgpAsPathLength) {
 	*out = *in
-	if in.SrlRoutingpolicyPolicy != nil {
-		in, out := &in.SrlRoutingpolicyPolicy, &out.SrlRoutingpolicyPolicy
-		*out = new(RoutingpolicyPolicy)
-		(*in).DeepCopyInto(*out)
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unique != nil {
+		in, out := &in.Unique, &out.Unique
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(uint8)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyParameters.
-func (in *RoutingpolicyPolicyParameters) DeepCopy() *RoutingpolicyPolicyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchBgpAsPathLength.
+func (in *RoutingpolicyPolicyStatementMatchBgpAsPathLength) DeepCopy() *RoutingpolicyPolicyStatementMatchBgpAsPathLength {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyParameters)
+	out := new(RoutingpolicyPolicyStatementMatchBgpAsPathLength)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicySpec) DeepCopyInto(out *RoutingpolicyPolicySpec) {
+func (in *RoutingpolicyPolicyStatementMatchBgpEvpn) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchBgpEvpn) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(uint8)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicySpec.
-func (in *RoutingpolicyPolicySpec) DeepCopy() *RoutingpolicyPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchBgpEvpn.
+func (in *RoutingpolicyPolicyStatementMatchBgpEvpn) DeepCopy() *RoutingpolicyPolicyStatementMatchBgpEvpn {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicySpec)
+	out := new(RoutingpolicyPolicyStatementMatchBgpEvpn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatement) DeepCopyInto(out *RoutingpolicyPolicyStatement) {
+func (in *RoutingpolicyPolicyStatementMatchIsis) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchIsis) {
 	*out = *in
-	if in.Action != nil {
-		in, out := &in.Action, &out.Action
-		*out = new(RoutingpolicyPolicyStatementAction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Match != nil {
-		in, out := &in.Match, &out.Match
-		*out = new(RoutingpolicyPolicyStatementMatch)
-		(*in).DeepCopyInto(*out)
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(uint8)
+		**out = **in
 	}
-	if in.SequenceId != nil {
-		in, out := &in.SequenceId, &out.SequenceId
-		*out = new(uint32)
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatement.
-func (in *RoutingpolicyPolicyStatement) DeepCopy() *RoutingpolicyPolicyStatement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchIsis.
+func (in *RoutingpolicyPolicyStatementMatchIsis) DeepCopy() *RoutingpolicyPolicyStatementMatchIsis {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatement)
+	out := new(RoutingpolicyPolicyStatementMatchIsis)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementAction) DeepCopyInto(out *RoutingpolicyPolicyStatementAction) {
+func (in *RoutingpolicyPolicyStatementMatchOspf) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchOspf) {
 	*out = *in
-	if in.Accept != nil {
-		in, out := &in.Accept, &out.Accept
-		*out = new(RoutingpolicyPolicyStatementActionAccept)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NextEntry != nil {
-		in, out := &in.NextEntry, &out.NextEntry
-		*out = new(RoutingpolicyPolicyStatementActionNextEntry)
+	if in.AreaId != nil {
+		in, out := &in.AreaId, &out.AreaId
+		*out = new(string)
 		**out = **in
 	}
-	if in.NextPolicy != nil {
-		in, out := &in.NextPolicy, &out.NextPolicy
-		*out = new(RoutingpolicyPolicyStatementActionNextPolicy)
+	if in.InstanceId != nil {
+		in, out := &in.InstanceId, &out.InstanceId
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Reject != nil {
-		in, out := &in.Reject, &out.Reject
-		*out = new(RoutingpolicyPolicyStatementActionReject)
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementAction.
-func (in *RoutingpolicyPolicyStatementAction) DeepCopy() *RoutingpolicyPolicyStatementAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchOspf.
+func (in *RoutingpolicyPolicyStatementMatchOspf) DeepCopy() *RoutingpolicyPolicyStatementMatchOspf {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementAction)
+	out := new(RoutingpolicyPolicyStatementMatchOspf)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAccept) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAccept) {
+func (in *RoutingpolicyPolicyStatus) DeepCopyInto(out *RoutingpolicyPolicyStatus) {
 	*out = *in
-	if in.Bgp != nil {
-		in, out := &in.Bgp, &out.Bgp
-		*out = new(RoutingpolicyPolicyStatementActionAcceptBgp)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAccept.
-func (in *RoutingpolicyPolicyStatementActionAccept) DeepCopy() *RoutingpolicyPolicyStatementActionAccept {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatus.
+func (in *RoutingpolicyPolicyStatus) DeepCopy() *RoutingpolicyPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAccept)
+	out := new(RoutingpolicyPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgp) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgp) {
+func (in *RoutingpolicyPrefixset) DeepCopyInto(out *RoutingpolicyPrefixset) {
 	*out = *in
-	if in.AsPath != nil {
-		in, out := &in.AsPath, &out.AsPath
-		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpAsPath)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Communities != nil {
-		in, out := &in.Communities, &out.Communities
-		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpCommunities)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LocalPreference != nil {
-		in, out := &in.LocalPreference, &out.LocalPreference
-		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference)
-		(*in).DeepCopyInto(*out)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
-	if in.Origin != nil {
-		in, out := &in.Origin, &out.Origin
-		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpOrigin)
-		(*in).DeepCopyInto(*out)
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = make([]*RoutingpolicyPrefixsetPrefix, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RoutingpolicyPrefixsetPrefix)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgp.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgp) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgp {
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixset.
+func (in *RoutingpolicyPrefixset) DeepCopy() *RoutingpolicyPrefixset {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAcceptBgp)
+	out := new(RoutingpolicyPrefixset)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPath) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpAsPath) {
+func (in *RoutingpolicyPrefixsetObservation) DeepCopyInto(out *RoutingpolicyPrefixsetObservation) {
 	*out = *in
-	if in.Prepend != nil {
-		in, out := &in.Prepend, &out.Prepend
-		*out = new(RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Remove != nil {
-		in, out := &in.Remove, &out.Remove
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Replace != nil {
-		in, out := &in.Replace, &out.Replace
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpAsPath.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPath) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpAsPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetObservation.
+func (in *RoutingpolicyPrefixsetObservation) DeepCopy() *RoutingpolicyPrefixsetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAcceptBgpAsPath)
+	out := new(RoutingpolicyPrefixsetObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend) {
+func (in *RoutingpolicyPrefixsetParameters) DeepCopyInto(out *RoutingpolicyPrefixsetParameters) {
 	*out = *in
-	if in.AsNumber != nil {
-		in, out := &in.AsNumber, &out.AsNumber
-		*out = new(string)
-		**out = **in
-	}
-	if in.RepeatN != nil {
-		in, out := &in.RepeatN, &out.RepeatN
-		*out = new(uint8)
-		**out = **in
+	if in.SrlRoutingpolicyPrefixset != nil {
+		in, out := &in.SrlRoutingpolicyPrefixset, &out.SrlRoutingpolicyPrefixset
+		*out = new(RoutingpolicyPrefixset)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetParameters.
+func (in *RoutingpolicyPrefixsetParameters) DeepCopy() *RoutingpolicyPrefixsetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAcceptBgpAsPathPrepend)
+	out := new(RoutingpolicyPrefixsetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpCommunities) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpCommunities) {
+func (in *RoutingpolicyPrefixsetPrefix) DeepCopyInto(out *RoutingpolicyPrefixsetPrefix) {
 	*out = *in
-	if in.Add != nil {
-		in, out := &in.Add, &out.Add
-		*out = new(string)
-		**out = **in
-	}
-	if in.Remove != nil {
-		in, out := &in.Remove, &out.Remove
+	if in.IpPrefix != nil {
+		in, out := &in.IpPrefix, &out.IpPrefix
 		*out = new(string)
 		**out = **in
 	}
-	if in.Replace != nil {
-		in, out := &in.Replace, &out.Replace
+	if in.MaskLengthRange != nil {
+		in, out := &in.MaskLengthRange, &out.MaskLengthRange
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpCommunities.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpCommunities) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpCommunities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetPrefix.
+func (in *RoutingpolicyPrefixsetPrefix) DeepCopy() *RoutingpolicyPrefixsetPrefix {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAcceptBgpCommunities)
+	out := new(RoutingpolicyPrefixsetPrefix)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference) {
+func (in *RoutingpolicyPrefixsetSpec) DeepCopyInto(out *RoutingpolicyPrefixsetSpec) {
 	*out = *in
-	if in.Set != nil {
-		in, out := &in.Set, &out.Set
-		*out = new(uint32)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetSpec.
+func (in *RoutingpolicyPrefixsetSpec) DeepCopy() *RoutingpolicyPrefixsetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAcceptBgpLocalPreference)
+	out := new(RoutingpolicyPrefixsetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpOrigin) DeepCopyInto(out *RoutingpolicyPolicyStatementActionAcceptBgpOrigin) {
+func (in *RoutingpolicyPrefixsetStatus) DeepCopyInto(out *RoutingpolicyPrefixsetStatus) {
 	*out = *in
-	if in.Set != nil {
-		in, out := &in.Set, &out.Set
-		*out = new(string)
-		**out = **in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
+	if in.NetworkNodes != nil {
+		in, out := &in.NetworkNodes, &out.NetworkNodes
+		*out = make([]NetworkNodeSyncStatus, len(*in))
+		copy(*out, *in)
 	}
+	out.AppliedStatus = in.AppliedStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionAcceptBgpOrigin.
-func (in *RoutingpolicyPolicyStatementActionAcceptBgpOrigin) DeepCopy() *RoutingpolicyPolicyStatementActionAcceptBgpOrigin {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetStatus.
+func (in *RoutingpolicyPrefixsetStatus) DeepCopy() *RoutingpolicyPrefixsetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionAcceptBgpOrigin)
+	out := new(RoutingpolicyPrefixsetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionNextEntry) DeepCopyInto(out *RoutingpolicyPolicyStatementActionNextEntry) {
+func (in *SrlAsnPool) DeepCopyInto(out *SrlAsnPool) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionNextEntry.
-func (in *RoutingpolicyPolicyStatementActionNextEntry) DeepCopy() *RoutingpolicyPolicyStatementActionNextEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlAsnPool.
+func (in *SrlAsnPool) DeepCopy() *SrlAsnPool {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionNextEntry)
+	out := new(SrlAsnPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionNextPolicy) DeepCopyInto(out *RoutingpolicyPolicyStatementActionNextPolicy) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionNextPolicy.
-func (in *RoutingpolicyPolicyStatementActionNextPolicy) DeepCopy() *RoutingpolicyPolicyStatementActionNextPolicy {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlAsnPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(RoutingpolicyPolicyStatementActionNextPolicy)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementActionReject) DeepCopyInto(out *RoutingpolicyPolicyStatementActionReject) {
+func (in *SrlAsnPoolList) DeepCopyInto(out *SrlAsnPoolList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlAsnPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementActionReject.
-func (in *RoutingpolicyPolicyStatementActionReject) DeepCopy() *RoutingpolicyPolicyStatementActionReject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlAsnPoolList.
+func (in *SrlAsnPoolList) DeepCopy() *SrlAsnPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementActionReject)
+	out := new(SrlAsnPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlAsnPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementMatch) DeepCopyInto(out *RoutingpolicyPolicyStatementMatch) {
+func (in *SrlBfd) DeepCopyInto(out *SrlBfd) {
 	*out = *in
-	if in.Bgp != nil {
-		in, out := &in.Bgp, &out.Bgp
-		*out = new(RoutingpolicyPolicyStatementMatchBgp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Family != nil {
-		in, out := &in.Family, &out.Family
-		*out = new(string)
-		**out = **in
-	}
-	if in.Isis != nil {
-		in, out := &in.Isis, &out.Isis
-		*out = new(RoutingpolicyPolicyStatementMatchIsis)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ospf != nil {
-		in, out := &in.Ospf, &out.Ospf
-		*out = new(RoutingpolicyPolicyStatementMatchOspf)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PrefixSet != nil {
-		in, out := &in.PrefixSet, &out.PrefixSet
-		*out = new(string)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatch.
-func (in *RoutingpolicyPolicyStatementMatch) DeepCopy() *RoutingpolicyPolicyStatementMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlBfd.
+func (in *SrlBfd) DeepCopy() *SrlBfd {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementMatch)
+	out := new(SrlBfd)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlBfd) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementMatchBgp) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchBgp) {
+func (in *SrlBfdList) DeepCopyInto(out *SrlBfdList) {
 	*out = *in
-	if in.AsPathLength != nil {
-		in, out := &in.AsPathLength, &out.AsPathLength
-		*out = new(RoutingpolicyPolicyStatementMatchBgpAsPathLength)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AsPathSet != nil {
-		in, out := &in.AsPathSet, &out.AsPathSet
-		*out = new(string)
-		**out = **in
-	}
-	if in.CommunitySet != nil {
-		in, out := &in.CommunitySet, &out.CommunitySet
-		*out = new(string)
-		**out = **in
-	}
-	if in.Evpn != nil {
-		in, out := &in.Evpn, &out.Evpn
-		*out = new(RoutingpolicyPolicyStatementMatchBgpEvpn)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlBfd, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchBgp.
-func (in *RoutingpolicyPolicyStatementMatchBgp) DeepCopy() *RoutingpolicyPolicyStatementMatchBgp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlBfdList.
+func (in *SrlBfdList) DeepCopy() *SrlBfdList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementMatchBgp)
+	out := new(SrlBfdList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlBfdList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementMatchBgpAsPathLength) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchBgpAsPathLength) {
+func (in *SrlChangeRecord) DeepCopyInto(out *SrlChangeRecord) {
 	*out = *in
-	if in.Operator != nil {
-		in, out := &in.Operator, &out.Operator
-		*out = new(string)
-		**out = **in
-	}
-	if in.Unique != nil {
-		in, out := &in.Unique, &out.Unique
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(uint8)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchBgpAsPathLength.
-func (in *RoutingpolicyPolicyStatementMatchBgpAsPathLength) DeepCopy() *RoutingpolicyPolicyStatementMatchBgpAsPathLength {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlChangeRecord.
+func (in *SrlChangeRecord) DeepCopy() *SrlChangeRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementMatchBgpAsPathLength)
+	out := new(SrlChangeRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlChangeRecord) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementMatchBgpEvpn) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchBgpEvpn) {
+func (in *SrlChangeRecordList) DeepCopyInto(out *SrlChangeRecordList) {
 	*out = *in
-	if in.RouteType != nil {
-		in, out := &in.RouteType, &out.RouteType
-		*out = new(uint8)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlChangeRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchBgpEvpn.
-func (in *RoutingpolicyPolicyStatementMatchBgpEvpn) DeepCopy() *RoutingpolicyPolicyStatementMatchBgpEvpn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlChangeRecordList.
+func (in *SrlChangeRecordList) DeepCopy() *SrlChangeRecordList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementMatchBgpEvpn)
+	out := new(SrlChangeRecordList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlChangeRecordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementMatchIsis) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchIsis) {
+func (in *SrlConfigTemplate) DeepCopyInto(out *SrlConfigTemplate) {
 	*out = *in
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = new(uint8)
-		**out = **in
-	}
-	if in.RouteType != nil {
-		in, out := &in.RouteType, &out.RouteType
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchIsis.
-func (in *RoutingpolicyPolicyStatementMatchIsis) DeepCopy() *RoutingpolicyPolicyStatementMatchIsis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlConfigTemplate.
+func (in *SrlConfigTemplate) DeepCopy() *SrlConfigTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementMatchIsis)
+	out := new(SrlConfigTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlConfigTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatementMatchOspf) DeepCopyInto(out *RoutingpolicyPolicyStatementMatchOspf) {
+func (in *SrlConfigTemplateList) DeepCopyInto(out *SrlConfigTemplateList) {
 	*out = *in
-	if in.AreaId != nil {
-		in, out := &in.AreaId, &out.AreaId
-		*out = new(string)
-		**out = **in
-	}
-	if in.InstanceId != nil {
-		in, out := &in.InstanceId, &out.InstanceId
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RouteType != nil {
-		in, out := &in.RouteType, &out.RouteType
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlConfigTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatementMatchOspf.
-func (in *RoutingpolicyPolicyStatementMatchOspf) DeepCopy() *RoutingpolicyPolicyStatementMatchOspf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlConfigTemplateList.
+func (in *SrlConfigTemplateList) DeepCopy() *SrlConfigTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatementMatchOspf)
+	out := new(SrlConfigTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlConfigTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPolicyStatus) DeepCopyInto(out *RoutingpolicyPolicyStatus) {
+func (in *SrlEvpnL2Service) DeepCopyInto(out *SrlEvpnL2Service) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPolicyStatus.
-func (in *RoutingpolicyPolicyStatus) DeepCopy() *RoutingpolicyPolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlEvpnL2Service.
+func (in *SrlEvpnL2Service) DeepCopy() *SrlEvpnL2Service {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPolicyStatus)
+	out := new(SrlEvpnL2Service)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlEvpnL2Service) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPrefixset) DeepCopyInto(out *RoutingpolicyPrefixset) {
+func (in *SrlEvpnL2ServiceList) DeepCopyInto(out *SrlEvpnL2ServiceList) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = make([]*RoutingpolicyPrefixsetPrefix, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlEvpnL2Service, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RoutingpolicyPrefixsetPrefix)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixset.
-func (in *RoutingpolicyPrefixset) DeepCopy() *RoutingpolicyPrefixset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlEvpnL2ServiceList.
+func (in *SrlEvpnL2ServiceList) DeepCopy() *SrlEvpnL2ServiceList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPrefixset)
+	out := new(SrlEvpnL2ServiceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPrefixsetObservation) DeepCopyInto(out *RoutingpolicyPrefixsetObservation) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetObservation.
-func (in *RoutingpolicyPrefixsetObservation) DeepCopy() *RoutingpolicyPrefixsetObservation {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlEvpnL2ServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(RoutingpolicyPrefixsetObservation)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPrefixsetParameters) DeepCopyInto(out *RoutingpolicyPrefixsetParameters) {
+func (in *SrlEvpnL3Service) DeepCopyInto(out *SrlEvpnL3Service) {
 	*out = *in
-	if in.SrlRoutingpolicyPrefixset != nil {
-		in, out := &in.SrlRoutingpolicyPrefixset, &out.SrlRoutingpolicyPrefixset
-		*out = new(RoutingpolicyPrefixset)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetParameters.
-func (in *RoutingpolicyPrefixsetParameters) DeepCopy() *RoutingpolicyPrefixsetParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlEvpnL3Service.
+func (in *SrlEvpnL3Service) DeepCopy() *SrlEvpnL3Service {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPrefixsetParameters)
+	out := new(SrlEvpnL3Service)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlEvpnL3Service) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPrefixsetPrefix) DeepCopyInto(out *RoutingpolicyPrefixsetPrefix) {
+func (in *SrlEvpnL3ServiceList) DeepCopyInto(out *SrlEvpnL3ServiceList) {
 	*out = *in
-	if in.IpPrefix != nil {
-		in, out := &in.IpPrefix, &out.IpPrefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaskLengthRange != nil {
-		in, out := &in.MaskLengthRange, &out.MaskLengthRange
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlEvpnL3Service, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetPrefix.
-func (in *RoutingpolicyPrefixsetPrefix) DeepCopy() *RoutingpolicyPrefixsetPrefix {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlEvpnL3ServiceList.
+func (in *SrlEvpnL3ServiceList) DeepCopy() *SrlEvpnL3ServiceList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPrefixsetPrefix)
+	out := new(SrlEvpnL3ServiceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlEvpnL3ServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPrefixsetSpec) DeepCopyInto(out *RoutingpolicyPrefixsetSpec) {
+func (in *SrlFabric) DeepCopyInto(out *SrlFabric) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetSpec.
-func (in *RoutingpolicyPrefixsetSpec) DeepCopy() *RoutingpolicyPrefixsetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlFabric.
+func (in *SrlFabric) DeepCopy() *SrlFabric {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPrefixsetSpec)
+	out := new(SrlFabric)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlFabric) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingpolicyPrefixsetStatus) DeepCopyInto(out *RoutingpolicyPrefixsetStatus) {
+func (in *SrlFabricList) DeepCopyInto(out *SrlFabricList) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlFabric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingpolicyPrefixsetStatus.
-func (in *RoutingpolicyPrefixsetStatus) DeepCopy() *RoutingpolicyPrefixsetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlFabricList.
+func (in *SrlFabricList) DeepCopy() *SrlFabricList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingpolicyPrefixsetStatus)
+	out := new(SrlFabricList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlFabricList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrlBfd) DeepCopyInto(out *SrlBfd) {
+func (in *SrlInterface) DeepCopyInto(out *SrlInterface) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -10744,18 +12043,18 @@ func (in *SrlBfd) DeepCopyInto(out *SrlBfd) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlBfd.
-func (in *SrlBfd) DeepCopy() *SrlBfd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterface.
+func (in *SrlInterface) DeepCopy() *SrlInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(SrlBfd)
+	out := new(SrlInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrlBfd) DeepCopyObject() runtime.Object {
+func (in *SrlInterface) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -10763,31 +12062,31 @@ func (in *SrlBfd) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrlBfdList) DeepCopyInto(out *SrlBfdList) {
+func (in *SrlInterfaceList) DeepCopyInto(out *SrlInterfaceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SrlBfd, len(*in))
+		*out = make([]SrlInterface, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlBfdList.
-func (in *SrlBfdList) DeepCopy() *SrlBfdList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterfaceList.
+func (in *SrlInterfaceList) DeepCopy() *SrlInterfaceList {
 	if in == nil {
 		return nil
 	}
-	out := new(SrlBfdList)
+	out := new(SrlInterfaceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrlBfdList) DeepCopyObject() runtime.Object {
+func (in *SrlInterfaceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -10795,7 +12094,7 @@ func (in *SrlBfdList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrlInterface) DeepCopyInto(out *SrlInterface) {
+func (in *SrlInterfaceSubinterface) DeepCopyInto(out *SrlInterfaceSubinterface) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -10803,18 +12102,18 @@ func (in *SrlInterface) DeepCopyInto(out *SrlInterface) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterface.
-func (in *SrlInterface) DeepCopy() *SrlInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterfaceSubinterface.
+func (in *SrlInterfaceSubinterface) DeepCopy() *SrlInterfaceSubinterface {
 	if in == nil {
 		return nil
 	}
-	out := new(SrlInterface)
+	out := new(SrlInterfaceSubinterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrlInterface) DeepCopyObject() runtime.Object {
+func (in *SrlInterfaceSubinterface) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -10822,31 +12121,31 @@ func (in *SrlInterface) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrlInterfaceList) DeepCopyInto(out *SrlInterfaceList) {
+func (in *SrlInterfaceSubinterfaceList) DeepCopyInto(out *SrlInterfaceSubinterfaceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SrlInterface, len(*in))
+		*out = make([]SrlInterfaceSubinterface, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterfaceList.
-func (in *SrlInterfaceList) DeepCopy() *SrlInterfaceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterfaceSubinterfaceList.
+func (in *SrlInterfaceSubinterfaceList) DeepCopy() *SrlInterfaceSubinterfaceList {
 	if in == nil {
 		return nil
 	}
-	out := new(SrlInterfaceList)
+	out := new(SrlInterfaceSubinterfaceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrlInterfaceList) DeepCopyObject() runtime.Object {
+func (in *SrlInterfaceSubinterfaceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -10854,26 +12153,26 @@ func (in *SrlInterfaceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrlInterfaceSubinterface) DeepCopyInto(out *SrlInterfaceSubinterface) {
+func (in *SrlIpPool) DeepCopyInto(out *SrlIpPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterfaceSubinterface.
-func (in *SrlInterfaceSubinterface) DeepCopy() *SrlInterfaceSubinterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlIpPool.
+func (in *SrlIpPool) DeepCopy() *SrlIpPool {
 	if in == nil {
 		return nil
 	}
-	out := new(SrlInterfaceSubinterface)
+	out := new(SrlIpPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrlInterfaceSubinterface) DeepCopyObject() runtime.Object {
+func (in *SrlIpPool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -10881,31 +12180,31 @@ func (in *SrlInterfaceSubinterface) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrlInterfaceSubinterfaceList) DeepCopyInto(out *SrlInterfaceSubinterfaceList) {
+func (in *SrlIpPoolList) DeepCopyInto(out *SrlIpPoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SrlInterfaceSubinterface, len(*in))
+		*out = make([]SrlIpPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlInterfaceSubinterfaceList.
-func (in *SrlInterfaceSubinterfaceList) DeepCopy() *SrlInterfaceSubinterfaceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlIpPoolList.
+func (in *SrlIpPoolList) DeepCopy() *SrlIpPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(SrlInterfaceSubinterfaceList)
+	out := new(SrlIpPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrlInterfaceSubinterfaceList) DeepCopyObject() runtime.Object {
+func (in *SrlIpPoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -11502,6 +12801,65 @@ func (in *SrlNetworkinstanceStaticroutesList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrlProviderConfig) DeepCopyInto(out *SrlProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlProviderConfig.
+func (in *SrlProviderConfig) DeepCopy() *SrlProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SrlProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrlProviderConfigList) DeepCopyInto(out *SrlProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlProviderConfigList.
+func (in *SrlProviderConfigList) DeepCopy() *SrlProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(SrlProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SrlRoutingpolicyAspathset) DeepCopyInto(out *SrlRoutingpolicyAspathset) {
 	*out = *in
@@ -12151,6 +13509,65 @@ func (in *SrlSystemNtpList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrlTelemetryProfile) DeepCopyInto(out *SrlTelemetryProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlTelemetryProfile.
+func (in *SrlTelemetryProfile) DeepCopy() *SrlTelemetryProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SrlTelemetryProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlTelemetryProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrlTelemetryProfileList) DeepCopyInto(out *SrlTelemetryProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlTelemetryProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlTelemetryProfileList.
+func (in *SrlTelemetryProfileList) DeepCopy() *SrlTelemetryProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(SrlTelemetryProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlTelemetryProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SrlTunnelinterface) DeepCopyInto(out *SrlTunnelinterface) {
 	*out = *in
@@ -12269,6 +13686,65 @@ func (in *SrlTunnelinterfaceVxlaninterfaceList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrlVniPool) DeepCopyInto(out *SrlVniPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlVniPool.
+func (in *SrlVniPool) DeepCopy() *SrlVniPool {
+	if in == nil {
+		return nil
+	}
+	out := new(SrlVniPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlVniPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrlVniPoolList) DeepCopyInto(out *SrlVniPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrlVniPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrlVniPoolList.
+func (in *SrlVniPoolList) DeepCopy() *SrlVniPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(SrlVniPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrlVniPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemMtu) DeepCopyInto(out *SystemMtu) {
 	*out = *in
@@ -12361,6 +13837,7 @@ func (in *SystemMtuStatus) DeepCopyInto(out *SystemMtuStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemMtuStatus.
@@ -12438,6 +13915,11 @@ func (in *SystemNameSpec) DeepCopyInto(out *SystemNameSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNameSpec.
@@ -12455,6 +13937,12 @@ func (in *SystemNameStatus) DeepCopyInto(out *SystemNameStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	if in.NetworkNodes != nil {
+		in, out := &in.NetworkNodes, &out.NetworkNodes
+		*out = make([]NetworkNodeSyncStatus, len(*in))
+		copy(*out, *in)
+	}
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNameStatus.
@@ -12610,6 +14098,7 @@ func (in *SystemNetworkinstanceProtocolsBgpvpnStatus) DeepCopyInto(out *SystemNe
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNetworkinstanceProtocolsBgpvpnStatus.
@@ -12989,6 +14478,7 @@ func (in *SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiStatus) DeepCopyIn
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiStatus.
@@ -13058,6 +14548,7 @@ func (in *SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceStatus) DeepCopyInto(
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceStatus.
@@ -13172,6 +14663,7 @@ func (in *SystemNetworkinstanceProtocolsEvpnStatus) DeepCopyInto(out *SystemNetw
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNetworkinstanceProtocolsEvpnStatus.
@@ -13290,6 +14782,11 @@ func (in *SystemNtpSpec) DeepCopyInto(out *SystemNtpSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNtpSpec.
@@ -13307,6 +14804,12 @@ func (in *SystemNtpStatus) DeepCopyInto(out *SystemNtpStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	if in.NetworkNodes != nil {
+		in, out := &in.NetworkNodes, &out.NetworkNodes
+		*out = make([]NetworkNodeSyncStatus, len(*in))
+		copy(*out, *in)
+	}
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemNtpStatus.
@@ -13319,6 +14822,120 @@ func (in *SystemNtpStatus) DeepCopy() *SystemNtpStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetryExport) DeepCopyInto(out *TelemetryExport) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryExport.
+func (in *TelemetryExport) DeepCopy() *TelemetryExport {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetryExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetryProfileSpec) DeepCopyInto(out *TelemetryProfileSpec) {
+	*out = *in
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]TelemetrySubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Export.DeepCopyInto(&out.Export)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryProfileSpec.
+func (in *TelemetryProfileSpec) DeepCopy() *TelemetryProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetryProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetryProfileStatus) DeepCopyInto(out *TelemetryProfileStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.NetworkNodes != nil {
+		in, out := &in.NetworkNodes, &out.NetworkNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryProfileStatus.
+func (in *TelemetryProfileStatus) DeepCopy() *TelemetryProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetryProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetrySubscription) DeepCopyInto(out *TelemetrySubscription) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SampleInterval != nil {
+		in, out := &in.SampleInterval, &out.SampleInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HeartbeatInterval != nil {
+		in, out := &in.HeartbeatInterval, &out.HeartbeatInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SuppressRedundant != nil {
+		in, out := &in.SuppressRedundant, &out.SuppressRedundant
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetrySubscription.
+func (in *TelemetrySubscription) DeepCopy() *TelemetrySubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetrySubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tunnelinterface) DeepCopyInto(out *Tunnelinterface) {
 	*out = *in
@@ -13396,6 +15013,7 @@ func (in *TunnelinterfaceStatus) DeepCopyInto(out *TunnelinterfaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelinterfaceStatus.
@@ -13717,6 +15335,7 @@ func (in *TunnelinterfaceVxlaninterfaceStatus) DeepCopyInto(out *Tunnelinterface
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtNetworkNode = in.AtNetworkNode
+	out.AppliedStatus = in.AppliedStatus
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelinterfaceVxlaninterfaceStatus.
@@ -13728,3 +15347,18 @@ func (in *TunnelinterfaceVxlaninterfaceStatus) DeepCopy() *TunnelinterfaceVxlani
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VniPoolSpec) DeepCopyInto(out *VniPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VniPoolSpec.
+func (in *VniPoolSpec) DeepCopy() *VniPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VniPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,27 +17,18 @@ limitations under the License.
 package provider
 
 import (
-	"context"
-	"fmt"
 	"os"
-	"time"
 
 	"github.com/spf13/cobra"
 
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/log/zap"
-
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
 	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
-	"github.com/yndd/ndd-runtime/pkg/logging"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
-	"github.com/yndd/ndd-provider-srl/internal/initializer"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -63,7 +54,6 @@ func Execute() {
 }
 
 func init() {
-	zlog := zap.New(zap.JSONEncoder())
 	rootCmd.SilenceUsage = true
 	rootCmd.PersistentFlags().BoolVarP(&debug, "debug", "d", false, "enable debug mode")
 
@@ -72,25 +62,4 @@ func init() {
 	utilruntime.Must(ndrv1.AddToScheme(scheme))
 	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
-
-	cfg, err := ctrl.GetConfig()
-	if err != nil {
-		fmt.Printf("cannot get config %s\n", err)
-	}
-
-	cl, err := client.New(cfg, client.Options{Scheme: scheme})
-	if err != nil {
-		fmt.Printf("cannot create new kubernetes client %s\n", err)
-	}
-
-	i := initializer.New(cl,
-		initializer.NewCRDWaiter([]string{
-			fmt.Sprintf("%s.%s", "registrations", srlv1.Group),
-		}, time.Minute, time.Second, logging.NewLogrLogger(zlog.WithName("nddrbacinit"))),
-		initializer.NewRegistrationObject(),
-	)
-	if err := i.Init(context.TODO()); err != nil {
-		fmt.Printf("cannot initialize provider %s\n", err)
-	}
-	fmt.Printf("Initialization has been completed\n")
 }
@@ -17,13 +17,17 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"os"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -31,8 +35,14 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/ratelimiter"
 
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/audit"
 	"github.com/yndd/ndd-provider-srl/internal/collector"
 	"github.com/yndd/ndd-provider-srl/internal/controllers"
+	"github.com/yndd/ndd-provider-srl/internal/controllers/srl"
+	"github.com/yndd/ndd-provider-srl/internal/initializer"
+	"github.com/yndd/ndd-provider-srl/internal/shard"
+	"github.com/yndd/ndd-provider-srl/internal/tracing"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -45,6 +55,30 @@ var (
 	pollInterval         time.Duration
 	namespace            string
 	podname              string
+	enableWebhooks       bool
+	subMode              string
+	subSampleInterval    time.Duration
+	subHeartbeatInterval time.Duration
+	subSuppressRedundant bool
+	subEncoding          string
+	initTimeout          time.Duration
+	initPollInterval     time.Duration
+	nodeQPS              float64
+	nodeBurst            int
+	nodeMaxInFlight      int
+	configCacheMaxAge    time.Duration
+	sharding             bool
+	shardLeaseDuration   time.Duration
+	shardRenewInterval   time.Duration
+	tracingExporter      string
+	tracingEndpoint      string
+	tracingInsecure      bool
+	tracingFile          string
+	tracingSampleRatio   float64
+	auditSink            string
+	auditFile            string
+	auditRecordLimit     int
+	auditSecretLeafs     []string
 )
 
 // startCmd represents the start command for the network device driver
@@ -60,8 +94,43 @@ var startCmd = &cobra.Command{
 			// Only use a logr.Logger when debug is on
 			ctrl.SetLogger(zlog)
 		}
+		if sharding && enableLeaderElection {
+			return errors.New("Cannot enable sharding together with leader election")
+		}
+		if sharding && namespace == "" {
+			return errors.New("Cannot enable sharding without a namespace")
+		}
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			return errors.Wrap(err, "Cannot get config")
+		}
+
+		shutdownTracing, err := tracing.Setup(cmd.Context(), tracing.Options{
+			Exporter:    tracingExporter,
+			Endpoint:    tracingEndpoint,
+			Insecure:    tracingInsecure,
+			Path:        tracingFile,
+			SampleRatio: tracingSampleRatio,
+		})
+		if err != nil {
+			return errors.Wrap(err, "Cannot setup tracing")
+		}
+		defer func() {
+			// flush the spans that are not exported yet
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				zlog.Error(err, "Cannot shutdown tracing")
+			}
+		}()
+
+		zlog.Info("initialize provider")
+		if err := initialize(cmd.Context(), cfg, logging.NewLogrLogger(zlog.WithName("init"))); err != nil {
+			return errors.Wrap(err, "Cannot initialize provider")
+		}
+
 		zlog.Info("create manager")
-		mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 			Scheme:                 scheme,
 			MetricsBindAddress:     metricsAddr,
 			Port:                   9443,
@@ -73,23 +142,120 @@ var startCmd = &cobra.Command{
 			return errors.Wrap(err, "Cannot create manager")
 		}
 
+		// sharder shards the network nodes across the provider replicas, without
+		// sharding the replica reconciles all network nodes
+		var sharder *shard.Sharder
+		if sharding {
+			identity := podname
+			if identity == "" {
+				if identity, err = os.Hostname(); err != nil {
+					return errors.Wrap(err, "Cannot get shard identity")
+				}
+			}
+			sharder = shard.NewSharder(mgr.GetClient(), mgr.GetAPIReader(), "ndd-provider-srl", namespace, identity,
+				shard.WithLogger(logging.NewLogrLogger(zlog.WithName("shard"))),
+				shard.WithLeaseDuration(shardLeaseDuration),
+				shard.WithRenewInterval(shardRenewInterval),
+			)
+			if err := mgr.Add(sharder); err != nil {
+				return errors.Wrap(err, "Cannot add sharder to manager")
+			}
+		}
+
+		// recorder records the set requests pushed to the network nodes in the
+		// audit trail, without a sink nothing is recorded
+		var recorder *audit.Recorder
+		switch auditSink {
+		case "", audit.SinkNone:
+		case audit.SinkFile:
+			s, err := audit.NewFileSink(auditFile)
+			if err != nil {
+				return errors.Wrap(err, "Cannot create audit sink")
+			}
+			recorder = audit.NewRecorder(s, logging.NewLogrLogger(zlog.WithName("audit")), audit.WithSecretLeafs(auditSecretLeafs))
+		case audit.SinkRecord:
+			recorder = audit.NewRecorder(audit.NewRecordSink(mgr.GetClient(), auditRecordLimit), logging.NewLogrLogger(zlog.WithName("audit")), audit.WithSecretLeafs(auditSecretLeafs))
+		default:
+			return errors.Errorf("Unknown audit sink %s", auditSink)
+		}
+
+		// the concurrency of the default provider config overrides the flag, it
+		// is only read here such that a change requires a restart, the other
+		// settings of the provider config are applied while running
+		pc := &srlv1.SrlProviderConfig{}
+		if err := mgr.GetAPIReader().Get(cmd.Context(), client.ObjectKey{Name: srlv1.DefaultProviderConfigName}, pc); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return errors.Wrap(err, "Cannot get default provider config")
+			}
+		} else if pc.Spec.Concurrency != nil {
+			concurrency = *pc.Spec.Concurrency
+		}
+		// the provider configs are loaded before the controllers start, such
+		// that the first reconciles connect with their settings and secrets
+		if err := srl.LoadProviderConfigs(cmd.Context(), mgr.GetAPIReader(), logging.NewLogrLogger(zlog.WithName("srl"))); err != nil {
+			return errors.Wrap(err, "Cannot load provider configs")
+		}
+
 		//tuChan is the communication channel by which gnmi subscriptions to the device driver are handled
 		tuChan := make(chan collector.TargetUpdate)
+		// subStatus holds the state of the gnmi subscriptions, which is reported by the registration
+		subStatus := collector.NewSubscriptionStatus()
+		// telemetry holds the SrlTelemetryProfiles of which the subscriptions are made by the deviation server
+		telemetry := collector.NewTelemetry()
 
 		// eventChannels are used for deviation handling on the resources
-		eventChans, err := controllers.Setup(mgr, nddCtlrOptions(concurrency), logging.NewLogrLogger(zlog.WithName("srl")), autoPilot, pollInterval, namespace, tuChan)
+		eventChans, err := controllers.Setup(mgr, controllers.Options{
+			Controller:         nddCtlrOptions(concurrency),
+			Logger:             logging.NewLogrLogger(zlog.WithName("srl")),
+			Poll:               pollInterval,
+			Namespace:          namespace,
+			TargetUpdates:      tuChan,
+			SubscriptionStatus: subStatus,
+			Telemetry:          telemetry,
+			Srl: srl.Options{
+				NodeLimits: srl.NodeLimits{
+					QPS:         nodeQPS,
+					Burst:       nodeBurst,
+					MaxInFlight: nodeMaxInFlight,
+				},
+				ConfigCacheMaxAge: configCacheMaxAge,
+				Sharder:           sharder,
+				AuditRecorder:     recorder,
+				Autopilot:         autoPilot,
+			},
+		})
 		if err != nil {
 			return errors.Wrap(err, "Cannot add ndd controllers to manager")
 		}
 
+		if enableWebhooks {
+			if err := controllers.SetupWebhooks(mgr, logging.NewLogrLogger(zlog.WithName("srl"))); err != nil {
+				return errors.Wrap(err, "Cannot add ndd webhooks to manager")
+			}
+		}
+
 		d := collector.NewDeviationServer(
 			collector.WithEventChannels(eventChans),
 			collector.WithTargetUpdateChannel(tuChan),
+			collector.WithSubscriptionStatus(subStatus),
+			collector.WithTelemetry(telemetry),
+			collector.WithConfigWatcher(srl.NodeConfigWatcher()),
+			collector.WithSharder(sharder),
+			collector.WithSubscriptionOptions(collector.SubscriptionOptions{
+				Mode:              subMode,
+				SampleInterval:    subSampleInterval,
+				HeartbeatInterval: subHeartbeatInterval,
+				SuppressRedundant: &subSuppressRedundant,
+				Encoding:          subEncoding,
+			}),
+			collector.WithClient(mgr.GetClient()),
 			collector.WithLogging(logging.NewLogrLogger(zlog.WithName("srl"))),
 		)
-		go func() {
-			d.StartTargetChangeHandler()
-		}()
+		// the deviation server is started by the manager once it is elected as
+		// leader and stopped when the manager stops
+		if err := mgr.Add(d); err != nil {
+			return errors.Wrap(err, "Cannot add deviation server to manager")
+		}
 
 		// +kubebuilder:scaffold:builder
 
@@ -114,12 +280,54 @@ func init() {
 	startCmd.Flags().StringVarP(&probeAddr, "health-probe-bind-address", "p", ":8081", "The address the probe endpoint binds to.")
 	startCmd.Flags().BoolVarP(&enableLeaderElection, "leader-elect", "l", false, "Enable leader election for controller manager. "+
 		"Enabling this will ensure there is only one active controller manager.")
-	startCmd.Flags().IntVarP(&concurrency, "concurrency", "", 1, "Number of items to process simultaneously")
+	startCmd.Flags().IntVarP(&concurrency, "concurrency", "", 1, "Number of items to process simultaneously, the concurrency of the default SrlProviderConfig at the start of the provider overrides it.")
 	startCmd.Flags().BoolVarP(&autoPilot, "autopilot", "a", true,
-		"Apply delta/diff changes to the config automatically when set to true, if set to false the provider will report the delta and the operator should intervene what to do with the delta/diffs")
-	startCmd.Flags().DurationVarP(&pollInterval, "poll-interval", "", 1*time.Minute, "Poll interval controls how often an individual resource should be checked for drift.")
+		"Apply delta/diff changes to the config automatically when set to true, if set to false the provider will report the delta and the operator should intervene what to do with the delta/diffs. "+
+			"Sets the drift policy of the resources without a drift policy annotation to correct or report, the autopilot of a SrlProviderConfig overrides it.")
+	startCmd.Flags().DurationVarP(&pollInterval, "poll-interval", "", 1*time.Minute, "Poll interval controls how often an individual resource should be checked for drift, a SrlProviderConfig and a resource with the poll interval annotation can override it.")
 	startCmd.Flags().StringVarP(&namespace, "namespace", "n", os.Getenv("POD_NAMESPACE"), "Namespace used to unpack and run packages.")
 	startCmd.Flags().StringVarP(&podname, "podname", "", os.Getenv("POD_NAME"), "Name from the pod")
+	startCmd.Flags().StringVarP(&subMode, "subscription-mode", "", collector.SubscriptionModeOnChange, "Default mode of the gnmi subscriptions: on-change, sample or target-defined.")
+	startCmd.Flags().DurationVarP(&subSampleInterval, "subscription-sample-interval", "", 0, "Default sample interval of the gnmi subscriptions in sample mode.")
+	startCmd.Flags().DurationVarP(&subHeartbeatInterval, "subscription-heartbeat-interval", "", 0, "Default heartbeat interval of the gnmi subscriptions.")
+	startCmd.Flags().BoolVarP(&subSuppressRedundant, "subscription-suppress-redundant", "", false, "Suppress the samples of values that did not change.")
+	startCmd.Flags().StringVarP(&subEncoding, "subscription-encoding", "", "json_ietf_config_only", "Default encoding of the gnmi subscriptions: json, bytes, proto, ascii, json_ietf or json_ietf_config_only.")
+	startCmd.Flags().DurationVarP(&initTimeout, "init-timeout", "", 1*time.Minute, "Timeout of the initialization, which waits for the CRDs of the provider and creates the default Registration.")
+	startCmd.Flags().DurationVarP(&initPollInterval, "init-poll-interval", "", 1*time.Second, "Poll interval of the checks for the CRDs of the provider during the initialization.")
+	startCmd.Flags().Float64VarP(&nodeQPS, "node-qps", "", srl.DefaultNodeQPS, "Rate of the operations per second on the device driver of a network node, shared by all controllers.")
+	startCmd.Flags().IntVarP(&nodeBurst, "node-burst", "", srl.DefaultNodeBurst, "Burst of the operations on the device driver of a network node, shared by all controllers.")
+	startCmd.Flags().IntVarP(&nodeMaxInFlight, "node-max-in-flight", "", srl.DefaultNodeMaxInFlight, "Number of concurrent operations on the device driver of a network node, shared by all controllers, 0 disables the limit.")
+	startCmd.Flags().DurationVarP(&configCacheMaxAge, "config-cache-max-age", "", srl.DefaultConfigCacheMaxAge, "Age after which the cached config of a network node is read again from the device driver, the cached config is kept up to date by the config subscription, 0 disables the cache.")
+	startCmd.Flags().BoolVarP(&sharding, "sharding", "", false, "Shard the network nodes across the provider replicas with a consistent hash ring, every replica reconciles the resources of and subscribes to the network nodes of its shard. Requires the namespace and cannot be combined with leader election.")
+	startCmd.Flags().DurationVarP(&shardLeaseDuration, "shard-lease-duration", "", shard.DefaultLeaseDuration, "Duration after which a replica that did not renew its shard lease is removed from the ring and its network nodes are rebalanced.")
+	startCmd.Flags().DurationVarP(&shardRenewInterval, "shard-renew-interval", "", shard.DefaultRenewInterval, "Interval of the renewals of the shard lease of the replica and of the checks for replicas that joined or left.")
+	startCmd.Flags().StringVarP(&tracingExporter, "tracing-exporter", "", tracing.ExporterNone, "Exporter of the spans of the reconciles, the validations and the gNMI calls: none, otlp or file.")
+	startCmd.Flags().StringVarP(&tracingEndpoint, "tracing-endpoint", "", "localhost:4317", "Endpoint of the OTLP gRPC collector the spans are exported to.")
+	startCmd.Flags().BoolVarP(&tracingInsecure, "tracing-insecure", "", false, "Connect to the OTLP collector without TLS.")
+	startCmd.Flags().StringVarP(&tracingFile, "tracing-file", "", "", "File the spans are written to as JSON with the file exporter, stdout when empty.")
+	startCmd.Flags().Float64VarP(&tracingSampleRatio, "tracing-sample-ratio", "", 1, "Ratio of the reconciles that are traced.")
+	startCmd.Flags().StringVarP(&auditSink, "audit-sink", "", audit.SinkNone, "Sink of the audit trail of the set requests pushed to the network nodes: none, file for JSON lines or record for SrlChangeRecords.")
+	startCmd.Flags().StringVarP(&auditFile, "audit-file", "", "", "File the audit records are appended to as JSON lines with the file sink, stdout when empty.")
+	startCmd.Flags().IntVarP(&auditRecordLimit, "audit-record-limit", "", audit.DefaultRecordLimit, "Number of SrlChangeRecords that are kept per network node with the record sink, the oldest records are deleted, 0 keeps all records.")
+	startCmd.Flags().StringSliceVarP(&auditSecretLeafs, "audit-secret-leafs", "", audit.DefaultSecretLeafs, "Names of the leafs of which the values are redacted in the audit records.")
+	startCmd.Flags().BoolVarP(&enableWebhooks, "enable-webhooks", "", false, "Serve the admission webhooks, which validate the resources and record the user that changed a resource for the audit trail, requires the serving certificates of the webhook server.")
+}
+
+// initialize waits until the CRDs of all kinds of the provider are established
+// and creates the default Registration, the initialization fails when it does
+// not complete within the init timeout.
+func initialize(ctx context.Context, cfg *rest.Config, log logging.Logger) error {
+	cl, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return errors.Wrap(err, "Cannot create new kubernetes client")
+	}
+	ctx, cancel := context.WithTimeout(ctx, initTimeout)
+	defer cancel()
+	i := initializer.New(cl,
+		initializer.NewCRDWaiter(srlv1.Group, initializer.Kinds(scheme, srlv1.GroupVersion), initTimeout, initPollInterval, log),
+		initializer.NewRegistrationObject(),
+	)
+	return i.Init(ctx)
 }
 
 func nddCtlrOptions(c int) controller.Options {
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/collector"
+)
+
+// Redacted replaces the values of the secret leafs in the records.
+const Redacted = "<redacted>"
+
+// DefaultSecretLeafs are the names of the leafs of which the values are
+// redacted by default, e.g. the keys of the authentication keychains.
+var DefaultSecretLeafs = []string{"authentication-key", "password", "auth-password", "priv-password", "hashed-password", "pre-shared-key", "secret"}
+
+const (
+	// SinkNone disables the audit trail
+	SinkNone = "none"
+	// SinkFile writes the records as JSON lines to a file, or to stdout when
+	// the path is empty
+	SinkFile = "file"
+	// SinkRecord creates a SrlChangeRecord per record
+	SinkRecord = "record"
+)
+
+// A Resource is the managed resource on behalf of which a set request is sent.
+type Resource struct {
+	Kind         string     `json:"kind,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Namespace    string     `json:"namespace,omitempty"`
+	Generation   int64      `json:"generation,omitempty"`
+	User         string     `json:"user,omitempty"`
+	FieldManager string     `json:"fieldManager,omitempty"`
+	ManagedTime  *time.Time `json:"managedTime,omitempty"`
+}
+
+// An Update is an update or replace of a path, the value is JSON encoded.
+type Update struct {
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// A Record is the audit record of a set request sent to the device driver of a
+// network node.
+type Record struct {
+	Timestamp   time.Time `json:"timestamp"`
+	NetworkNode string    `json:"networkNode"`
+	Resource    *Resource `json:"resource,omitempty"`
+	Updates     []Update  `json:"updates,omitempty"`
+	Replaces    []Update  `json:"replaces,omitempty"`
+	Deletes     []string  `json:"deletes,omitempty"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// A Sink stores the audit records.
+type Sink interface {
+	Write(ctx context.Context, r Record) error
+}
+
+type resourceKey struct{}
+
+// WithResource returns the context of the set requests that are sent on behalf
+// of the managed resource.
+func WithResource(ctx context.Context, r Resource) context.Context {
+	return context.WithValue(ctx, resourceKey{}, r)
+}
+
+// ResourceFrom returns the managed resource of the context.
+func ResourceFrom(ctx context.Context) (Resource, bool) {
+	r, ok := ctx.Value(resourceKey{}).(Resource)
+	return r, ok
+}
+
+// ResourceOf returns the audit resource of the object of the kind. The user is
+// the user the admission webhook recorded in the changed-by annotation of the
+// object, the field manager is the manager of the latest change of the spec in
+// the managed fields of the object.
+func ResourceOf(kind string, o metav1.Object) Resource {
+	r := Resource{
+		Kind:       kind,
+		Name:       o.GetName(),
+		Namespace:  o.GetNamespace(),
+		Generation: o.GetGeneration(),
+		User:       o.GetAnnotations()[srlv1.AnnotationKeyChangedBy],
+	}
+	for _, mf := range o.GetManagedFields() {
+		if mf.Subresource != "" || mf.FieldsV1 == nil || !bytes.Contains(mf.FieldsV1.Raw, []byte(`"f:spec"`)) {
+			continue
+		}
+		if mf.Time == nil {
+			if r.FieldManager == "" {
+				r.FieldManager = mf.Manager
+			}
+			continue
+		}
+		if r.ManagedTime == nil || mf.Time.Time.After(*r.ManagedTime) {
+			t := mf.Time.Time
+			r.FieldManager = mf.Manager
+			r.ManagedTime = &t
+		}
+	}
+	return r
+}
+
+// A Recorder records the set requests the provider sends to the device drivers
+// in its sink, the values of the secret leafs are redacted. A nil Recorder
+// records nothing.
+type Recorder struct {
+	sink   Sink
+	log    logging.Logger
+	secret map[string]bool
+}
+
+// RecorderOption is a function to initialize the options of a Recorder.
+type RecorderOption func(r *Recorder)
+
+// WithSecretLeafs initializes the recorder with the names of the leafs of which
+// the values are redacted, they replace the DefaultSecretLeafs.
+func WithSecretLeafs(leafs []string) RecorderOption {
+	return func(r *Recorder) {
+		r.secret = make(map[string]bool, len(leafs))
+		for _, l := range leafs {
+			r.secret[l] = true
+		}
+	}
+}
+
+// NewRecorder returns a recorder that writes the records to the sink.
+func NewRecorder(s Sink, l logging.Logger, opts ...RecorderOption) *Recorder {
+	r := &Recorder{sink: s, log: l}
+	WithSecretLeafs(DefaultSecretLeafs)(r)
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// GNMIDialOptions returns the dial options that record the set requests to the
+// device driver of the network node.
+func (r *Recorder) GNMIDialOptions(node string) []grpc.DialOption {
+	if r == nil {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(r.interceptor(node))}
+}
+
+func (r *Recorder) interceptor(node string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if set, ok := req.(*gnmi.SetRequest); ok {
+			rec := r.newRecord(ctx, node, set, err)
+			// a failing sink does not fail the change, it is reported instead
+			if werr := r.sink.Write(ctx, rec); werr != nil {
+				r.log.Info("cannot write audit record", "networknode", node, "error", werr)
+			}
+		}
+		return err
+	}
+}
+
+func (r *Recorder) newRecord(ctx context.Context, node string, req *gnmi.SetRequest, err error) Record {
+	rec := Record{
+		Timestamp:   time.Now().UTC(),
+		NetworkNode: node,
+		Updates:     r.updates(req.GetPrefix(), req.GetUpdate()),
+		Replaces:    r.updates(req.GetPrefix(), req.GetReplace()),
+	}
+	if res, ok := ResourceFrom(ctx); ok {
+		rec.Resource = &res
+	}
+	for _, p := range req.GetDelete() {
+		rec.Deletes = append(rec.Deletes, collector.PathString(req.GetPrefix(), p))
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	return rec
+}
+
+func (r *Recorder) updates(prefix *gnmi.Path, us []*gnmi.Update) []Update {
+	if len(us) == 0 {
+		return nil
+	}
+	ru := make([]Update, 0, len(us))
+	for _, u := range us {
+		path := joinPath(prefix, u.GetPath())
+		ru = append(ru, Update{Path: collector.PathString(prefix, u.GetPath()), Value: r.value(path, u.GetVal())})
+	}
+	return ru
+}
+
+// value returns the JSON encoding of the typed value, values that cannot be
+// decoded are recorded as a JSON string of their text representation. The
+// value of a secret leaf and the secret leafs within the value are redacted.
+func (r *Recorder) value(path *gnmi.Path, tv *gnmi.TypedValue) json.RawMessage {
+	if tv == nil {
+		return nil
+	}
+	var v interface{} = Redacted
+	if elems := path.GetElem(); len(elems) == 0 || !r.secret[stripModule(elems[len(elems)-1].GetName())] {
+		var err error
+		if v, err = collector.TypedValue(tv); err != nil {
+			v = tv.String()
+		}
+		v = r.redact(v)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// redact replaces the values of the secret leafs within the JSON decoded value.
+func (r *Recorder) redact(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, c := range x {
+			if r.secret[stripModule(k)] {
+				x[k] = Redacted
+				continue
+			}
+			x[k] = r.redact(c)
+		}
+	case []interface{}:
+		for i, c := range x {
+			x[i] = r.redact(c)
+		}
+	}
+	return v
+}
+
+// joinPath returns the path relative to the prefix.
+func joinPath(prefix, path *gnmi.Path) *gnmi.Path {
+	return &gnmi.Path{Elem: append(append([]*gnmi.PathElem{}, prefix.GetElem()...), path.GetElem()...)}
+}
+
+func stripModule(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
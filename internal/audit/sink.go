@@ -0,0 +1,180 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// errors
+	errOpenFile     = "cannot open audit file"
+	errWriteFile    = "cannot write audit record"
+	errCreateRecord = "cannot create SrlChangeRecord"
+	errPruneRecords = "cannot delete the oldest SrlChangeRecords"
+
+	// DefaultRecordLimit is the default number of SrlChangeRecords that are
+	// kept per network node
+	DefaultRecordLimit = 1000
+)
+
+// A FileSink writes the records as JSON lines.
+type FileSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFileSink returns a sink that appends the records to the file, the records
+// are written to stdout when the path is empty.
+func NewFileSink(path string) (*FileSink, error) {
+	if path == "" {
+		return &FileSink{w: os.Stdout}, nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, errOpenFile)
+	}
+	return &FileSink{w: f}, nil
+}
+
+// Write writes the record as a JSON line.
+func (s *FileSink) Write(_ context.Context, r Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, errWriteFile)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(b, '\n'))
+	return errors.Wrap(err, errWriteFile)
+}
+
+// A RecordSink creates a SrlChangeRecord per record, the oldest records of a
+// network node are deleted when it has more than the limit.
+type RecordSink struct {
+	kube  client.Client
+	limit int
+}
+
+// NewRecordSink returns a sink that creates the SrlChangeRecords with the
+// client and keeps at most limit records per network node, 0 keeps all.
+func NewRecordSink(kube client.Client, limit int) *RecordSink {
+	return &RecordSink{kube: kube, limit: limit}
+}
+
+// Write creates the SrlChangeRecord of the record, it is labelled with the
+// network node and the managed resource of the record.
+func (s *RecordSink) Write(ctx context.Context, r Record) error {
+	if err := s.create(ctx, r); err != nil {
+		return err
+	}
+	return s.prune(ctx, r.NetworkNode)
+}
+
+func (s *RecordSink) create(ctx context.Context, r Record) error {
+	cr := &srlv1.SrlChangeRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: r.NetworkNode + "-",
+			Labels:       map[string]string{},
+		},
+		Spec: srlv1.ChangeRecordSpec{
+			Timestamp:   metav1.NewTime(r.Timestamp),
+			NetworkNode: r.NetworkNode,
+			Replaces:    recordUpdates(r.Replaces),
+			Updates:     recordUpdates(r.Updates),
+			Deletes:     r.Deletes,
+			Error:       r.Error,
+		},
+	}
+	setLabel(cr, srlv1.LabelKeyChangeNetworkNode, r.NetworkNode)
+	if res := r.Resource; res != nil {
+		cr.Spec.Resource = srlv1.ChangeRecordResource{
+			Kind:         res.Kind,
+			Name:         res.Name,
+			Namespace:    res.Namespace,
+			Generation:   res.Generation,
+			User:         res.User,
+			FieldManager: res.FieldManager,
+		}
+		if res.ManagedTime != nil {
+			t := metav1.NewTime(*res.ManagedTime)
+			cr.Spec.Resource.ManagedTime = &t
+		}
+		setLabel(cr, srlv1.LabelKeyChangeKind, res.Kind)
+		setLabel(cr, srlv1.LabelKeyChangeResource, res.Name)
+	}
+	return errors.Wrap(s.kube.Create(ctx, cr), errCreateRecord)
+}
+
+// prune deletes the oldest records of the network node that exceed the limit.
+func (s *RecordSink) prune(ctx context.Context, node string) error {
+	if s.limit <= 0 {
+		return nil
+	}
+	l := &srlv1.SrlChangeRecordList{}
+	if err := s.kube.List(ctx, l, client.MatchingLabels{srlv1.LabelKeyChangeNetworkNode: node}); err != nil {
+		return errors.Wrap(err, errPruneRecords)
+	}
+	if len(l.Items) <= s.limit {
+		return nil
+	}
+	sort.Slice(l.Items, func(i, j int) bool {
+		ti, tj := l.Items[i].Spec.Timestamp, l.Items[j].Spec.Timestamp
+		if ti.Equal(&tj) {
+			return l.Items[i].GetName() < l.Items[j].GetName()
+		}
+		return ti.Before(&tj)
+	})
+	for i := range l.Items[:len(l.Items)-s.limit] {
+		if err := s.kube.Delete(ctx, &l.Items[i]); client.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errPruneRecords)
+		}
+	}
+	return nil
+}
+
+// setLabel sets the label when the value is a valid label value, names that are
+// too long for a label are only recorded in the spec.
+func setLabel(cr *srlv1.SrlChangeRecord, key, value string) {
+	if value == "" || len(validation.IsValidLabelValue(value)) != 0 {
+		return
+	}
+	cr.Labels[key] = value
+}
+
+func recordUpdates(us []Update) []srlv1.ChangeRecordUpdate {
+	if len(us) == 0 {
+		return nil
+	}
+	r := make([]srlv1.ChangeRecordUpdate, 0, len(us))
+	for _, u := range us {
+		r = append(r, srlv1.ChangeRecordUpdate{Path: u.Path, Value: string(u.Value)})
+	}
+	return r
+}
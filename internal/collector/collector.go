@@ -25,24 +25,33 @@ import (
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
 	"github.com/yndd/ndd-runtime/pkg/logging"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
-	defaultTargetReceivebuffer = 1000
-	defaultLockRetry           = 5 * time.Second
-	defaultRetryTimer          = 10 * time.Second
+	// DefaultBufferSize is the number of subscription responses that are
+	// buffered when the target config has no buffer size
+	DefaultBufferSize = 1000
+	// DefaultRetryTimer is the time after which a failed subscription is
+	// retried when the target config has no retry timer
+	DefaultRetryTimer = 10 * time.Second
+
+	defaultLockRetry = 5 * time.Second
 
 	// errors
 	errCreateSubscriptionRequest = "cannot create subscription request"
+	errCreateSubscribeClient     = "cannot create subscribe client"
+	errSendSubscribeRequest      = "cannot send subscribe request"
+	errFmtSubscriptionExists     = "subscription %s already exists"
+	errFmtSubscriptionNotFound   = "subscription %s not found"
 )
 
 // Collector defines the interfaces for the collector
 type Collector interface {
-	Lock()
-	Unlock()
 	GetSubscription(subName string) bool
-	StopSubscription(ctx context.Context, sub string) error
-	StartSubscription(ctx context.Context, subName string, sub []string) error
+	StopSubscription(ctx context.Context, subName string) error
+	StartSubscription(ctx context.Context, target, subName string, paths []*gnmi.Path, opts SubscriptionOptions) error
+	StopSubscriptions()
 }
 
 // DeviceCollectorOption can be used to manipulate Options.
@@ -55,99 +64,190 @@ func WithDeviceCollectorLogger(log logging.Logger) DeviceCollectorOption {
 	}
 }
 
-// GNMICollector defines the parameters for the collector
+// GNMICollector defines the parameters for the collector, the subscriptions
+// are safe for concurrent use. The subscriptions are made with the gnmi client
+// of the target, their responses and errors are read from the channels of the
+// collector.
 type GNMICollector struct {
 	TargetReceiveBuffer uint
 	RetryTimer          time.Duration
 	Target              *target.Target
-	//targetSubRespChan   chan *collector.SubscribeResponse
-	//targetSubErrChan    chan *collector.TargetError
-	Subscriptions map[string]*Subscription
-	Mutex         sync.RWMutex
+
+	responses chan *target.SubscribeResponse
+	errors    chan *target.TargetError
+
+	mu            sync.Mutex
+	subscriptions map[string]*Subscription
 	log           logging.Logger
 }
 
-// Subscription defines the parameters for the subscription
+// Subscription defines the parameters for the subscription, the subscription
+// runs until its context is cancelled.
 type Subscription struct {
-	StopCh   chan bool
-	CancelFn context.CancelFunc
+	cancel context.CancelFunc
+	// done is closed when the subscription stopped and is removed from the
+	// collector
+	done chan struct{}
 }
 
-// NewGNMICollector creates a new GNMI collector
+// NewGNMICollector creates a new GNMI collector, the buffer size and retry
+// timer of the target config are used when they are set.
 func NewGNMICollector(t *target.Target, opts ...DeviceCollectorOption) *GNMICollector {
 	c := &GNMICollector{
 		Target:              t,
-		Subscriptions:       make(map[string]*Subscription),
-		Mutex:               sync.RWMutex{},
-		TargetReceiveBuffer: defaultTargetReceivebuffer,
-		RetryTimer:          defaultRetryTimer,
+		subscriptions:       make(map[string]*Subscription),
+		TargetReceiveBuffer: DefaultBufferSize,
+		RetryTimer:          DefaultRetryTimer,
+	}
+	if t.Config.BufferSize > 0 {
+		c.TargetReceiveBuffer = t.Config.BufferSize
+	}
+	if t.Config.RetryTimer > 0 {
+		c.RetryTimer = t.Config.RetryTimer
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.responses = make(chan *target.SubscribeResponse, c.TargetReceiveBuffer)
+	c.errors = make(chan *target.TargetError)
 	return c
 }
 
-// Lock locks a gnmi collector
-func (c *GNMICollector) Lock() {
-	c.Mutex.RLock()
+// ReadSubscriptions returns the channels of the responses and the errors of the
+// subscriptions.
+func (c *GNMICollector) ReadSubscriptions() (chan *target.SubscribeResponse, chan *target.TargetError) {
+	return c.responses, c.errors
 }
 
-// Unlock unlocks a gnmi collector
-func (c *GNMICollector) Unlock() {
-	c.Mutex.RUnlock()
+// GetSubscription returns true when the subscription exists
+func (c *GNMICollector) GetSubscription(subName string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.subscriptions[subName]
+	return ok
 }
 
-// GetSubscription returns a bool based on a subscription name
-func (c *GNMICollector) GetSubscription(subName string) bool {
-	if _, ok := c.Subscriptions[subName]; !ok {
-		return true
+// StopSubscription stops a subscription and waits until its stream is closed
+func (c *GNMICollector) StopSubscription(ctx context.Context, subName string) error {
+	log := c.log.WithValues("subscription", subName)
+	log.Debug("subscription stop...")
+	c.mu.Lock()
+	sub, ok := c.subscriptions[subName]
+	c.mu.Unlock()
+	if !ok {
+		return errors.Errorf(errFmtSubscriptionNotFound, subName)
 	}
-	return false
+	sub.cancel()
+	select {
+	case <-sub.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	log.Debug("subscription stopped")
+	return nil
 }
 
-// StopSubscription stops a subscription
-func (c *GNMICollector) StopSubscription(ctx context.Context, sub string) error {
-	c.log.WithValues("subscription", sub)
-	c.log.Debug("subscription stop...")
-	c.Subscriptions[sub].StopCh <- true // trigger quit
-
-	c.log.Debug("subscription stopped")
-	return nil
+// StopSubscriptions stops all subscriptions and waits until their streams are
+// closed
+func (c *GNMICollector) StopSubscriptions() {
+	c.mu.Lock()
+	subs := make([]*Subscription, 0, len(c.subscriptions))
+	for _, sub := range c.subscriptions {
+		subs = append(subs, sub)
+	}
+	c.mu.Unlock()
+	for _, sub := range subs {
+		sub.cancel()
+		<-sub.done
+	}
 }
 
-// StartSubscription starts a subscription
-func (c *GNMICollector) StartSubscription(dctx context.Context, target, subName string, paths []*gnmi.Path) error {
+// StartSubscription starts a subscription, the subscription runs until it is
+// stopped or the context is cancelled, after which it is removed from the
+// collector.
+func (c *GNMICollector) StartSubscription(ctx context.Context, target, subName string, paths []*gnmi.Path, opts SubscriptionOptions) error {
 	log := c.log.WithValues("subscription", subName, "Paths", paths)
 	log.Debug("subscription start...")
-	// initialize new subscription
-	ctx, cancel := context.WithCancel(dctx)
 
-	c.Subscriptions[subName] = &Subscription{
-		StopCh:   make(chan bool),
-		CancelFn: cancel,
-	}
-
-	req, err := CreateSubscriptionRequest(target, subName, paths)
+	req, err := CreateSubscriptionRequest(target, subName, paths, opts)
 	if err != nil {
-		c.log.Debug(errCreateSubscriptionRequest, "error", err)
+		log.Debug(errCreateSubscriptionRequest, "error", err)
 		return errors.Wrap(err, errCreateSubscriptionRequest)
 	}
 
+	c.mu.Lock()
+	if _, ok := c.subscriptions[subName]; ok {
+		c.mu.Unlock()
+		return errors.Errorf(errFmtSubscriptionExists, subName)
+	}
+	sctx, cancel := context.WithCancel(ctx)
+	sub := &Subscription{cancel: cancel, done: make(chan struct{})}
+	c.subscriptions[subName] = sub
+	c.mu.Unlock()
+
 	go func() {
-		c.Target.Subscribe(ctx, req, subName)
+		c.subscribe(sctx, req, subName)
+		c.mu.Lock()
+		if c.subscriptions[subName] == sub {
+			delete(c.subscriptions, subName)
+		}
+		c.mu.Unlock()
+		close(sub.done)
+		log.Debug("subscription cancelled")
 	}()
 	log.Debug("subscription started ...")
+	return nil
+}
+
+// subscribe runs the subscription until the context is cancelled, a failed
+// subscription is reported on the error channel and retried after the retry
+// timer. The subscribe of the gnmic target is not used, it retries a failed
+// subscription without checking the context such that it cannot be stopped.
+func (c *GNMICollector) subscribe(ctx context.Context, req *gnmi.SubscribeRequest, subName string) {
+	for {
+		err := c.stream(ctx, req, subName)
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case c.errors <- &target.TargetError{SubscriptionName: subName, Err: err}:
+		case <-ctx.Done():
+			return
+		}
+		retry := time.NewTimer(c.RetryTimer)
+		select {
+		case <-retry.C:
+		case <-ctx.Done():
+			retry.Stop()
+			return
+		}
+	}
+}
 
+// stream sends the subscribe request to the target and forwards the responses
+// until the stream fails or the context is cancelled.
+func (c *GNMICollector) stream(ctx context.Context, req *gnmi.SubscribeRequest, subName string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		"username", stringValue(c.Target.Config.Username, ""),
+		"password", stringValue(c.Target.Config.Password, ""))
+	sc, err := c.Target.Client.Subscribe(ctx)
+	if err != nil {
+		return errors.Wrap(err, errCreateSubscribeClient)
+	}
+	if err := sc.Send(req); err != nil {
+		return errors.Wrap(err, errSendSubscribeRequest)
+	}
 	for {
+		rsp, err := sc.Recv()
+		if err != nil {
+			return err
+		}
 		select {
-		case <-c.Subscriptions[subName].StopCh: // execute quit
-			c.Subscriptions[subName].CancelFn()
-			c.Mutex.Lock()
-			delete(c.Subscriptions, subName)
-			c.Mutex.Unlock()
-			c.log.Debug("subscription cancelled")
-			return nil
+		case c.responses <- &target.SubscribeResponse{SubscriptionName: subName, Response: rsp}:
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 }
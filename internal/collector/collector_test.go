@@ -0,0 +1,324 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/karimra/gnmic/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testTimeout = 10 * time.Second
+
+// fakeGNMIServer answers every subscription with a sync response and keeps the
+// stream open until the client cancels it. The subscriptions fail when fail is
+// set or when the first path of the subscription starts with failElem.
+type fakeGNMIServer struct {
+	gnmi.UnimplementedGNMIServer
+
+	mu       sync.Mutex
+	fail     bool
+	failElem string
+	streams  int
+	total    int
+}
+
+func (s *fakeGNMIServer) Subscribe(stream gnmi.GNMI_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.streams++
+	s.total++
+	fail := s.fail
+	if subs := req.GetSubscribe().GetSubscription(); s.failElem != "" && len(subs) > 0 {
+		fail = fail || subs[0].GetPath().GetElem()[0].GetName() == s.failElem
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.streams--
+		s.mu.Unlock()
+	}()
+	if fail {
+		return status.Error(codes.Unavailable, "subscription failed")
+	}
+	if err := stream.Send(&gnmi.SubscribeResponse{
+		Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true},
+	}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+// subscriptions returns the number of open and the total number of
+// subscriptions made to the server.
+func (s *fakeGNMIServer) subscriptions() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.streams, s.total
+}
+
+// newFakeGNMIServer starts a fakeGNMIServer that is stopped when the test ends
+// and returns its address.
+func newFakeGNMIServer(t *testing.T) (*fakeGNMIServer, string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	fs := &fakeGNMIServer{}
+	s := grpc.NewServer()
+	gnmi.RegisterGNMIServer(s, fs)
+	go s.Serve(l) // nolint:errcheck
+	t.Cleanup(s.Stop)
+	return fs, l.Addr().String()
+}
+
+// newTestTargetConfig returns the target config of the device driver like the
+// provider config builds it without a retry timer and buffer size, such that
+// the collector runs with its defaults.
+func newTestTargetConfig(name, address string) *types.TargetConfig {
+	insecure, skipVerify, gzip := true, true, false
+	username, password := "admin", "admin"
+	empty := ""
+	return &types.TargetConfig{
+		Name:       name,
+		Address:    address,
+		Username:   &username,
+		Password:   &password,
+		Timeout:    testTimeout,
+		SkipVerify: &skipVerify,
+		Insecure:   &insecure,
+		TLSCA:      &empty,
+		TLSCert:    &empty,
+		TLSKey:     &empty,
+		Gzip:       &gzip,
+	}
+}
+
+// newTestCollector returns a collector connected to the address, the responses
+// and errors of its subscriptions are not read by the test.
+func newTestCollector(t *testing.T, address string) *GNMICollector {
+	t.Helper()
+	tg := target.NewTarget(newTestTargetConfig("dev1", address))
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	if err := tg.CreateGNMIClient(ctx); err != nil {
+		t.Fatalf("create gnmi client: %v", err)
+	}
+	// the connection is made by the first call, such that the goroutines of
+	// the connection are running before the test counts the goroutines
+	tg.Client.Capabilities(ctx, &gnmi.CapabilityRequest{}) // nolint:errcheck
+	return NewGNMICollector(tg, WithDeviceCollectorLogger(logging.NewNopLogger()))
+}
+
+// noGoroutinesLeft fails the test when the number of goroutines does not drop
+// to the number before the subscriptions were started.
+func noGoroutinesLeft(t *testing.T, before int) {
+	t.Helper()
+	eventually(t, "the goroutines of the subscriptions exited", func() bool {
+		return runtime.NumGoroutine() <= before
+	})
+}
+
+func testPaths() []*gnmi.Path {
+	return []*gnmi.Path{{Elem: []*gnmi.PathElem{{Name: "interface"}}}}
+}
+
+// eventually fails the test when the condition is not true within the test
+// timeout.
+func eventually(t *testing.T, msg string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting until %s", msg)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewGNMICollectorDefaults(t *testing.T) {
+	c := NewGNMICollector(target.NewTarget(newTestTargetConfig("dev1", "127.0.0.1:0")))
+	if c.RetryTimer != DefaultRetryTimer {
+		t.Errorf("RetryTimer: want %s, got %s", DefaultRetryTimer, c.RetryTimer)
+	}
+	if _, errCh := c.ReadSubscriptions(); cap(c.responses) != DefaultBufferSize || errCh == nil {
+		t.Errorf("responses: want buffer %d, got %d", DefaultBufferSize, cap(c.responses))
+	}
+
+	tc := newTestTargetConfig("dev1", "127.0.0.1:0")
+	tc.RetryTimer, tc.BufferSize = time.Second, 5
+	c = NewGNMICollector(target.NewTarget(tc))
+	if c.RetryTimer != time.Second || cap(c.responses) != 5 {
+		t.Errorf("target config: want retry timer 1s and buffer 5, got %s and %d", c.RetryTimer, cap(c.responses))
+	}
+}
+
+func TestStartStopSubscription(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	c := newTestCollector(t, address)
+	ctx := context.Background()
+	before := runtime.NumGoroutine()
+
+	if err := c.StartSubscription(ctx, "dev1", "sub1", testPaths(), DefaultSubscriptionOptions()); err != nil {
+		t.Fatalf("StartSubscription: %v", err)
+	}
+	if !c.GetSubscription("sub1") {
+		t.Errorf("GetSubscription(sub1): want true after start")
+	}
+	if err := c.StartSubscription(ctx, "dev1", "sub1", testPaths(), DefaultSubscriptionOptions()); err == nil {
+		t.Errorf("StartSubscription(sub1): want error for an existing subscription")
+	}
+	eventually(t, "the subscription is open on the server", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 1
+	})
+
+	if err := c.StopSubscription(ctx, "sub1"); err != nil {
+		t.Fatalf("StopSubscription: %v", err)
+	}
+	if c.GetSubscription("sub1") {
+		t.Errorf("GetSubscription(sub1): want false after stop")
+	}
+	if err := c.StopSubscription(ctx, "sub1"); err == nil {
+		t.Errorf("StopSubscription(sub1): want error for a stopped subscription")
+	}
+	eventually(t, "the subscription is closed on the server", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 0
+	})
+	noGoroutinesLeft(t, before)
+	if _, total := fs.subscriptions(); total != 1 {
+		t.Errorf("StopSubscription: want no retries of a stopped subscription, got %d subscriptions", total)
+	}
+}
+
+func TestStopSubscriptionFailing(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	fs.fail = true
+	c := newTestCollector(t, address)
+	ctx := context.Background()
+	before := runtime.NumGoroutine()
+
+	if err := c.StartSubscription(ctx, "dev1", "sub1", testPaths(), DefaultSubscriptionOptions()); err != nil {
+		t.Fatalf("StartSubscription: %v", err)
+	}
+	_, errCh := c.ReadSubscriptions()
+	select {
+	case tErr := <-errCh:
+		if tErr.SubscriptionName != "sub1" {
+			t.Errorf("error of subscription: want sub1, got %s", tErr.SubscriptionName)
+		}
+	case <-time.After(testTimeout):
+		t.Fatalf("timed out waiting for the error of the failed subscription")
+	}
+
+	// the failed subscription waits for the retry timer, the next error is not
+	// read such that the stop must not depend on a reader of the errors
+	if err := c.StopSubscription(ctx, "sub1"); err != nil {
+		t.Fatalf("StopSubscription: %v", err)
+	}
+	noGoroutinesLeft(t, before)
+	if _, total := fs.subscriptions(); total != 1 {
+		t.Errorf("StopSubscription: want the failed subscription to wait for the retry timer, got %d subscriptions", total)
+	}
+}
+
+func TestStartStopSubscriptionConcurrent(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	c := newTestCollector(t, address)
+	ctx := context.Background()
+	before := runtime.NumGoroutine()
+
+	const workers, rounds = 8, 20
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				// the workers share the subscription names, such that the
+				// start and stop of the same subscription race
+				name := fmt.Sprintf("sub%d", (w+r)%4)
+				if err := c.StartSubscription(ctx, "dev1", name, testPaths(), DefaultSubscriptionOptions()); err != nil {
+					continue
+				}
+				c.GetSubscription(name)
+				c.StopSubscription(ctx, name) // nolint:errcheck
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for i := 0; i < 4; i++ {
+		name := fmt.Sprintf("sub%d", i)
+		if err := c.StartSubscription(ctx, "dev1", name, testPaths(), DefaultSubscriptionOptions()); err != nil {
+			t.Fatalf("StartSubscription(%s): %v", name, err)
+		}
+	}
+	c.StopSubscriptions()
+	for i := 0; i < 4; i++ {
+		if name := fmt.Sprintf("sub%d", i); c.GetSubscription(name) {
+			t.Errorf("GetSubscription(%s): want false after StopSubscriptions", name)
+		}
+	}
+	eventually(t, "all subscriptions are closed on the server", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 0
+	})
+	noGoroutinesLeft(t, before)
+}
+
+func TestStopSubscriptionContextCancelled(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	c := newTestCollector(t, address)
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := c.StartSubscription(ctx, "dev1", "sub1", testPaths(), DefaultSubscriptionOptions()); err != nil {
+		t.Fatalf("StartSubscription: %v", err)
+	}
+	cancel()
+	eventually(t, "the subscription of the cancelled context is removed", func() bool {
+		return !c.GetSubscription("sub1")
+	})
+	if err := c.StartSubscription(context.Background(), "dev1", "sub1", testPaths(), DefaultSubscriptionOptions()); err != nil {
+		t.Fatalf("StartSubscription after cancel: %v", err)
+	}
+	c.StopSubscriptions()
+	eventually(t, "all subscriptions are closed on the server", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 0
+	})
+	noGoroutinesLeft(t, before)
+}
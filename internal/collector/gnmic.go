@@ -3,40 +3,193 @@ package collector
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/karimra/gnmic/utils"
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
+const (
+	// EncodingJSONIETFConfigOnly is the encoding of the device driver that only
+	// returns the configuration in JSON_IETF encoding, it is not part of the gnmi
+	// specification.
+	EncodingJSONIETFConfigOnly gnmi.Encoding = 46
+
+	// subscription modes
+	SubscriptionModeOnChange      = "on-change"
+	SubscriptionModeSample        = "sample"
+	SubscriptionModeTargetDefined = "target-defined"
+
+	defaultSubscriptionMode = SubscriptionModeOnChange
+	defaultEncoding         = "json_ietf_config_only"
+	defaultQosMarking       = 21
+
+	// errors
+	errFmtUnknownSubscriptionMode = "unknown subscription mode %s"
+	errFmtUnknownEncoding         = "unknown encoding %s"
+	errSampleIntervalRequired     = "a sample interval is required for sample subscriptions"
+)
+
+// encodings are the encodings a subscription can be made with.
+var encodings = map[string]gnmi.Encoding{
+	"json":                  gnmi.Encoding_JSON,
+	"bytes":                 gnmi.Encoding_BYTES,
+	"proto":                 gnmi.Encoding_PROTO,
+	"ascii":                 gnmi.Encoding_ASCII,
+	"json_ietf":             gnmi.Encoding_JSON_IETF,
+	"json_ietf_config_only": EncodingJSONIETFConfigOnly,
+}
+
+// SubscriptionOptions defines the mode, encoding and intervals of a subscription,
+// the empty fields are filled in with the defaults of the deviation server.
+type SubscriptionOptions struct {
+	// Mode is on-change, sample or target-defined
+	Mode string
+	// SampleInterval of sample subscriptions
+	SampleInterval time.Duration
+	// HeartbeatInterval after which the value is sent when it did not change
+	HeartbeatInterval time.Duration
+	// SuppressRedundant suppresses the samples of values that did not change
+	SuppressRedundant *bool
+	// Encoding is json, bytes, proto, ascii, json_ietf or json_ietf_config_only
+	Encoding string
+	// QosMarking is the DSCP marking of the subscription
+	QosMarking *uint32
+	// Prefix is the prefix path of the subscription, the subscription name is
+	// used as prefix by the config subscription of the device driver
+	Prefix *string
+}
+
+// DefaultSubscriptionOptions returns the options of the config change
+// subscription, STREAM/ON_CHANGE with JSON_IETF_CONFIG_ONLY encoding.
+func DefaultSubscriptionOptions() SubscriptionOptions {
+	suppress := false
+	qos := uint32(defaultQosMarking)
+	return SubscriptionOptions{
+		Mode:              defaultSubscriptionMode,
+		Encoding:          defaultEncoding,
+		SuppressRedundant: &suppress,
+		QosMarking:        &qos,
+	}
+}
+
+// WithDefaults returns the options in which the empty fields are filled in with
+// the defaults.
+func (o SubscriptionOptions) WithDefaults(def SubscriptionOptions) SubscriptionOptions {
+	if o.Mode == "" {
+		o.Mode = def.Mode
+	}
+	if o.SampleInterval == 0 {
+		o.SampleInterval = def.SampleInterval
+	}
+	if o.HeartbeatInterval == 0 {
+		o.HeartbeatInterval = def.HeartbeatInterval
+	}
+	if o.SuppressRedundant == nil {
+		o.SuppressRedundant = def.SuppressRedundant
+	}
+	if o.Encoding == "" {
+		o.Encoding = def.Encoding
+	}
+	if o.QosMarking == nil {
+		o.QosMarking = def.QosMarking
+	}
+	return o
+}
+
+// Equal returns true when the options are the same.
+func (o SubscriptionOptions) Equal(other SubscriptionOptions) bool {
+	return o.Mode == other.Mode &&
+		o.SampleInterval == other.SampleInterval &&
+		o.HeartbeatInterval == other.HeartbeatInterval &&
+		boolValue(o.SuppressRedundant) == boolValue(other.SuppressRedundant) &&
+		strings.ToLower(o.Encoding) == strings.ToLower(other.Encoding) &&
+		uint32Value(o.QosMarking) == uint32Value(other.QosMarking) &&
+		stringValue(o.Prefix, "") == stringValue(other.Prefix, "")
+}
+
+// Validate returns an error when the mode or encoding is unknown or a sample
+// subscription has no sample interval.
+func (o SubscriptionOptions) Validate() error {
+	if _, err := subscriptionMode(o.Mode); err != nil {
+		return err
+	}
+	if _, ok := encodings[strings.ToLower(o.Encoding)]; !ok {
+		return fmt.Errorf(errFmtUnknownEncoding, o.Encoding)
+	}
+	if o.Mode == SubscriptionModeSample && o.SampleInterval == 0 {
+		return fmt.Errorf(errSampleIntervalRequired)
+	}
+	return nil
+}
+
+func subscriptionMode(mode string) (gnmi.SubscriptionMode, error) {
+	switch mode {
+	case SubscriptionModeOnChange:
+		return gnmi.SubscriptionMode_ON_CHANGE, nil
+	case SubscriptionModeSample:
+		return gnmi.SubscriptionMode_SAMPLE, nil
+	case SubscriptionModeTargetDefined:
+		return gnmi.SubscriptionMode_TARGET_DEFINED, nil
+	}
+	return 0, fmt.Errorf(errFmtUnknownSubscriptionMode, mode)
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+func stringValue(s *string, def string) string {
+	if s == nil {
+		return def
+	}
+	return *s
+}
+
+func uint32Value(u *uint32) uint32 {
+	if u == nil {
+		return 0
+	}
+	return *u
+}
+
 // CreateSubscriptionRequest create a gnmi subscription
-func CreateSubscriptionRequest(target, subName string, paths []*gnmi.Path) (*gnmi.SubscribeRequest, error) {
+func CreateSubscriptionRequest(target, subName string, paths []*gnmi.Path, opts SubscriptionOptions) (*gnmi.SubscribeRequest, error) {
 	// create subscription
 
-	gnmiPrefix, err := utils.CreatePrefix(subName, target)
+	gnmiPrefix, err := utils.CreatePrefix(stringValue(opts.Prefix, subName), target)
 	if err != nil {
 		return nil, fmt.Errorf("create prefix failed")
 	}
-	modeVal := gnmi.SubscriptionList_Mode_value[strings.ToUpper("STREAM")]
-	qos := &gnmi.QOSMarking{Marking: 21}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	mode, _ := subscriptionMode(opts.Mode)
 
 	subscriptions := make([]*gnmi.Subscription, len(paths))
 	for i, p := range paths {
-		subscriptions[i] = &gnmi.Subscription{Path: p}
-		switch gnmi.SubscriptionList_Mode(modeVal) {
-		case gnmi.SubscriptionList_STREAM:
-			mode := gnmi.SubscriptionMode_value[strings.Replace(strings.ToUpper("ON_CHANGE"), "-", "_", -1)]
-			subscriptions[i].Mode = gnmi.SubscriptionMode(mode)
+		subscriptions[i] = &gnmi.Subscription{
+			Path:              p,
+			Mode:              mode,
+			SuppressRedundant: boolValue(opts.SuppressRedundant),
+			HeartbeatInterval: uint64(opts.HeartbeatInterval.Nanoseconds()),
+		}
+		if mode == gnmi.SubscriptionMode_SAMPLE {
+			subscriptions[i].SampleInterval = uint64(opts.SampleInterval.Nanoseconds())
 		}
 	}
+	subList := &gnmi.SubscriptionList{
+		Prefix:       gnmiPrefix,
+		Mode:         gnmi.SubscriptionList_STREAM,
+		Encoding:     encodings[strings.ToLower(opts.Encoding)],
+		Subscription: subscriptions,
+	}
+	if opts.QosMarking != nil {
+		subList.Qos = &gnmi.QOSMarking{Marking: *opts.QosMarking}
+	}
 	req := &gnmi.SubscribeRequest{
 		Request: &gnmi.SubscribeRequest_Subscribe{
-			Subscribe: &gnmi.SubscriptionList{
-				Prefix:       gnmiPrefix,
-				Mode:         gnmi.SubscriptionList_Mode(modeVal),
-				Encoding:     46, // "JSON_IETF_CONFIG_ONLY"
-				Subscription: subscriptions,
-				Qos:          qos,
-			},
+			Subscribe: subList,
 		},
 	}
 	return req, nil
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// reconnect backoff
+	defaultReconnectInitial = 1 * time.Second
+	defaultReconnectFactor  = 2.0
+	defaultReconnectJitter  = 0.2
+	defaultReconnectCap     = 5 * time.Minute
+
+	// telemetryErrorLogInterval is the interval at which the errors of a
+	// telemetry subscription are logged
+	telemetryErrorLogInterval = time.Minute
+)
+
+var (
+	subscriptionReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ndd_srl_subscription_reconnects_total",
+		Help: "Number of reconnect attempts of the gnmi subscription of a target",
+	}, []string{"target"})
+	subscriptionConnected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ndd_srl_subscription_connected",
+		Help: "Whether the gnmi subscription of a target is connected (1) or not (0)",
+	}, []string{"target"})
+	telemetrySubscriptionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ndd_srl_telemetry_subscription_errors_total",
+		Help: "Number of errors of a telemetry subscription of a target",
+	}, []string{"target", "subscription"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(subscriptionReconnects, subscriptionConnected, telemetrySubscriptionErrors)
+}
+
+// subscriptionErrors counts the errors of a telemetry subscription, such that
+// a failing subscription is logged at most once per log interval.
+type subscriptionErrors struct {
+	// suppressed are the errors since the last logged error
+	suppressed int
+	logged     time.Time
+}
+
+// record counts the error and returns true with the number of suppressed errors
+// when the error is to be logged.
+func (e *subscriptionErrors) record(now time.Time) (bool, int) {
+	if !e.logged.IsZero() && now.Sub(e.logged) < telemetryErrorLogInterval {
+		e.suppressed++
+		return false, 0
+	}
+	suppressed := e.suppressed
+	e.suppressed = 0
+	e.logged = now
+	return true, suppressed
+}
+
+// newReconnectBackoff returns the jittered exponential backoff between the
+// reconnect attempts of a subscription.
+func newReconnectBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: defaultReconnectInitial,
+		Factor:   defaultReconnectFactor,
+		Jitter:   defaultReconnectJitter,
+		Steps:    math.MaxInt32,
+		Cap:      defaultReconnectCap,
+	}
+}
+
+// A TargetStatus is the state of the subscription of a target.
+type TargetStatus struct {
+	Connected          bool
+	ReconnectAttempts  int32
+	LastError          string
+	LastTransitionTime time.Time
+}
+
+// SubscriptionStatus holds the state of the subscriptions of the targets, it is
+// updated by the deviation server and reported by the registration.
+type SubscriptionStatus struct {
+	mu      sync.RWMutex
+	targets map[string]TargetStatus
+}
+
+// NewSubscriptionStatus returns an empty SubscriptionStatus.
+func NewSubscriptionStatus() *SubscriptionStatus {
+	return &SubscriptionStatus{targets: make(map[string]TargetStatus)}
+}
+
+// Get returns the state of the subscription of the target.
+func (s *SubscriptionStatus) Get(name string) (TargetStatus, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ts, ok := s.targets[name]
+	return ts, ok
+}
+
+// connected records the subscription of the target is connected, the reconnect
+// attempts are reset.
+func (s *SubscriptionStatus) connected(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := s.targets[name]
+	if !ts.Connected {
+		ts.LastTransitionTime = time.Now()
+	}
+	ts.Connected = true
+	ts.ReconnectAttempts = 0
+	s.targets[name] = ts
+	subscriptionConnected.WithLabelValues(name).Set(1)
+}
+
+// failed records the subscription of the target failed with the error and a
+// reconnect is attempted.
+func (s *SubscriptionStatus) failed(name string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := s.targets[name]
+	if ts.Connected || ts.LastTransitionTime.IsZero() {
+		ts.LastTransitionTime = time.Now()
+	}
+	ts.Connected = false
+	ts.ReconnectAttempts++
+	if err != nil {
+		ts.LastError = err.Error()
+	}
+	s.targets[name] = ts
+	subscriptionConnected.WithLabelValues(name).Set(0)
+	subscriptionReconnects.WithLabelValues(name).Inc()
+}
+
+// delete removes the state of the subscription of a deleted target.
+func (s *SubscriptionStatus) delete(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.targets, name)
+	subscriptionConnected.DeleteLabelValues(name)
+	subscriptionReconnects.DeleteLabelValues(name)
+}
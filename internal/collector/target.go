@@ -18,14 +18,25 @@ package collector
 
 import (
 	"context"
+	"reflect"
+	"sync"
 	"time"
 
 	"github.com/karimra/gnmic/target"
 	"github.com/karimra/gnmic/types"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
 	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/shard"
 )
 
 const (
@@ -33,7 +44,9 @@ const (
 	configSubscription = "ConfigChangesubscription"
 
 	// errors
-	errCreateGnmiClient = "cannot create gnmi client"
+	errCreateGnmiClient   = "cannot create gnmi client"
+	errFmtNotManagedList  = "%s has no managed resource list"
+	errListNetworkNodeRes = "cannot list the resources of the network node"
 
 	// timers
 	defaultTimeout = 5 * time.Second
@@ -48,6 +61,8 @@ const (
 	TargetAdd TargetAction = "target added"
 	// stop
 	TargetDelete TargetAction = "target deleted"
+	// stop all targets of the owner
+	TargetDeleteOwner TargetAction = "targets of owner deleted"
 )
 
 // TargetUpdate identifies the update actions on the target
@@ -55,6 +70,20 @@ type TargetUpdate struct {
 	Name         string
 	Action       TargetAction
 	TargetConfig *types.TargetConfig
+	// Owner is the registration the target belongs to, a target is only deleted
+	// by its owner such that a target that moved to another registration is not
+	// deleted by the registration it moved from
+	Owner string
+	// SubscriptionOptions of the target, the empty options are filled in with
+	// the defaults of the deviation server
+	SubscriptionOptions SubscriptionOptions
+}
+
+// A dialFunc creates the gnmi client of the target.
+type dialFunc func(ctx context.Context, t *target.Target) error
+
+func dialTarget(ctx context.Context, t *target.Target) error {
+	return t.CreateGNMIClient(ctx)
 }
 
 // DeviationServer contains the device driver information
@@ -63,19 +92,66 @@ type DeviationServer struct {
 	tuCh     chan TargetUpdate
 	log      logging.Logger
 	stopCh   chan struct{}
-	Targets  map[string]*Target
-	ctx      context.Context
+	kube     client.Client
+	status   *SubscriptionStatus
+	options  SubscriptionOptions
+	// telemetry holds the telemetry profiles of which the subscriptions are
+	// made to the targets
+	telemetry *Telemetry
+	// config is notified of the state of the config subscriptions and of the
+	// config changes they report
+	config ConfigWatcher
+	// shards of the network nodes across the provider replicas, only the targets
+	// of the shard of the replica are subscribed
+	shards *shard.Sharder
+	// dial creates the gnmi clients of the targets
+	dial dialFunc
+	// mu protects the Targets
+	mu      sync.Mutex
+	Targets map[string]*Target
 }
 
 // Target defines the parameters for a Target
 type Target struct {
 	Config    *types.TargetConfig
+	Options   SubscriptionOptions
+	Owner     string
 	Target    *target.Target
-	StopCh    chan struct{}
 	log       logging.Logger
 	Collector *GNMICollector
+	// cancel stops the subscription handler of the target, done is closed when
+	// the subscription handler returned
+	cancel context.CancelFunc
+	done   chan struct{}
+	// telemetry subscriptions of the target, telemetryCh notifies a change of
+	// the telemetry profiles, active are the running telemetry subscriptions
+	// and errors the errors of the running telemetry subscriptions
+	telemetry   *Telemetry
+	telemetryCh chan struct{}
+	active      map[string]TelemetrySubscription
+	errors      map[string]*subscriptionErrors
+	// config is notified of the config changes of the target
+	config ConfigWatcher
 }
 
+// A ConfigWatcher is notified of the config subscriptions of the targets, e.g.
+// to keep a cache of the config of the targets fresh.
+type ConfigWatcher interface {
+	// Synced is called when the config subscription of the target is synced
+	Synced(target string)
+	// Changed is called with the notification of a change reported by the
+	// config subscription
+	Changed(target string, n *gnmi.Notification)
+	// Unsynced is called when the config subscription of the target stopped
+	Unsynced(target string)
+}
+
+type nopConfigWatcher struct{}
+
+func (nopConfigWatcher) Synced(string)                      {}
+func (nopConfigWatcher) Changed(string, *gnmi.Notification) {}
+func (nopConfigWatcher) Unsynced(string)                    {}
+
 // Option is a function to initialize the options
 type Option func(d *DeviationServer)
 
@@ -100,7 +176,56 @@ func WithLogging(l logging.Logger) Option {
 	}
 }
 
-// WithStopChannel initializes the deviation server with stop channel info
+// WithClient initializes the deviation server with the client used to list the
+// resources of a network node, which are reconciled after a reconnect
+func WithClient(c client.Client) Option {
+	return func(d *DeviationServer) {
+		d.kube = c
+	}
+}
+
+// WithSubscriptionStatus initializes the deviation server with the status in
+// which the state of the subscriptions of the targets is recorded
+func WithSubscriptionStatus(s *SubscriptionStatus) Option {
+	return func(d *DeviationServer) {
+		d.status = s
+	}
+}
+
+// WithSubscriptionOptions initializes the deviation server with the default
+// options of the subscriptions
+func WithSubscriptionOptions(o SubscriptionOptions) Option {
+	return func(d *DeviationServer) {
+		d.options = o.WithDefaults(DefaultSubscriptionOptions())
+	}
+}
+
+// WithTelemetry initializes the deviation server with the telemetry profiles of
+// which the subscriptions are made to the targets
+func WithTelemetry(t *Telemetry) Option {
+	return func(d *DeviationServer) {
+		d.telemetry = t
+	}
+}
+
+// WithConfigWatcher initializes the deviation server with the watcher that is
+// notified of the config subscriptions of the targets
+func WithConfigWatcher(w ConfigWatcher) Option {
+	return func(d *DeviationServer) {
+		d.config = w
+	}
+}
+
+// WithSharder initializes the deviation server with the sharder of the network
+// nodes across the provider replicas
+func WithSharder(s *shard.Sharder) Option {
+	return func(d *DeviationServer) {
+		d.shards = s
+	}
+}
+
+// WithStopChannel initializes the deviation server with a stop channel, the
+// deviation server stops when the channel is closed
 func WithStopChannel(stopCh chan struct{}) Option {
 	return func(d *DeviationServer) {
 		d.stopCh = stopCh
@@ -111,7 +236,10 @@ func WithStopChannel(stopCh chan struct{}) Option {
 func NewDeviationServer(opts ...Option) *DeviationServer {
 	s := &DeviationServer{
 		Targets: make(map[string]*Target),
-		ctx:     context.Background(),
+		status:  NewSubscriptionStatus(),
+		options: DefaultSubscriptionOptions(),
+		config:  nopConfigWatcher{},
+		dial:    dialTarget,
 	}
 
 	for _, o := range opts {
@@ -121,112 +249,484 @@ func NewDeviationServer(opts ...Option) *DeviationServer {
 	return s
 }
 
-// StartTargetChangeHandler changes to targets, targets can be deleted or created
-// this function handles the changes to the targets
-func (d *DeviationServer) StartTargetChangeHandler() {
+// Start handles the changes to the targets until the context is cancelled or
+// the stop channel is closed, targets can be deleted or created. When the
+// deviation server stops, the subscriptions of all targets are stopped.
+// Start implements manager.Runnable, such that the deviation server is started
+// and stopped together with the manager.
+func (d *DeviationServer) Start(ctx context.Context) error {
 	d.log.Debug("Starting subscription gnmi server...")
 
+	shardCh := d.shards.Subscribe()
 	for {
 		select {
 		case tu := <-d.tuCh:
 			d.log.Debug("subscription server", "Action", tu.Action, "Target", tu.Name)
 
-			if err := d.HandleTargetUpdate(d.ctx, tu); err != nil {
+			if err := d.HandleTargetUpdate(ctx, tu); err != nil {
 				d.log.Debug("HandleSubscription", "Error", err)
 			}
+		case <-d.telemetry.Changed():
+			d.log.Debug("telemetry profiles changed")
+			d.notifyTelemetry()
+		case <-shardCh:
+			d.log.Debug("shards changed")
+			d.stopUnowned()
+		case <-ctx.Done():
+			d.log.Debug("stopping subscription handler")
+			d.stopTargets()
+			return nil
 		case <-d.stopCh:
 			d.log.Debug("stopping subscription handler")
-
+			d.stopTargets()
+			return nil
 		}
 	}
 }
 
-// HandleTargetUpdate supports updates of a Target
+// NeedLeaderElection implements manager.LeaderElectionRunnable, only the
+// leader subscribes to the targets.
+func (d *DeviationServer) NeedLeaderElection() bool {
+	return true
+}
+
+// HandleTargetUpdate supports updates of a Target, the subscription of a target
+// is only started when the target has no running subscription with the same
+// options, such that the repeated target updates of the registration do not
+// create duplicates. A subscription of which the options changed is restarted.
 func (d *DeviationServer) HandleTargetUpdate(ctx context.Context, tu TargetUpdate) error {
 	switch tu.Action {
 	case TargetAdd:
+		if !d.shards.Owns(tu.Name) {
+			d.log.Debug("target in the shard of another replica", "target", tu.Name)
+			return d.HandleTargetUpdate(ctx, TargetUpdate{Name: tu.Name, Action: TargetDelete})
+		}
+		opts := tu.SubscriptionOptions.WithDefaults(d.options)
+		if err := opts.Validate(); err != nil {
+			return err
+		}
+		d.mu.Lock()
 		// it is possible that during a restart the subscription got removed
-		if _, ok := d.Targets[tu.Name]; !ok {
-			t := target.NewTarget(tu.TargetConfig)
-			d.log.Debug("Target", "Config", tu.TargetConfig, "Target", t)
+		if t, ok := d.Targets[tu.Name]; ok {
+			if t.Options.Equal(opts) && targetConfigEqual(t.Config, tu.TargetConfig) {
+				t.Owner = tu.Owner
+				d.mu.Unlock()
+				return nil
+			}
+			d.log.Debug("subscription options or target config changed", "target", tu.Name)
+			delete(d.Targets, tu.Name)
+			d.mu.Unlock()
+			t.stop()
+			d.mu.Lock()
+		}
+		defer d.mu.Unlock()
+		// another update of the target could have added it while stopping
+		if _, ok := d.Targets[tu.Name]; ok {
+			d.log.Debug("target added while stopping", "target", tu.Name)
+			return nil
+		}
+
+		sctx, scancel := context.WithCancel(ctx)
+		dt := &Target{
+			log:     d.log,
+			Config:  tu.TargetConfig,
+			Options: opts,
+			Owner:   tu.Owner,
+			cancel:  scancel,
+			done:    make(chan struct{}),
+
+			telemetry:   d.telemetry,
+			telemetryCh: make(chan struct{}, 1),
+			config:      d.config,
+		}
+		d.Targets[tu.Name] = dt
+
+		// the gnmi client is dialed by the subscription handler of the target,
+		// such that a target that does not respond does not block the updates
+		// of the other targets
+		go func() {
+			defer close(dt.done)
+			d.log.Debug("Target", "TargetName", tu.Name, "Config", tu.TargetConfig)
+			d.runTarget(sctx, tu.Name, dt)
+		}()
 
-			ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
-			defer cancel()
+	case TargetDelete:
+		d.mu.Lock()
+		t, ok := d.Targets[tu.Name]
+		if ok && tu.Owner != "" && t.Owner != tu.Owner {
+			d.mu.Unlock()
+			d.log.Debug("target owned by another registration", "target", tu.Name, "owner", t.Owner)
+			return nil
+		}
+		delete(d.Targets, tu.Name)
+		d.mu.Unlock()
+		if ok {
+			t.stop()
+		}
+		d.status.delete(tu.Name)
+
+	case TargetDeleteOwner:
+		d.mu.Lock()
+		targets := make(map[string]*Target)
+		for name, t := range d.Targets {
+			if t.Owner == tu.Owner {
+				targets[name] = t
+				delete(d.Targets, name)
+			}
+		}
+		d.mu.Unlock()
+		for name, t := range targets {
+			d.log.Debug("stopping target of deleted owner", "target", name, "owner", tu.Owner)
+			t.stop()
+			d.status.delete(name)
+		}
+	}
+	return nil
+}
 
-			if err := t.CreateGNMIClient(ctx); err != nil {
-				d.log.Debug("Error Creating client", "Error", err)
-				return errors.Wrap(err, errCreateGnmiClient)
+// runTarget dials the target and runs its subscription until the context is
+// cancelled. When the dial or the subscription fails the target is reconnected
+// with a jittered exponential backoff, the reconnect attempts and the last error
+// are recorded in the subscription status. After the target is resynced, all
+// resources of the network node are reconciled to detect the changes that were
+// missed while the subscription was disconnected.
+func (d *DeviationServer) runTarget(ctx context.Context, name string, t *Target) {
+	backoff := newReconnectBackoff()
+	subscribed := false
+	for {
+		if err := t.connect(ctx, d.dial); err != nil {
+			if ctx.Err() != nil {
+				return
 			}
-			d.Targets[tu.Name] = &Target{
-				log:       d.log,
-				Config:    tu.TargetConfig,
-				Target:    t,
-				StopCh:    make(chan struct{}),
-				Collector: NewGNMICollector(t, WithDeviceCollectorLogger(d.log)),
+			d.log.Debug("connect failed", "target", name, "error", err)
+			d.status.failed(name, err)
+		} else {
+			reconnected := subscribed
+			subscribed = true
+			err := t.StartGnmiSubscriptionHandler(ctx, func() {
+				d.status.connected(name)
+				d.config.Synced(name)
+				backoff = newReconnectBackoff()
+				if reconnected {
+					reconnected = false
+					go d.reconcileNetworkNode(ctx, name)
+				}
+			})
+			t.Target.Stop()
+			// without a subscription the config changes of the target are missed
+			d.config.Unsynced(name)
+			if ctx.Err() != nil {
+				return
 			}
+			d.log.Debug("subscription failed", "target", name, "error", err)
+			d.status.failed(name, err)
+		}
 
-			// start gnmi subscription handler
-			go func() {
-				d.log.Debug("Target", "TargetName", tu.Name, "Target Info", d.Targets[tu.Name].Target)
+		select {
+		case <-time.After(backoff.Step()):
+		case <-ctx.Done():
+			return
+		}
+		d.log.Debug("reconnecting", "target", name)
+	}
+}
 
-				d.Targets[tu.Name].StartGnmiSubscriptionHandler(d.ctx)
-				// we should delete the target since an error occurred and we will get
-				// most likely a device driver got restarted
-				delete(d.Targets, tu.Name)
+// reconcileNetworkNode triggers a reconcile of all resources of the network
+// node, which are the resources that reference the network node and the
+// resources that are fanned out to it by their network node selector.
+func (d *DeviationServer) reconcileNetworkNode(ctx context.Context, name string) {
+	if d.kube == nil {
+		return
+	}
+	nn := &ndrv1.NetworkNode{}
+	if err := d.kube.Get(ctx, client.ObjectKey{Name: name}, nn); err != nil {
+		// without the labels of the network node only the resources that
+		// reference it or are synced to it are found
+		d.log.Debug("cannot get network node", "target", name, "error", err)
+		nn.SetName(name)
+	}
+	for gk, ch := range d.eventChs {
+		l, err := d.newManagedList(gk)
+		if err != nil {
+			d.log.Debug("cannot reconcile network node", "target", name, "error", err)
+			continue
+		}
+		if err := d.kube.List(ctx, l); err != nil {
+			d.log.Debug(errListNetworkNodeRes, "target", name, "error", err)
+			continue
+		}
+		for _, mg := range l.GetItems() {
+			if !appliedToNetworkNode(mg, nn) {
+				continue
+			}
+			select {
+			case ch <- event.GenericEvent{Object: mg}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// A networkNodeSelectable is a managed resource that is fanned out to the
+// network nodes that match its network node selector.
+type networkNodeSelectable interface {
+	GetNetworkNodeSelector() *metav1.LabelSelector
+	GetNetworkNodeSyncStatus() []srlv1.NetworkNodeSyncStatus
+}
 
-			}()
+// appliedToNetworkNode returns true when the managed resource references the
+// network node, is selected for it by its network node selector or has the
+// network node in its sync status.
+func appliedToNetworkNode(mg resource.Managed, nn *ndrv1.NetworkNode) bool {
+	if ref := mg.GetNetworkNodeReference(); ref != nil && ref.Name == nn.GetName() {
+		return true
+	}
+	o, ok := mg.(networkNodeSelectable)
+	if !ok || o.GetNetworkNodeSelector() == nil {
+		return false
+	}
+	for _, s := range o.GetNetworkNodeSyncStatus() {
+		if s.Name == nn.GetName() {
+			return true
 		}
+	}
+	selector, err := metav1.LabelSelectorAsSelector(o.GetNetworkNodeSelector())
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(nn.GetLabels()))
+}
 
-	case TargetDelete:
-		// delete the
-		if _, ok := d.Targets[tu.Name]; ok {
-			if err := d.Targets[tu.Name].Collector.StopSubscription(ctx, configSubscription); err != nil {
-				return err
-			}
-			d.Targets[tu.Name].StopCh <- struct{}{}
+// newManagedList returns the list of the managed resources of the group kind.
+func (d *DeviationServer) newManagedList(groupKind string) (resource.ManagedList, error) {
+	gk := schema.ParseGroupKind(groupKind)
+	obj, err := d.kube.Scheme().New(srlv1.GroupVersion.WithKind(gk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	l, ok := obj.(resource.ManagedList)
+	if !ok {
+		return nil, errors.Errorf(errFmtNotManagedList, groupKind)
+	}
+	return l, nil
+}
+
+// notifyTelemetry notifies all targets of a change of the telemetry profiles.
+func (d *DeviationServer) notifyTelemetry() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, t := range d.Targets {
+		select {
+		case t.telemetryCh <- struct{}{}:
+		default:
 		}
+	}
+}
 
-		delete(d.Targets, tu.Name)
+// stopUnowned stops the subscriptions of the targets that moved to the shard of
+// another replica.
+func (d *DeviationServer) stopUnowned() {
+	d.mu.Lock()
+	targets := make(map[string]*Target)
+	for name, t := range d.Targets {
+		if !d.shards.Owns(name) {
+			targets[name] = t
+			delete(d.Targets, name)
+		}
+	}
+	d.mu.Unlock()
+	for name, t := range targets {
+		d.log.Debug("target moved to another shard", "target", name)
+		t.stop()
+		d.status.delete(name)
+	}
+}
+
+// stopTargets stops the subscriptions of all targets.
+func (d *DeviationServer) stopTargets() {
+	d.mu.Lock()
+	targets := make([]*Target, 0, len(d.Targets))
+	for name, t := range d.Targets {
+		targets = append(targets, t)
+		delete(d.Targets, name)
+	}
+	d.mu.Unlock()
+	for _, t := range targets {
+		t.stop()
+	}
+}
 
+// targetConfigEqual returns true when the target configs connect to the target
+// in the same way.
+func targetConfigEqual(a, b *types.TargetConfig) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
+	return a.Address == b.Address &&
+		stringValue(a.Username, "") == stringValue(b.Username, "") &&
+		stringValue(a.Password, "") == stringValue(b.Password, "") &&
+		a.Timeout == b.Timeout &&
+		a.RetryTimer == b.RetryTimer &&
+		a.BufferSize == b.BufferSize &&
+		boolValue(a.Insecure) == boolValue(b.Insecure) &&
+		boolValue(a.SkipVerify) == boolValue(b.SkipVerify) &&
+		stringValue(a.TLSCA, "") == stringValue(b.TLSCA, "") &&
+		stringValue(a.TLSCert, "") == stringValue(b.TLSCert, "") &&
+		stringValue(a.TLSKey, "") == stringValue(b.TLSKey, "") &&
+		boolValue(a.Gzip) == boolValue(b.Gzip)
+}
+
+// timeoutOf returns the timeout of the gnmi client of the target config.
+func timeoutOf(c *types.TargetConfig) time.Duration {
+	if c == nil || c.Timeout <= 0 {
+		return defaultTimeout
+	}
+	return c.Timeout
+}
+
+// stop stops the subscription handler of the target and waits until it returned.
+func (t *Target) stop() {
+	t.cancel()
+	<-t.done
+}
+
+// connect dials the target within the timeout of the target config and
+// replaces the gnmi client and the collector of the target.
+func (t *Target) connect(ctx context.Context, dial dialFunc) error {
+	nt := target.NewTarget(t.Config)
+	cctx, cancel := context.WithTimeout(ctx, timeoutOf(t.Config))
+	defer cancel()
+	if err := dial(cctx, nt); err != nil {
+		return errors.Wrap(err, errCreateGnmiClient)
+	}
+	t.Target = nt
+	t.Collector = NewGNMICollector(nt, WithDeviceCollectorLogger(t.log))
 	return nil
 }
 
-// StartGnmiSubscriptionHandler starts gnmi subscription
-func (t *Target) StartGnmiSubscriptionHandler(ctx context.Context) {
+// StartGnmiSubscriptionHandler starts gnmi subscription, the subscription stops
+// when the context is cancelled or the subscription of the target fails, in
+// which case the error of the target is returned. The onSync function is called
+// when the target completed the sync of the subscription.
+func (t *Target) StartGnmiSubscriptionHandler(ctx context.Context, onSync func()) error {
 	t.log.Debug("Starting GNMI subscription...", "Target", t.Target.Config.Name)
 
-	t.Collector.Lock()
-	go t.Collector.StartSubscription(ctx, t.Config.Name, configSubscription,
+	if err := t.Collector.StartSubscription(ctx, t.Config.Name, configSubscription,
 		[]*gnmi.Path{{
 			Elem: []*gnmi.PathElem{
 				{Name: "provider-resource-update"},
 			},
-		}})
-	t.Collector.Unlock()
+		}}, t.Options); err != nil {
+		t.log.Debug("subscribe", "error", err)
+		return err
+	}
+	defer t.Collector.StopSubscriptions()
+
+	t.active = make(map[string]TelemetrySubscription)
+	t.errors = make(map[string]*subscriptionErrors)
+	defer t.clearTelemetryErrors()
+	t.syncTelemetry(ctx)
 
-	chanSubResp, chanSubErr := t.Target.ReadSubscriptions()
+	chanSubResp, chanSubErr := t.Collector.ReadSubscriptions()
 
 	for {
 		select {
 		case resp := <-chanSubResp:
+			if resp.SubscriptionName != configSubscription {
+				if err := t.telemetry.export(t.Config.Name, resp.SubscriptionName, resp.Response); err != nil {
+					t.log.Debug("telemetry export", "subscription", resp.SubscriptionName, "error", err)
+				}
+				continue
+			}
 			//log.Infof("SubRsp Response %v", resp)
 			// TODO error handling
+			if resp.Response.GetSyncResponse() {
+				onSync()
+			}
 			t.ReconcileOnChange(resp.Response)
 		case tErr := <-chanSubErr:
+			// a failing telemetry subscription does not affect the config
+			// subscription, the collector retries it
+			if tErr.SubscriptionName != configSubscription {
+				t.telemetryError(tErr.SubscriptionName, tErr.Err)
+				continue
+			}
 			t.log.Debug("subscribe", "error", tErr)
-			return
-		case <-t.StopCh:
+			return tErr.Err
+		case <-t.telemetryCh:
+			t.syncTelemetry(ctx)
+		case <-ctx.Done():
 			t.log.Debug("Stopping subscription process...")
-			return
+			return nil
+		}
+	}
+}
+
+// syncTelemetry starts the telemetry subscriptions of the target that are not
+// running and stops the ones that are no longer selected or that changed.
+func (t *Target) syncTelemetry(ctx context.Context) {
+	desired := t.telemetry.subscriptions(t.Config.Name)
+	for name, sub := range t.active {
+		if d, ok := desired[name]; ok && reflect.DeepEqual(d, sub) {
+			continue
+		}
+		if err := t.Collector.StopSubscription(ctx, name); err != nil {
+			t.log.Debug("telemetry subscription", "subscription", name, "error", err)
 		}
+		delete(t.active, name)
+		delete(t.errors, name)
+		telemetrySubscriptionErrors.DeleteLabelValues(t.Config.Name, name)
+	}
+	for name, sub := range desired {
+		if _, ok := t.active[name]; ok {
+			continue
+		}
+		opts := sub.Options
+		prefix := ""
+		opts.Prefix = &prefix
+		if err := t.Collector.StartSubscription(ctx, t.Config.Name, name, sub.Paths, opts); err != nil {
+			t.log.Debug("telemetry subscription", "subscription", name, "error", err)
+			continue
+		}
+		t.active[name] = sub
+	}
+}
+
+// telemetryError counts the error of the telemetry subscription, the errors of a
+// subscription are logged at most once per log interval with the number of
+// errors that were not logged.
+func (t *Target) telemetryError(name string, err error) {
+	if _, ok := t.active[name]; !ok {
+		return
+	}
+	telemetrySubscriptionErrors.WithLabelValues(t.Config.Name, name).Inc()
+	e, ok := t.errors[name]
+	if !ok {
+		e = &subscriptionErrors{}
+		t.errors[name] = e
+	}
+	if log, suppressed := e.record(time.Now()); log {
+		t.log.Debug("telemetry subscription", "subscription", name, "error", err, "suppressed", suppressed)
 	}
 }
 
-// ReconcileOnChange reconciles an on change update
+// clearTelemetryErrors removes the error counts of the telemetry subscriptions
+// of the stopped subscription handler.
+func (t *Target) clearTelemetryErrors() {
+	for name := range t.active {
+		telemetrySubscriptionErrors.DeleteLabelValues(t.Config.Name, name)
+	}
+}
+
+// ReconcileOnChange reconciles an on change update, the config watcher is
+// notified of every notification with updates or deletes
 func (t *Target) ReconcileOnChange(resp *gnmi.SubscribeResponse) error {
 	switch resp.GetResponse().(type) {
 	case *gnmi.SubscribeResponse_Update:
+		if len(resp.GetUpdate().GetDelete()) != 0 || len(resp.GetUpdate().GetUpdate()) != 0 {
+			t.config.Changed(t.Config.Name, resp.GetUpdate())
+		}
 		// handle deletes
 		du := resp.GetUpdate().Delete
 		for _, del := range du {
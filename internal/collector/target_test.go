@@ -0,0 +1,430 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+func newTestDeviationServer() *DeviationServer {
+	return NewDeviationServer(WithLogging(logging.NewNopLogger()))
+}
+
+// target returns the target with the name of the deviation server.
+func (d *DeviationServer) target(name string) (*Target, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t, ok := d.Targets[name]
+	return t, ok
+}
+
+func (d *DeviationServer) targetCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.Targets)
+}
+
+func addTarget(name, owner, address string) TargetUpdate {
+	return TargetUpdate{
+		Name:         name,
+		Action:       TargetAdd,
+		Owner:        owner,
+		TargetConfig: newTestTargetConfig(name, address),
+	}
+}
+
+func TestHandleTargetUpdate(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	d := newTestDeviationServer()
+	ctx := context.Background()
+	defer d.stopTargets()
+
+	if err := d.HandleTargetUpdate(ctx, addTarget("dev1", "reg1", address)); err != nil {
+		t.Fatalf("TargetAdd: %v", err)
+	}
+	eventually(t, "the target is connected", func() bool {
+		ts, ok := d.status.Get("dev1")
+		return ok && ts.Connected
+	})
+	first, _ := d.target("dev1")
+
+	// a repeated update with the same options keeps the subscription and moves
+	// the target to the new owner
+	if err := d.HandleTargetUpdate(ctx, addTarget("dev1", "reg2", address)); err != nil {
+		t.Fatalf("TargetAdd: %v", err)
+	}
+	if got, _ := d.target("dev1"); got != first {
+		t.Errorf("TargetAdd with the same options: want the subscription to be kept")
+	}
+	if _, total := fs.subscriptions(); total != 1 {
+		t.Errorf("TargetAdd with the same options: want 1 subscription, got %d", total)
+	}
+
+	// a delete of the previous owner does not delete the target
+	if err := d.HandleTargetUpdate(ctx, TargetUpdate{Name: "dev1", Action: TargetDelete, Owner: "reg1"}); err != nil {
+		t.Fatalf("TargetDelete: %v", err)
+	}
+	if _, ok := d.target("dev1"); !ok {
+		t.Errorf("TargetDelete of another owner: want the target to be kept")
+	}
+
+	// changed options restart the subscription
+	tu := addTarget("dev1", "reg2", address)
+	tu.SubscriptionOptions = SubscriptionOptions{Mode: SubscriptionModeSample, SampleInterval: time.Second}
+	if err := d.HandleTargetUpdate(ctx, tu); err != nil {
+		t.Fatalf("TargetAdd: %v", err)
+	}
+	if got, _ := d.target("dev1"); got == first {
+		t.Errorf("TargetAdd with changed options: want the subscription to be restarted")
+	}
+	eventually(t, "the restarted subscription is open", func() bool {
+		open, total := fs.subscriptions()
+		return open == 1 && total == 2
+	})
+
+	if err := d.HandleTargetUpdate(ctx, TargetUpdate{Name: "dev1", Action: TargetDelete, Owner: "reg2"}); err != nil {
+		t.Fatalf("TargetDelete: %v", err)
+	}
+	if _, ok := d.target("dev1"); ok {
+		t.Errorf("TargetDelete of the owner: want the target to be deleted")
+	}
+	if _, ok := d.status.Get("dev1"); ok {
+		t.Errorf("TargetDelete of the owner: want the status to be deleted")
+	}
+	eventually(t, "the subscription is closed", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 0
+	})
+}
+
+func TestHandleTargetUpdateInvalidOptions(t *testing.T) {
+	_, address := newFakeGNMIServer(t)
+	d := newTestDeviationServer()
+
+	tu := addTarget("dev1", "reg1", address)
+	tu.SubscriptionOptions = SubscriptionOptions{Mode: SubscriptionModeSample}
+	if err := d.HandleTargetUpdate(context.Background(), tu); err == nil {
+		t.Errorf("TargetAdd: want error for a sample subscription without interval")
+	}
+	if d.targetCount() != 0 {
+		t.Errorf("TargetAdd with invalid options: want no target")
+	}
+}
+
+func TestHandleTargetUpdateConcurrent(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	d := newTestDeviationServer()
+	ctx := context.Background()
+
+	const workers, rounds, targets = 8, 10, 4
+	owners := []string{"reg1", "reg2"}
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				name := fmt.Sprintf("dev%d", (w+r)%targets)
+				owner := owners[w%len(owners)]
+				var tu TargetUpdate
+				switch (w + r) % 3 {
+				case 0:
+					tu = addTarget(name, owner, address)
+					if r%2 == 1 {
+						tu.SubscriptionOptions = SubscriptionOptions{Mode: SubscriptionModeSample, SampleInterval: time.Second}
+					}
+				case 1:
+					tu = TargetUpdate{Name: name, Action: TargetDelete, Owner: owner}
+				case 2:
+					tu = TargetUpdate{Action: TargetDeleteOwner, Owner: owner}
+				}
+				if err := d.HandleTargetUpdate(ctx, tu); err != nil {
+					t.Errorf("HandleTargetUpdate(%s %s): %v", tu.Action, tu.Name, err)
+				}
+				d.notifyTelemetry()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, owner := range owners {
+		if err := d.HandleTargetUpdate(ctx, TargetUpdate{Action: TargetDeleteOwner, Owner: owner}); err != nil {
+			t.Fatalf("TargetDeleteOwner(%s): %v", owner, err)
+		}
+	}
+	if n := d.targetCount(); n != 0 {
+		t.Errorf("TargetDeleteOwner of all owners: want no targets, got %d", n)
+	}
+	eventually(t, "all subscriptions are closed", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 0
+	})
+}
+
+func TestStartStopsTargets(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	tuCh := make(chan TargetUpdate)
+	d := NewDeviationServer(WithLogging(logging.NewNopLogger()), WithTargetUpdateChannel(tuCh))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.Start(ctx) // nolint:errcheck
+	}()
+	for i := 0; i < 3; i++ {
+		tuCh <- addTarget(fmt.Sprintf("dev%d", i), "reg1", address)
+	}
+	eventually(t, "the subscriptions are open", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 3
+	})
+
+	cancel()
+	<-done
+	if n := d.targetCount(); n != 0 {
+		t.Errorf("Start stopped: want no targets, got %d", n)
+	}
+	eventually(t, "all subscriptions are closed", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 0
+	})
+}
+
+// newTestTelemetry returns a Telemetry of which the prometheus sink is not
+// registered, such that every test has its own.
+func newTestTelemetry() *Telemetry {
+	return &Telemetry{
+		profiles:   make(map[string]TelemetryProfile),
+		changed:    make(chan struct{}, 1),
+		prometheus: newPrometheusSink(),
+		files:      make(map[string]*jsonSink),
+	}
+}
+
+func testProfile(name string, nodes ...string) TelemetryProfile {
+	return TelemetryProfile{
+		Name:         name,
+		NetworkNodes: nodes,
+		Subscriptions: []TelemetrySubscription{{
+			Name:    "interfaces",
+			Paths:   testPaths(),
+			Options: SubscriptionOptions{Mode: SubscriptionModeSample, SampleInterval: time.Second}.WithDefaults(DefaultSubscriptionOptions()),
+		}},
+		Sink: TelemetrySinkPrometheus,
+	}
+}
+
+func TestTelemetryProfileDeleted(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	telemetry := newTestTelemetry()
+	d := NewDeviationServer(WithLogging(logging.NewNopLogger()), WithTelemetry(telemetry))
+	ctx := context.Background()
+	defer d.stopTargets()
+
+	if err := telemetry.SetProfile(testProfile("p1", "dev1")); err != nil {
+		t.Fatalf("SetProfile: %v", err)
+	}
+	if err := d.HandleTargetUpdate(ctx, addTarget("dev1", "reg1", address)); err != nil {
+		t.Fatalf("TargetAdd: %v", err)
+	}
+	eventually(t, "the config and telemetry subscriptions are open", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 2
+	})
+
+	telemetry.DeleteProfile("p1")
+	d.notifyTelemetry()
+	eventually(t, "the telemetry subscription of the deleted profile is closed", func() bool {
+		open, _ := fs.subscriptions()
+		return open == 1
+	})
+	_, total := fs.subscriptions()
+	time.Sleep(100 * time.Millisecond)
+	if open, now := fs.subscriptions(); open != 1 || now != total {
+		t.Errorf("DeleteProfile: want the telemetry subscription to stay closed, got %d open and %d new subscriptions", open, now-total)
+	}
+}
+
+// countingLogger counts the debug messages with the message.
+type countingLogger struct {
+	msg   string
+	mu    *sync.Mutex
+	count *int
+}
+
+func (l countingLogger) Info(msg string, keysAndValues ...interface{}) {}
+
+func (l countingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	if msg != l.msg {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	*l.count++
+}
+
+func (l countingLogger) WithValues(keysAndValues ...interface{}) logging.Logger {
+	return l
+}
+
+func (l countingLogger) logged() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return *l.count
+}
+
+func TestTelemetrySubscriptionErrors(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	fs.failElem = testPaths()[0].GetElem()[0].GetName()
+	telemetry := newTestTelemetry()
+	log := countingLogger{msg: "telemetry subscription", mu: &sync.Mutex{}, count: new(int)}
+	d := NewDeviationServer(WithLogging(log), WithTelemetry(telemetry))
+	ctx := context.Background()
+	defer d.stopTargets()
+
+	if err := telemetry.SetProfile(testProfile("p1", "dev1")); err != nil {
+		t.Fatalf("SetProfile: %v", err)
+	}
+	tu := addTarget("dev1", "reg1", address)
+	tu.TargetConfig.RetryTimer = time.Millisecond
+	if err := d.HandleTargetUpdate(ctx, tu); err != nil {
+		t.Fatalf("TargetAdd: %v", err)
+	}
+	name := telemetrySubscriptionName("p1", "interfaces")
+	eventually(t, "the errors of the telemetry subscription are counted", func() bool {
+		return testutil.ToFloat64(telemetrySubscriptionErrors.WithLabelValues("dev1", name)) >= 5
+	})
+	if n := log.logged(); n != 1 {
+		t.Errorf("telemetry subscription errors: want 1 logged error per log interval, got %d", n)
+	}
+
+	// the failing subscription of a deleted profile is stopped
+	telemetry.DeleteProfile("p1")
+	d.notifyTelemetry()
+	eventually(t, "the failing telemetry subscription is stopped", func() bool {
+		_, total := fs.subscriptions()
+		time.Sleep(50 * time.Millisecond)
+		_, now := fs.subscriptions()
+		return now == total
+	})
+	if open, _ := fs.subscriptions(); open != 1 {
+		t.Errorf("DeleteProfile: want only the config subscription open, got %d", open)
+	}
+}
+
+func TestHandleTargetUpdateDialHangs(t *testing.T) {
+	fs, address := newFakeGNMIServer(t)
+	tuCh := make(chan TargetUpdate)
+	d := NewDeviationServer(WithLogging(logging.NewNopLogger()), WithTargetUpdateChannel(tuCh))
+	dialing := make(chan struct{})
+	d.dial = func(ctx context.Context, tg *target.Target) error {
+		if tg.Config.Name == "hang" {
+			// the dial of the target does not respond until its timeout
+			close(dialing)
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return dialTarget(ctx, tg)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.Start(ctx) // nolint:errcheck
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	send := func(tu TargetUpdate) {
+		t.Helper()
+		select {
+		case tuCh <- tu:
+		case <-time.After(time.Second):
+			t.Fatalf("%s %s: the target update is blocked", tu.Action, tu.Name)
+		}
+	}
+	send(addTarget("hang", "reg1", address))
+	<-dialing
+	send(addTarget("dev1", "reg1", address))
+	eventually(t, "the other target is connected while the dial hangs", func() bool {
+		ts, ok := d.status.Get("dev1")
+		return ok && ts.Connected
+	})
+	if open, _ := fs.subscriptions(); open != 1 {
+		t.Errorf("subscriptions: want 1 open subscription, got %d", open)
+	}
+
+	// the hanging dial is stopped together with its target
+	send(TargetUpdate{Name: "hang", Action: TargetDelete, Owner: "reg1"})
+	eventually(t, "the target with the hanging dial is deleted", func() bool {
+		_, ok := d.target("hang")
+		return !ok
+	})
+}
+
+func TestAppliedToNetworkNode(t *testing.T) {
+	nn := &ndrv1.NetworkNode{ObjectMeta: metav1.ObjectMeta{Name: "dev1", Labels: map[string]string{"role": "leaf"}}}
+	ntp := func(ref string, selector map[string]string, synced ...string) *srlv1.SrlSystemNtp {
+		mg := &srlv1.SrlSystemNtp{}
+		if ref != "" {
+			mg.SetNetworkNodeReference(&nddv1.Reference{Name: ref})
+		}
+		if selector != nil {
+			mg.Spec.NetworkNodeSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		for _, name := range synced {
+			mg.Status.NetworkNodes = append(mg.Status.NetworkNodes, srlv1.NetworkNodeSyncStatus{Name: name})
+		}
+		return mg
+	}
+	cases := map[string]struct {
+		mg   *srlv1.SrlSystemNtp
+		want bool
+	}{
+		"ReferencesNode":      {mg: ntp("dev1", nil), want: true},
+		"ReferencesOtherNode": {mg: ntp("dev2", nil), want: false},
+		"SelectsNode":         {mg: ntp("", map[string]string{"role": "leaf"}), want: true},
+		"SelectsOtherNodes":   {mg: ntp("", map[string]string{"role": "spine"}), want: false},
+		// a network node that is no longer selected still has the resource
+		"SyncedToNode":      {mg: ntp("", map[string]string{"role": "spine"}, "dev1"), want: true},
+		"SyncedToOtherNode": {mg: ntp("", map[string]string{"role": "spine"}, "dev2"), want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := appliedToNetworkNode(tc.mg, nn); got != tc.want {
+				t.Errorf("appliedToNetworkNode: want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
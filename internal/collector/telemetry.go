@@ -0,0 +1,561 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// telemetrySubscriptionPrefix is the prefix of the names of the telemetry
+	// subscriptions, <prefix>/<profile>/<subscription>
+	telemetrySubscriptionPrefix = "telemetry"
+
+	// telemetryMetricPrefix is the prefix of the names of the exported metrics
+	telemetryMetricPrefix = "srl"
+
+	// telemetry sinks
+	TelemetrySinkPrometheus = "prometheus"
+	TelemetrySinkFile       = "file"
+	TelemetrySinkStdout     = "stdout"
+
+	// errors
+	errOpenTelemetryFile = "cannot open telemetry file"
+	errWriteTelemetry    = "cannot write telemetry update"
+)
+
+// A TelemetrySubscription is a subscription of a telemetry profile.
+type TelemetrySubscription struct {
+	Name    string
+	Paths   []*gnmi.Path
+	Options SubscriptionOptions
+}
+
+// A TelemetryProfile names the subscriptions that are made to the network
+// nodes and the sink the received updates are exported to.
+type TelemetryProfile struct {
+	Name          string
+	NetworkNodes  []string
+	Subscriptions []TelemetrySubscription
+	// Sink is prometheus, file or stdout
+	Sink string
+	// Path of the file when the sink is file
+	Path string
+}
+
+// Telemetry holds the telemetry profiles, it is updated by the telemetry profile
+// reconciler and applied to the targets by the deviation server.
+type Telemetry struct {
+	mu       sync.RWMutex
+	profiles map[string]TelemetryProfile
+	changed  chan struct{}
+
+	prometheus *prometheusSink
+	stdout     *jsonSink
+	files      map[string]*jsonSink
+}
+
+// NewTelemetry returns a Telemetry without profiles, the prometheus sink is
+// registered in the metrics registry of the manager.
+func NewTelemetry() *Telemetry {
+	t := &Telemetry{
+		profiles:   make(map[string]TelemetryProfile),
+		changed:    make(chan struct{}, 1),
+		prometheus: newPrometheusSink(),
+		stdout:     &jsonSink{w: os.Stdout},
+		files:      make(map[string]*jsonSink),
+	}
+	metrics.Registry.MustRegister(t.prometheus)
+	return t
+}
+
+// SetProfile adds or updates a telemetry profile.
+func (t *Telemetry) SetProfile(p TelemetryProfile) error {
+	if p.Sink == TelemetrySinkFile {
+		if _, err := t.file(p.Path); err != nil {
+			return err
+		}
+	}
+	t.mu.Lock()
+	t.profiles[p.Name] = p
+	t.mu.Unlock()
+	t.prometheus.deleteProfile(p.Name, p.NetworkNodes)
+	t.notify()
+	return nil
+}
+
+// DeleteProfile deletes a telemetry profile.
+func (t *Telemetry) DeleteProfile(name string) {
+	t.mu.Lock()
+	_, ok := t.profiles[name]
+	delete(t.profiles, name)
+	t.mu.Unlock()
+	if ok {
+		t.prometheus.deleteProfile(name, nil)
+		t.notify()
+	}
+}
+
+// Changed returns the channel on which a change of the profiles is notified.
+func (t *Telemetry) Changed() <-chan struct{} {
+	if t == nil {
+		return nil
+	}
+	return t.changed
+}
+
+func (t *Telemetry) notify() {
+	select {
+	case t.changed <- struct{}{}:
+	default:
+	}
+}
+
+// subscriptions returns the telemetry subscriptions of the target, keyed by the
+// name of the subscription.
+func (t *Telemetry) subscriptions(target string) map[string]TelemetrySubscription {
+	subs := make(map[string]TelemetrySubscription)
+	if t == nil {
+		return subs
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, p := range t.profiles {
+		selected := false
+		for _, nn := range p.NetworkNodes {
+			if nn == target {
+				selected = true
+			}
+		}
+		if !selected {
+			continue
+		}
+		for _, s := range p.Subscriptions {
+			subs[telemetrySubscriptionName(p.Name, s.Name)] = s
+		}
+	}
+	return subs
+}
+
+func telemetrySubscriptionName(profile, sub string) string {
+	return telemetrySubscriptionPrefix + "/" + profile + "/" + sub
+}
+
+// isTelemetrySubscription returns the profile and subscription of the name of
+// a telemetry subscription.
+func isTelemetrySubscription(subName string) (string, string, bool) {
+	split := strings.SplitN(subName, "/", 3)
+	if len(split) != 3 || split[0] != telemetrySubscriptionPrefix {
+		return "", "", false
+	}
+	return split[1], split[2], true
+}
+
+func (t *Telemetry) file(path string) (*jsonSink, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.files[path]; ok {
+		return s, nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, errOpenTelemetryFile)
+	}
+	s := &jsonSink{w: f}
+	t.files[path] = s
+	return s, nil
+}
+
+// export exports the response of a telemetry subscription of the target to the
+// sink of its profile.
+func (t *Telemetry) export(target, subName string, resp *gnmi.SubscribeResponse) error {
+	if t == nil {
+		return nil
+	}
+	n := resp.GetUpdate()
+	if n == nil {
+		return nil
+	}
+	profile, sub, ok := isTelemetrySubscription(subName)
+	if !ok {
+		return nil
+	}
+	t.mu.RLock()
+	p, ok := t.profiles[profile]
+	t.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	switch p.Sink {
+	case TelemetrySinkPrometheus:
+		t.prometheus.update(target, profile, n)
+		return nil
+	case TelemetrySinkFile:
+		s, err := t.file(p.Path)
+		if err != nil {
+			return err
+		}
+		return s.write(target, profile, sub, n)
+	default:
+		return t.stdout.write(target, profile, sub, n)
+	}
+}
+
+// A jsonSink writes the updates as JSON lines.
+type jsonSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+type jsonUpdate struct {
+	Timestamp    time.Time   `json:"timestamp"`
+	NetworkNode  string      `json:"networkNode"`
+	Profile      string      `json:"profile"`
+	Subscription string      `json:"subscription"`
+	Path         string      `json:"path"`
+	Value        interface{} `json:"value,omitempty"`
+	Deleted      bool        `json:"deleted,omitempty"`
+}
+
+func (s *jsonSink) write(target, profile, sub string, n *gnmi.Notification) error {
+	ts := time.Unix(0, n.GetTimestamp())
+	lines := make([][]byte, 0, len(n.GetUpdate())+len(n.GetDelete()))
+	for _, u := range n.GetUpdate() {
+		v, err := TypedValue(u.GetVal())
+		if err != nil {
+			continue
+		}
+		b, err := json.Marshal(jsonUpdate{
+			Timestamp:    ts,
+			NetworkNode:  target,
+			Profile:      profile,
+			Subscription: sub,
+			Path:         PathString(n.GetPrefix(), u.GetPath()),
+			Value:        v,
+		})
+		if err != nil {
+			return errors.Wrap(err, errWriteTelemetry)
+		}
+		lines = append(lines, b)
+	}
+	for _, d := range n.GetDelete() {
+		b, err := json.Marshal(jsonUpdate{
+			Timestamp:    ts,
+			NetworkNode:  target,
+			Profile:      profile,
+			Subscription: sub,
+			Path:         PathString(n.GetPrefix(), d),
+			Deleted:      true,
+		})
+		if err != nil {
+			return errors.Wrap(err, errWriteTelemetry)
+		}
+		lines = append(lines, b)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, l := range lines {
+		if _, err := s.w.Write(append(l, '\n')); err != nil {
+			return errors.Wrap(err, errWriteTelemetry)
+		}
+	}
+	return nil
+}
+
+// A prometheusSink exposes the numeric values of the updates as gauges. The name
+// of a metric is derived from the element names of the path, the labels from
+// the network node and the keys of the path.
+type prometheusSink struct {
+	mu      sync.RWMutex
+	samples map[string]promSample
+}
+
+type promSample struct {
+	profile     string
+	networkNode string
+	name        string
+	labels      []string
+	values      []string
+	value       float64
+}
+
+func newPrometheusSink() *prometheusSink {
+	return &prometheusSink{samples: make(map[string]promSample)}
+}
+
+// Describe implements prometheus.Collector, the metrics are unchecked since
+// they are derived from the received updates.
+func (s *prometheusSink) Describe(ch chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector. A metric must have the same labels
+// and may only be collected once per gather, hence the samples of which the
+// labels differ from the first sample of the metric and the samples that are
+// exported by multiple profiles are skipped.
+func (s *prometheusSink) Collect(ch chan<- prometheus.Metric) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(s.samples))
+	for k := range s.samples {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	metricLabels := make(map[string]string)
+	collected := make(map[string]bool)
+	for _, k := range keys {
+		smp := s.samples[k]
+		l := strings.Join(smp.labels, ",")
+		if ml, ok := metricLabels[smp.name]; ok && ml != l {
+			continue
+		}
+		metricLabels[smp.name] = l
+		id := smp.name + "|" + strings.Join(smp.values, "|")
+		if collected[id] {
+			continue
+		}
+		collected[id] = true
+		m, err := prometheus.NewConstMetric(
+			prometheus.NewDesc(smp.name, "srl telemetry "+smp.name, smp.labels, nil),
+			prometheus.GaugeValue, smp.value, smp.values...)
+		if err != nil {
+			continue
+		}
+		ch <- m
+	}
+}
+
+func (s *prometheusSink) update(target, profile string, n *gnmi.Notification) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range n.GetUpdate() {
+		v, err := TypedValue(u.GetVal())
+		if err != nil {
+			continue
+		}
+		elems, keys := pathElems(n.GetPrefix(), u.GetPath())
+		flatten(elems, keys, v, func(elems []string, keys []label, f float64) {
+			smp := newPromSample(target, profile, elems, keys, f)
+			s.samples[smp.key()] = smp
+		})
+	}
+	for _, d := range n.GetDelete() {
+		elems, keys := pathElems(n.GetPrefix(), d)
+		prefix := newPromSample(target, profile, elems, keys, 0).key()
+		for k := range s.samples {
+			if strings.HasPrefix(k, prefix) {
+				delete(s.samples, k)
+			}
+		}
+	}
+}
+
+// deleteProfile deletes the samples of the profile, the samples of the network
+// nodes that are kept are not deleted.
+func (s *prometheusSink) deleteProfile(profile string, keep []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := make(map[string]bool)
+	for _, nn := range keep {
+		kept[nn] = true
+	}
+	for k, smp := range s.samples {
+		if smp.profile == profile && !kept[smp.networkNode] {
+			delete(s.samples, k)
+		}
+	}
+}
+
+// A label is a key of a path, named after the element and the key name.
+type label struct {
+	name  string
+	value string
+}
+
+func newPromSample(target, profile string, elems []string, keys []label, v float64) promSample {
+	smp := promSample{
+		profile:     profile,
+		networkNode: target,
+		name:        metricName(elems),
+		labels:      []string{"network_node"},
+		values:      []string{target},
+		value:       v,
+	}
+	for _, l := range keys {
+		smp.labels = append(smp.labels, l.name)
+		smp.values = append(smp.values, l.value)
+	}
+	return smp
+}
+
+func (smp promSample) key() string {
+	var b strings.Builder
+	b.WriteString(smp.profile)
+	b.WriteString("|")
+	b.WriteString(smp.name)
+	for i := range smp.labels {
+		b.WriteString("|" + smp.labels[i] + "=" + smp.values[i])
+	}
+	return b.String()
+}
+
+// metricName returns the name of the metric of the element names of a path.
+func metricName(elems []string) string {
+	parts := append([]string{telemetryMetricPrefix}, elems...)
+	return sanitize(strings.Join(parts, "_"))
+}
+
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// stripModule strips the module prefix of a yang element name.
+func stripModule(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// pathElems returns the element names and the key labels of the prefix and
+// path, the keys are sorted per element to get stable labels.
+func pathElems(prefix, path *gnmi.Path) ([]string, []label) {
+	elems := make([]string, 0)
+	keys := make([]label, 0)
+	for _, p := range []*gnmi.Path{prefix, path} {
+		for _, e := range p.GetElem() {
+			name := stripModule(e.GetName())
+			elems = append(elems, name)
+			names := make([]string, 0, len(e.GetKey()))
+			for k := range e.GetKey() {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			for _, k := range names {
+				keys = append(keys, label{name: sanitize(name + "_" + k), value: e.GetKey()[k]})
+			}
+		}
+	}
+	return elems, keys
+}
+
+// PathString returns the xpath of the prefix and path.
+func PathString(prefix, path *gnmi.Path) string {
+	var b strings.Builder
+	for _, p := range []*gnmi.Path{prefix, path} {
+		for _, e := range p.GetElem() {
+			b.WriteString("/" + e.GetName())
+			names := make([]string, 0, len(e.GetKey()))
+			for k := range e.GetKey() {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			for _, k := range names {
+				b.WriteString("[" + k + "=" + e.GetKey()[k] + "]")
+			}
+		}
+	}
+	if b.Len() == 0 {
+		return "/"
+	}
+	return b.String()
+}
+
+// TypedValue returns the value of a gnmi typed value, JSON values are decoded.
+func TypedValue(tv *gnmi.TypedValue) (interface{}, error) {
+	switch v := tv.GetValue().(type) {
+	case *gnmi.TypedValue_JsonIetfVal:
+		var d interface{}
+		err := json.Unmarshal(v.JsonIetfVal, &d)
+		return d, err
+	case *gnmi.TypedValue_JsonVal:
+		var d interface{}
+		err := json.Unmarshal(v.JsonVal, &d)
+		return d, err
+	case *gnmi.TypedValue_IntVal:
+		return float64(v.IntVal), nil
+	case *gnmi.TypedValue_UintVal:
+		return float64(v.UintVal), nil
+	case *gnmi.TypedValue_FloatVal:
+		return float64(v.FloatVal), nil
+	case *gnmi.TypedValue_BoolVal:
+		return v.BoolVal, nil
+	case *gnmi.TypedValue_StringVal:
+		return v.StringVal, nil
+	case *gnmi.TypedValue_AsciiVal:
+		return v.AsciiVal, nil
+	}
+	return nil, errors.New("unsupported value")
+}
+
+// flatten calls fn for every numeric leaf of the value. Numbers encoded as
+// strings, as done for 64 bit values in JSON_IETF, and booleans are numeric.
+// The entries of lists are labelled with their index.
+func flatten(elems []string, keys []label, v interface{}, fn func([]string, []label, float64)) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		names := make([]string, 0, len(v))
+		for k := range v {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			flatten(append(elems[:len(elems):len(elems)], stripModule(k)), keys, v[k], fn)
+		}
+	case []interface{}:
+		for i, e := range v {
+			l := label{name: sanitize(lastElem(elems) + "_index"), value: strconv.Itoa(i)}
+			flatten(elems, append(keys[:len(keys):len(keys)], l), e, fn)
+		}
+	case float64:
+		fn(elems, keys, v)
+	case bool:
+		if v {
+			fn(elems, keys, 1)
+		} else {
+			fn(elems, keys, 0)
+		}
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			fn(elems, keys, f)
+		}
+	}
+}
+
+func lastElem(elems []string) string {
+	if len(elems) == 0 {
+		return "list"
+	}
+	return elems[len(elems)-1]
+}
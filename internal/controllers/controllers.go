@@ -29,8 +29,45 @@ import (
 	"github.com/yndd/ndd-provider-srl/internal/controllers/srl"
 )
 
+// Options are the options of the controllers of the provider.
+type Options struct {
+	// Controller are the options of the controllers
+	Controller controller.Options
+
+	// Logger of the controllers
+	Logger logging.Logger
+
+	// Poll is the interval at which the resources are checked for drift
+	Poll time.Duration
+
+	// Namespace of the provider
+	Namespace string
+
+	// TargetUpdates is the channel of the updates of the targets the deviation
+	// server subscribes to
+	TargetUpdates chan collector.TargetUpdate
+
+	// SubscriptionStatus holds the state of the subscriptions of the deviation
+	// server
+	SubscriptionStatus *collector.SubscriptionStatus
+
+	// Telemetry holds the SrlTelemetryProfiles the deviation server subscribes to
+	Telemetry *collector.Telemetry
+
+	// Srl are the options shared by the controllers of the srl package
+	Srl srl.Options
+}
+
 // Setup package controllers.
-func Setup(mgr ctrl.Manager, option controller.Options, l logging.Logger, autopilot bool, poll time.Duration, namespace string, tuChan chan collector.TargetUpdate) (map[string]chan event.GenericEvent, error) {
+func Setup(mgr ctrl.Manager, o Options) (map[string]chan event.GenericEvent, error) {
+	// the limits and the config cache of the network nodes are shared by all
+	// controllers, which only reconcile the resources of the shard of the replica
+	// and record their set requests in the audit trail. The drift of the
+	// resources without a drift policy annotation is corrected in autopilot and
+	// reported otherwise, unless the provider config of their network node sets
+	// the autopilot.
+	srl.Configure(o.Srl)
+
 	eventChans := make(map[string]chan event.GenericEvent)
 	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string) (string, chan event.GenericEvent, error){
 		srl.SetupBfd,
@@ -60,17 +97,40 @@ func Setup(mgr ctrl.Manager, option controller.Options, l logging.Logger, autopi
 		srl.SetupTunnelinterface,
 		srl.SetupTunnelinterfaceVxlaninterface,
 	} {
-		gvk, eventChan, err := setup(mgr, option, l, poll, namespace)
+		gvk, eventChan, err := setup(mgr, o.Controller, o.Logger, o.Poll, o.Namespace)
 		if err != nil {
 			return nil, err
 		}
 		eventChans[gvk] = eventChan
 	}
 
-	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string, chan collector.TargetUpdate) error{
+	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string, chan collector.TargetUpdate, *collector.SubscriptionStatus) error{
 		srl.SetupRegistration,
 	} {
-		if err := setup(mgr, option, l, poll, namespace, tuChan); err != nil {
+		if err := setup(mgr, o.Controller, o.Logger, o.Poll, o.Namespace, o.TargetUpdates, o.SubscriptionStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration) error{
+		srl.SetupConfigTemplate,
+		srl.SetupEvpnL2Service,
+		srl.SetupEvpnL3Service,
+		srl.SetupFabric,
+		srl.SetupIpPool,
+		srl.SetupAsnPool,
+		srl.SetupVniPool,
+		srl.SetupProviderConfig,
+	} {
+		if err := setup(mgr, o.Controller, o.Logger, o.Poll); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, *collector.Telemetry) error{
+		srl.SetupTelemetryProfile,
+	} {
+		if err := setup(mgr, o.Controller, o.Logger, o.Poll, o.Telemetry); err != nil {
 			return nil, err
 		}
 	}
@@ -78,3 +138,18 @@ func Setup(mgr ctrl.Manager, option controller.Options, l logging.Logger, autopi
 	return eventChans, nil
 	//return config.Setup(mgr, l, option)
 }
+
+// SetupWebhooks adds the admission webhooks of the package to the manager.
+func SetupWebhooks(mgr ctrl.Manager, l logging.Logger) error {
+	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
+		srl.SetupUniquenessWebhook,
+		srl.SetupAddressingWebhook,
+		srl.SetupPoolWebhook,
+		srl.SetupChangedByWebhook,
+	} {
+		if err := setup(mgr, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errListAddressing                 = "cannot list resources to validate the addressing of the subinterface"
+	errFmtInvalidIpPrefix             = "ip-prefix %s is not a valid prefix"
+	errFmtNetworkAddress              = "ip-prefix %s uses the network address of the subnet"
+	errFmtBroadcastAddress            = "ip-prefix %s uses the broadcast address of the subnet"
+	errFmtMultiplePrimary             = "%s addresses %s and %s are both primary"
+	errFmtOverlappingPrefix           = "ip-prefix %s overlaps with %s"
+	errFmtOverlappingSubinterface     = "ip-prefix %s overlaps with %s of subinterface %s in network-instance %s"
+	errFmtNeighborOutsideSubnet       = "neighbor %s is not within the subnet of an %s address of the subinterface"
+	errFmtVirtualAddressOutsideSubnet = "vrrp-group %d virtual-address %s is not within the subnet of an %s address of the subinterface"
+)
+
+// An ipAddress is an address of a subinterface.
+type ipAddress struct {
+	prefix  string
+	ip      net.IP
+	net     *net.IPNet
+	primary bool
+}
+
+// subinterfaceRefName returns the name of the subinterface as it is referenced
+// by the network instances.
+func subinterfaceRefName(o *srlv1.SrlInterfaceSubinterface) string {
+	if o.Spec.ForNetworkNode.InterfaceName == nil || o.Spec.ForNetworkNode.SrlInterfaceSubinterface == nil || o.Spec.ForNetworkNode.SrlInterfaceSubinterface.Index == nil {
+		return ""
+	}
+	return subinterfaceName(*o.Spec.ForNetworkNode.InterfaceName, *o.Spec.ForNetworkNode.SrlInterfaceSubinterface.Index)
+}
+
+// subinterfaceAddresses returns the IPv4 and IPv6 addresses of the subinterface.
+func subinterfaceAddresses(o *srlv1.SrlInterfaceSubinterface) ([]ipAddress, []ipAddress, error) {
+	si := o.Spec.ForNetworkNode.SrlInterfaceSubinterface
+	if si == nil {
+		return nil, nil, nil
+	}
+	ipv4 := make([]ipAddress, 0)
+	if si.Ipv4 != nil {
+		for _, a := range si.Ipv4.Address {
+			if a == nil || a.IpPrefix == nil {
+				continue
+			}
+			addr, err := parseAddress(*a.IpPrefix, a.Primary != nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			ipv4 = append(ipv4, addr)
+		}
+	}
+	ipv6 := make([]ipAddress, 0)
+	if si.Ipv6 != nil {
+		for _, a := range si.Ipv6.Address {
+			if a == nil || a.IpPrefix == nil {
+				continue
+			}
+			addr, err := parseAddress(*a.IpPrefix, a.Primary != nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			ipv6 = append(ipv6, addr)
+		}
+	}
+	return ipv4, ipv6, nil
+}
+
+func parseAddress(prefix string, primary bool) (ipAddress, error) {
+	ip, n, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return ipAddress{}, errors.Errorf(errFmtInvalidIpPrefix, prefix)
+	}
+	return ipAddress{prefix: prefix, ip: ip, net: n, primary: primary}, nil
+}
+
+// inSubnet returns true when the address is within the subnet of one of the
+// addresses.
+func inSubnet(addrs []ipAddress, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, a := range addrs {
+		if a.net.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps(a, b ipAddress) bool {
+	return a.net.Contains(b.net.IP) || b.net.Contains(a.net.IP)
+}
+
+// validateAddresses validates the addresses of a single address family of a
+// subinterface. Only one address can be primary and the addresses cannot
+// overlap. IPv4 addresses of subnets other than /31 and /32 cannot use the
+// network or broadcast address of the subnet.
+func validateAddresses(family string, addrs []ipAddress) string {
+	var primary *ipAddress
+	for i, a := range addrs {
+		if ip4 := a.ip.To4(); ip4 != nil {
+			ones, bits := a.net.Mask.Size()
+			if bits-ones > 1 {
+				broadcast := make(net.IP, len(ip4))
+				for j := range ip4 {
+					broadcast[j] = a.net.IP.To4()[j] | ^a.net.Mask[j]
+				}
+				switch {
+				case ip4.Equal(a.net.IP):
+					return fmt.Sprintf(errFmtNetworkAddress, a.prefix)
+				case ip4.Equal(broadcast):
+					return fmt.Sprintf(errFmtBroadcastAddress, a.prefix)
+				}
+			}
+		}
+		if a.primary {
+			if primary != nil {
+				return fmt.Sprintf(errFmtMultiplePrimary, family, primary.prefix, a.prefix)
+			}
+			primary = &addrs[i]
+		}
+		for _, b := range addrs[:i] {
+			if overlaps(a, b) {
+				return fmt.Sprintf(errFmtOverlappingPrefix, a.prefix, b.prefix)
+			}
+		}
+	}
+	return ""
+}
+
+// validateNeighbors validates the static ARP and ND neighbors and the VRRP
+// virtual addresses are within the subnet of an address of the subinterface.
+func validateNeighbors(si *srlv1.InterfaceSubinterface, ipv4, ipv6 []ipAddress) string {
+	if si.Ipv4 != nil {
+		if si.Ipv4.Arp != nil {
+			for _, n := range si.Ipv4.Arp.Neighbor {
+				if n != nil && n.Ipv4Address != nil && !inSubnet(ipv4, *n.Ipv4Address) {
+					return fmt.Sprintf(errFmtNeighborOutsideSubnet, *n.Ipv4Address, "ipv4")
+				}
+			}
+		}
+		if si.Ipv4.Vrrp != nil {
+			for _, g := range si.Ipv4.Vrrp.VrrpGroup {
+				if g != nil && g.VirtualAddress != nil && !inSubnet(ipv4, *g.VirtualAddress) {
+					return fmt.Sprintf(errFmtVirtualAddressOutsideSubnet, vrrpGroupID(g.VirtualRouterId), *g.VirtualAddress, "ipv4")
+				}
+			}
+		}
+	}
+	if si.Ipv6 != nil {
+		if si.Ipv6.NeighborDiscovery != nil {
+			for _, n := range si.Ipv6.NeighborDiscovery.Neighbor {
+				if n != nil && n.Ipv6Address != nil && !inSubnet(ipv6, *n.Ipv6Address) {
+					return fmt.Sprintf(errFmtNeighborOutsideSubnet, *n.Ipv6Address, "ipv6")
+				}
+			}
+		}
+		if si.Ipv6.Vrrp != nil {
+			for _, g := range si.Ipv6.Vrrp.VrrpGroup {
+				if g != nil && g.VirtualAddress != nil && !inSubnet(ipv6, *g.VirtualAddress) {
+					return fmt.Sprintf(errFmtVirtualAddressOutsideSubnet, vrrpGroupID(g.VirtualRouterId), *g.VirtualAddress, "ipv6")
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func vrrpGroupID(id *uint8) uint8 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+// subinterfaceNetworkInstances returns the network instance of every subinterface
+// of the network node.
+func subinterfaceNetworkInstances(ctx context.Context, kube client.Client, nodeName string) (map[string]string, error) {
+	nis := &srlv1.SrlNetworkinstanceList{}
+	if err := kube.List(ctx, nis); err != nil {
+		return nil, errors.Wrap(err, errListAddressing)
+	}
+	m := make(map[string]string)
+	for i := range nis.Items {
+		ni := &nis.Items[i]
+		if networkNodeName(ni) != nodeName || meta.WasDeleted(ni) || ni.Spec.ForNetworkNode.SrlNetworkinstance == nil || ni.Spec.ForNetworkNode.SrlNetworkinstance.Name == nil {
+			continue
+		}
+		for _, itfce := range ni.Spec.ForNetworkNode.SrlNetworkinstance.Interface {
+			if itfce != nil && itfce.Name != nil {
+				m[*itfce.Name] = *ni.Spec.ForNetworkNode.SrlNetworkinstance.Name
+			}
+		}
+	}
+	return m, nil
+}
+
+// findAddressingError returns a message that describes the first semantic error
+// in the addressing of a subinterface, the message is empty when the addressing
+// is valid or the resource is not a subinterface. The prefixes of a subinterface
+// cannot overlap with the prefixes of the other subinterfaces in the same network
+// instance of the network node.
+func findAddressingError(ctx context.Context, kube client.Client, mg resource.Managed) (string, error) {
+	o, ok := mg.(*srlv1.SrlInterfaceSubinterface)
+	if !ok || o.Spec.ForNetworkNode.SrlInterfaceSubinterface == nil {
+		return "", nil
+	}
+	ipv4, ipv6, err := subinterfaceAddresses(o)
+	if err != nil {
+		return err.Error(), nil
+	}
+	if msg := validateAddresses("ipv4", ipv4); msg != "" {
+		return msg, nil
+	}
+	if msg := validateAddresses("ipv6", ipv6); msg != "" {
+		return msg, nil
+	}
+	if msg := validateNeighbors(o.Spec.ForNetworkNode.SrlInterfaceSubinterface, ipv4, ipv6); msg != "" {
+		return msg, nil
+	}
+	addrs := append(ipv4, ipv6...)
+	if len(addrs) == 0 {
+		return "", nil
+	}
+
+	nodeName := networkNodeName(mg)
+	nis, err := subinterfaceNetworkInstances(ctx, kube, nodeName)
+	if err != nil {
+		return "", err
+	}
+	ni, ok := nis[subinterfaceRefName(o)]
+	if !ok {
+		return "", nil
+	}
+	sis := &srlv1.SrlInterfaceSubinterfaceList{}
+	if err := kube.List(ctx, sis); err != nil {
+		return "", errors.Wrap(err, errListAddressing)
+	}
+	for i := range sis.Items {
+		other := &sis.Items[i]
+		if other.GetName() == o.GetName() || networkNodeName(other) != nodeName || meta.WasDeleted(other) {
+			continue
+		}
+		name := subinterfaceRefName(other)
+		if name == "" || name == subinterfaceRefName(o) || nis[name] != ni {
+			continue
+		}
+		otherIpv4, otherIpv6, err := subinterfaceAddresses(other)
+		if err != nil {
+			// the other subinterface reports its own addressing error
+			continue
+		}
+		otherAddrs := append(otherIpv4, otherIpv6...)
+		for _, a := range addrs {
+			for _, b := range otherAddrs {
+				if overlaps(a, b) {
+					return fmt.Sprintf(errFmtOverlappingSubinterface, a.prefix, b.prefix, name, ni), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// allowAddressing returns an error when the addressing of the managed resource
+// is semantically invalid. The InvalidAddressing condition is set on the managed
+// resource, it gets persisted by the reconciler together with the rest of the
+// status.
+func (e *guardedExternal) allowAddressing(ctx context.Context, mg resource.Managed) error {
+	msg, err := findAddressingError(ctx, e.kube, mg)
+	if err != nil {
+		return err
+	}
+	if msg != "" {
+		e.log.Debug("change blocked", "reason", srlv1.ConditionReasonAddressingConflict, "error", msg)
+		mg.SetConditions(srlv1.InvalidAddressing().WithMessage(msg))
+		return errors.New(msg)
+	}
+	if mg.GetCondition(srlv1.ConditionKindInvalidAddressing).Status == corev1.ConditionTrue {
+		mg.SetConditions(srlv1.ValidAddressing())
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"google.golang.org/grpc"
+
+	"github.com/yndd/ndd-provider-srl/internal/audit"
+	"github.com/yndd/ndd-provider-srl/internal/tracing"
+)
+
+// changes records the set requests the controllers send to the device drivers,
+// it is set by the options of Configure and nothing is recorded when it is not
+// set.
+var changes *audit.Recorder
+
+// gnmiDialOptions returns the dial options of the gnmi clients to the device
+// driver of the network node, the calls are traced and the set requests are
+// recorded in the audit trail.
+func gnmiDialOptions(node string) []grpc.DialOption {
+	return append(tracing.GNMIDialOptions(node), changes.GNMIDialOptions(node)...)
+}
+
+// withAuditResource returns the context of the set requests that push the spec
+// of the managed resource to the network node.
+func withAuditResource(ctx context.Context, mg resource.Managed) context.Context {
+	return audit.WithResource(ctx, audit.ResourceOf(groupVersionKind(mg).Kind, mg))
+}
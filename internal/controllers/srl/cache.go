@@ -0,0 +1,435 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/gext"
+	"github.com/yndd/ndd-runtime/pkg/gvk"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yndd/ndd-provider-srl/internal/collector"
+)
+
+const (
+	// DefaultConfigCacheMaxAge is the default age after which the cached config
+	// of a network node is read again from the device driver
+	DefaultConfigCacheMaxAge = 1 * time.Minute
+)
+
+// nodeConfigs is the config cache shared by the controllers, it is kept fresh
+// by the config subscriptions of the deviation server. Its max age is set by
+// the options of Configure before the controllers are set up.
+var nodeConfigs = newNodeConfigCache(DefaultConfigCacheMaxAge)
+
+// NodeConfigWatcher returns the watcher that keeps the config cache of the
+// network nodes fresh, it is notified by the deviation server.
+func NodeConfigWatcher() collector.ConfigWatcher {
+	return nodeConfigs
+}
+
+// A nodeConfigCache keeps the config of the network nodes, such that the
+// observations of the resources and the external leafref validations do not hit
+// the device driver on every reconcile. The config of a network node is read
+// once and kept up to date with the updates and deletes of its config
+// subscription, the reads of a path are answered from it. The config is only
+// served while the subscription is synced and is read again when it is older
+// than the max age.
+type nodeConfigCache struct {
+	mu     sync.Mutex
+	maxAge time.Duration
+	nodes  map[string]*nodeConfig
+}
+
+type nodeConfig struct {
+	synced bool
+	// gen is incremented when the config of the network node changed, such that
+	// a get that was started before the change is not cached
+	gen uint64
+	// tree is the config of the network node, nil when it was not read yet
+	tree    interface{}
+	fetched time.Time
+	// fetch is the get of the config that is in progress
+	fetch *configFetch
+	// resources are the get responses of the resources without their config
+	resources map[string]*resourceEntry
+}
+
+// A configFetch is a get of the config of a network node, done is closed when
+// the get returned, such that concurrent reads wait for the first one.
+type configFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// A resourceEntry holds the extension of the get response of a resource, which
+// reports the state of the resource in the device driver.
+type resourceEntry struct {
+	name      string
+	extension []*gnmi_ext.Extension
+	fetched   time.Time
+}
+
+func newNodeConfigCache(maxAge time.Duration) *nodeConfigCache {
+	return &nodeConfigCache{maxAge: maxAge, nodes: make(map[string]*nodeConfig)}
+}
+
+func (c *nodeConfigCache) setMaxAge(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxAge = d
+}
+
+func (c *nodeConfigCache) node(name string) *nodeConfig {
+	nc, ok := c.nodes[name]
+	if !ok {
+		nc = &nodeConfig{resources: make(map[string]*resourceEntry)}
+		c.nodes[name] = nc
+	}
+	return nc
+}
+
+// Synced implements collector.ConfigWatcher, the changes that were missed
+// before the subscription got synced drop the config.
+func (c *nodeConfigCache) Synced(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	nc := c.node(name)
+	nc.synced = true
+	nc.reset()
+}
+
+// Changed implements collector.ConfigWatcher, the deletes and updates of the
+// notification are applied to the config of the network node. The config is
+// dropped when the notification cannot be applied.
+func (c *nodeConfigCache) Changed(name string, n *gnmi.Notification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	nc := c.node(name)
+	nc.gen++
+	if nc.tree == nil {
+		return
+	}
+	if err := nc.apply(n); err != nil {
+		nc.tree = nil
+	}
+}
+
+// Unsynced implements collector.ConfigWatcher, the config of the network node
+// is bypassed until its subscription is synced again.
+func (c *nodeConfigCache) Unsynced(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	nc := c.node(name)
+	nc.synced = false
+	nc.reset()
+}
+
+// changed drops the responses of the resource on the network nodes after the
+// provider changed it, the config is updated by the config subscription.
+func (c *nodeConfigCache) changed(resource string, names ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, name := range names {
+		nc := c.node(name)
+		nc.gen++
+		for key, re := range nc.resources {
+			if re.name == resource {
+				delete(nc.resources, key)
+			}
+		}
+	}
+}
+
+func (nc *nodeConfig) reset() {
+	nc.gen++
+	nc.tree = nil
+	nc.resources = make(map[string]*resourceEntry)
+}
+
+// apply applies the deletes and the updates of the notification to the config,
+// an update of a path that is not rooted in the config is not applied.
+func (nc *nodeConfig) apply(n *gnmi.Notification) error {
+	for _, p := range n.GetDelete() {
+		nc.tree = treeDelete(nc.tree, joinPath(n.GetPrefix(), p))
+	}
+	for _, u := range n.GetUpdate() {
+		p := joinPath(n.GetPrefix(), u.GetPath())
+		if len(p.GetElem()) != 0 {
+			if _, ok := treeGet(nc.tree, &gnmi.Path{Elem: p.GetElem()[:1]}); !ok {
+				return errors.Errorf(errFmtConfigTreePath, xpathOf(p))
+			}
+		}
+		v, err := collector.TypedValue(u.GetVal())
+		if err != nil {
+			return err
+		}
+		if nc.tree, err = treeSet(nc.tree, p, v, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get returns the response of the get request on the target. A get of the whole
+// config and a get of a single path of which the state of the resource is known
+// are answered from the config of the network node of the target.
+func (c *nodeConfigCache) get(ctx context.Context, t *target.Target, req *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	name := t.Config.Name
+	c.mu.Lock()
+	nc := c.node(name)
+	if c.maxAge <= 0 || !nc.synced || len(req.GetPath()) > 1 {
+		c.mu.Unlock()
+		return t.Get(ctx, req)
+	}
+	c.mu.Unlock()
+
+	if len(req.GetPath()) == 0 {
+		if len(req.GetExtension()) != 0 {
+			return t.Get(ctx, req)
+		}
+		return c.getConfig(ctx, t, nc)
+	}
+	return c.getResource(ctx, t, nc, req)
+}
+
+// getConfig returns the whole config of the network node.
+func (c *nodeConfigCache) getConfig(ctx context.Context, t *target.Target, nc *nodeConfig) (*gnmi.GetResponse, error) {
+	if err := c.fresh(ctx, t, nc); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, err := nc.response(&gnmi.Path{}, nil)
+	if err == nil {
+		trace.SpanFromContext(ctx).AddEvent("config cache hit")
+	}
+	return resp, err
+}
+
+// getResource returns the response of the get of the path of a resource, the
+// response is read from the device driver when the state of the resource is
+// not known. The value of the response is stored in the config.
+func (c *nodeConfigCache) getResource(ctx context.Context, t *target.Target, nc *nodeConfig, req *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	key, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return t.Get(ctx, req)
+	}
+	if err := c.fresh(ctx, t, nc); err != nil {
+		return nil, err
+	}
+	maxAge := c.maxAgeOf()
+
+	c.mu.Lock()
+	if re, ok := nc.resources[string(key)]; ok && nc.tree != nil && time.Since(re.fetched) < maxAge {
+		resp, err := nc.response(req.GetPath()[0], re.extension)
+		c.mu.Unlock()
+		if err == nil {
+			trace.SpanFromContext(ctx).AddEvent("config cache hit")
+		}
+		return resp, err
+	}
+	gen := nc.gen
+	c.mu.Unlock()
+
+	resp, err := t.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// a response of a resource the device driver did not settle yet or a get
+	// that raced with a change of the config is not cached
+	if gen != nc.gen || nc.tree == nil || !settled(resp) {
+		return resp, nil
+	}
+	if err := nc.store(req.GetPath()[0], resp); err != nil {
+		nc.tree = nil
+		return resp, nil
+	}
+	nc.resources[string(key)] = &resourceEntry{
+		name:      resourceName(req),
+		extension: resp.GetExtension(),
+		fetched:   time.Now(),
+	}
+	return resp, nil
+}
+
+// fresh reads the config of the network node when it was not read yet or when
+// it is older than the max age.
+func (c *nodeConfigCache) fresh(ctx context.Context, t *target.Target, nc *nodeConfig) error {
+	c.mu.Lock()
+	if nc.tree != nil && time.Since(nc.fetched) < c.maxAge {
+		c.mu.Unlock()
+		return nil
+	}
+	f := nc.fetch
+	if f == nil {
+		f = &configFetch{done: make(chan struct{})}
+		nc.fetch = f
+		go c.fetch(t, nc, f, nc.gen)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fetch reads the config of the network node, the config is not stored when it
+// changed during the get. The get is not cancelled together with the reads that
+// wait for it.
+func (c *nodeConfigCache) fetch(t *target.Target, nc *nodeConfig, f *configFetch, gen uint64) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutOf(t))
+	defer cancel()
+	resp, err := t.Get(ctx, &gnmi.GetRequest{Path: []*gnmi.Path{}, Encoding: gnmi.Encoding_JSON})
+	var tree interface{}
+	if err == nil {
+		tree, err = configOf(resp)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	nc.fetch = nil
+	f.err = err
+	if err == nil && gen == nc.gen {
+		nc.tree, nc.fetched = tree, time.Now()
+	}
+	close(f.done)
+}
+
+// response returns the get response of the path with the value of the config.
+func (nc *nodeConfig) response(path *gnmi.Path, extension []*gnmi_ext.Extension) (*gnmi.GetResponse, error) {
+	resp := &gnmi.GetResponse{Extension: extension}
+	v, ok := treeGet(nc.tree, path)
+	if !ok {
+		return resp, nil
+	}
+	d, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	resp.Notification = []*gnmi.Notification{{
+		Timestamp: nc.fetched.UnixNano(),
+		Update: []*gnmi.Update{{
+			Path: path,
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: d}},
+		}},
+	}}
+	return resp, nil
+}
+
+// store stores the value of the get response of the path in the config, the
+// path is removed when the response has no value.
+func (nc *nodeConfig) store(path *gnmi.Path, resp *gnmi.GetResponse) error {
+	for _, n := range resp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			v, err := collector.TypedValue(u.GetVal())
+			if err != nil {
+				return err
+			}
+			if nc.tree, err = treeSet(nc.tree, path, v, false); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+	nc.tree = treeDelete(nc.tree, path)
+	return nil
+}
+
+func (c *nodeConfigCache) maxAgeOf() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.maxAge
+}
+
+// configOf returns the config of the get response of the whole config.
+func configOf(resp *gnmi.GetResponse) (interface{}, error) {
+	for _, n := range resp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			return collector.TypedValue(u.GetVal())
+		}
+	}
+	return make(map[string]interface{}), nil
+}
+
+// resourceName returns the name of the resource of the get request, which is
+// the name of the gnmi extension of the request.
+func resourceName(req *gnmi.GetRequest) string {
+	for _, ext := range req.GetExtension() {
+		meta := &gext.GEXT{}
+		if err := json.Unmarshal(ext.GetRegisteredExt().GetMsg(), meta); err == nil {
+			return meta.Name
+		}
+	}
+	return ""
+}
+
+// resourceNameOf returns the name of the managed resource in the gnmi extension
+// of its requests.
+func resourceNameOf(mg resource.Managed) string {
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	name, _ := gvk.String()
+	return name
+}
+
+// timeoutOf returns the timeout of the gets of the target.
+func timeoutOf(t *target.Target) time.Duration {
+	if t.Config == nil || t.Config.Timeout <= 0 {
+		return defaultGNMITimeout
+	}
+	return t.Config.Timeout
+}
+
+// settled returns true when the response does not change until the config of
+// the network node changes, which is not the case for the responses of which
+// the cache of the device driver is not ready or of which the resource is not
+// in a success state.
+func settled(resp *gnmi.GetResponse) bool {
+	if len(resp.GetExtension()) == 0 {
+		return true
+	}
+	meta := &gext.GEXT{}
+	if err := json.Unmarshal(resp.GetExtension()[0].GetRegisteredExt().GetMsg(), meta); err != nil {
+		return false
+	}
+	if !meta.CacheReady {
+		return false
+	}
+	return !meta.Exists || meta.Status == gext.ResourceStatusSuccess
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errFmtTransientCommit = "transient device commit error %s: %s"
+	errFmtPermanentCommit = "permanent device commit error %s: %s, not retried until the spec changes"
+)
+
+// permanentCodes are the gNMI status codes of commit errors that do not resolve
+// without a change of the resource, e.g. a configuration that is rejected by the
+// network node. All other codes are transient and are retried with backoff.
+var permanentCodes = map[codes.Code]bool{
+	codes.InvalidArgument:    true,
+	codes.FailedPrecondition: true,
+	codes.AlreadyExists:      true,
+	codes.OutOfRange:         true,
+	codes.PermissionDenied:   true,
+	codes.Unimplemented:      true,
+}
+
+// A commitError is an error returned by the device driver when a change is
+// committed to the network node.
+type commitError struct {
+	code      codes.Code
+	message   string
+	permanent bool
+}
+
+func (e *commitError) Error() string {
+	if e.permanent {
+		return fmt.Sprintf(errFmtPermanentCommit, e.code, e.message)
+	}
+	return fmt.Sprintf(errFmtTransientCommit, e.code, e.message)
+}
+
+// parseCommitError returns the commitError with the gNMI status code and the
+// verbatim message of the device, false is returned when the error does not
+// carry a gNMI status.
+func parseCommitError(err error) (*commitError, bool) {
+	s, ok := status.FromError(errors.Cause(err))
+	if !ok || s.Code() == codes.OK {
+		return nil, false
+	}
+	return &commitError{code: s.Code(), message: s.Message(), permanent: permanentCodes[s.Code()]}, true
+}
+
+// A commitFailure is a permanent commit error of a generation of a resource.
+type commitFailure struct {
+	generation int64
+	err        error
+}
+
+// commitFailures tracks the permanent commit errors of the resources of a
+// controller, such that a rejected generation is not committed again.
+type commitFailures struct {
+	mu       sync.Mutex
+	failures map[types.UID]commitFailure
+}
+
+func newCommitFailures() *commitFailures {
+	return &commitFailures{failures: make(map[types.UID]commitFailure)}
+}
+
+// get returns the permanent commit error of the current generation of the
+// resource, nil is returned when the generation was not rejected.
+func (c *commitFailures) get(mg resource.Managed) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f, ok := c.failures[mg.GetUID()]
+	if !ok || f.generation != mg.GetGeneration() {
+		return nil
+	}
+	return f.err
+}
+
+func (c *commitFailures) set(mg resource.Managed, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures[mg.GetUID()] = commitFailure{generation: mg.GetGeneration(), err: err}
+}
+
+func (c *commitFailures) delete(mg resource.Managed) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.failures, mg.GetUID())
+}
+
+// commitObservation reports the managed resource as up to date when the network
+// node permanently rejected its current generation, such that the reconciler
+// neither commits it again nor requeues it with an error until the spec of the
+// resource changes. The CommitFailed condition is cleared once the network node
+// matches the spec.
+func (e *guardedExternal) commitObservation(mg resource.Managed, obs managed.ExternalObservation) managed.ExternalObservation {
+	if !obs.Ready || meta.WasDeleted(mg) {
+		return obs
+	}
+	upToDate := obs.ResourceExists && obs.ResourceHasData && obs.ResourceUpToDate
+	if err := e.failures.get(mg); err != nil && !upToDate {
+		e.log.Debug("change blocked", "reason", srlv1.ConditionReasonPermanentCommitError, "error", err)
+		obs.ResourceExists, obs.ResourceHasData, obs.ResourceUpToDate = true, true, true
+		obs.ResourceUpdates, obs.ResourceDeletes = nil, nil
+		return obs
+	}
+	if upToDate {
+		e.failures.delete(mg)
+		if mg.GetCondition(srlv1.ConditionKindCommitFailed).Status == corev1.ConditionTrue {
+			mg.SetConditions(srlv1.CommitSucceeded())
+		}
+	}
+	return obs
+}
+
+// commitResult classifies the error of a device changing operation. The gNMI
+// status code and the verbatim message of the device are reported in the
+// CommitFailed condition and in the returned error, the status writer of the
+// reconciler reports them as the reason and message of the Synced condition.
+func (e *guardedExternal) commitResult(mg resource.Managed, err error) error {
+	if err == nil {
+		e.failures.delete(mg)
+		if mg.GetCondition(srlv1.ConditionKindCommitFailed).Status == corev1.ConditionTrue {
+			mg.SetConditions(srlv1.CommitSucceeded())
+		}
+		return nil
+	}
+	ce, ok := parseCommitError(err)
+	if !ok {
+		return err
+	}
+	e.log.Debug("commit failed", "code", ce.code.String(), "permanent", ce.permanent, "error", ce.message)
+	mg.SetConditions(srlv1.CommitFailed(nddv1.ConditionReason(ce.code.String())).WithMessage(ce.message))
+	if ce.permanent {
+		e.failures.set(mg, ce)
+	}
+	return ce
+}
+
+// newCommitReportingManager returns the manager of a managed reconciler of which
+// the status updates report the commit error of the CommitFailed condition as
+// the reason and message of the Synced condition, instead of the generic
+// reconcile error of the reconciler.
+func newCommitReportingManager(mgr ctrl.Manager) ctrl.Manager {
+	return &commitReportingManager{Manager: mgr}
+}
+
+type commitReportingManager struct {
+	ctrl.Manager
+}
+
+func (m *commitReportingManager) GetClient() client.Client {
+	return &commitReportingClient{Client: m.Manager.GetClient()}
+}
+
+type commitReportingClient struct {
+	client.Client
+}
+
+func (c *commitReportingClient) Status() client.StatusWriter {
+	return &commitReportingStatusWriter{StatusWriter: c.Client.Status()}
+}
+
+type commitReportingStatusWriter struct {
+	client.StatusWriter
+}
+
+func (w *commitReportingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if mg, ok := obj.(resource.Managed); ok {
+		reportCommitError(mg)
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+// reportCommitError sets the Synced condition of a managed resource of which the
+// last commit failed. The Synced condition is set by the reconciler when the
+// commit failed, or when the change is blocked because the network node
+// permanently rejected the current generation.
+func reportCommitError(mg resource.Managed) {
+	cf := mg.GetCondition(srlv1.ConditionKindCommitFailed)
+	if cf.Status != corev1.ConditionTrue {
+		return
+	}
+	synced := mg.GetCondition(nddv1.ConditionKindSynced)
+	if synced.Status == corev1.ConditionFalse && !strings.Contains(synced.Message, cf.Message) {
+		// the reconcile failed for another reason than the commit
+		return
+	}
+	mg.SetConditions(srlv1.CommitReconcileError(cf.Reason).WithMessage(cf.Message))
+}
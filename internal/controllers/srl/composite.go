@@ -0,0 +1,287 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errGetComposite          = "cannot get composite resource"
+	errUpdateCompositeStatus = "cannot update composite resource status"
+	errGetChild              = "cannot get child resource"
+	errApplyChild            = "cannot apply child resource"
+	errDeleteChild           = "cannot delete child resource"
+	errChildNotControlled    = "child resource exists and is not controlled by the owner"
+	errApplyChildren         = "cannot apply all child resources"
+	errFmtChildrenNotReady   = "%d of %d child resources ready"
+)
+
+// A composite is a resource that generates and owns child resources.
+type composite interface {
+	client.Object
+	SetConditions(c ...nddv1.Condition)
+	GetChildren() []srlv1.ChildResourceStatus
+	SetChildren(c []srlv1.ChildResourceStatus)
+}
+
+// A compositeChild is a child resource generated by a composite resource.
+type compositeChild struct {
+	networkNode string
+	obj         resource.Managed
+}
+
+// A compositeRenderFn generates the child resources of a composite resource.
+type compositeRenderFn func(ctx context.Context, kube client.Client, cr composite) ([]compositeChild, error)
+
+// A compositeReconciler generates the child resources of a composite resource,
+// keeps them in sync, removes the child resources that are no longer generated
+// and rolls up their readiness. The child resources are owned by the composite
+// resource, when it is deleted they get garbage collected.
+type compositeReconciler struct {
+	client       client.Client
+	scheme       *runtime.Scheme
+	log          logging.Logger
+	poll         time.Duration
+	newComposite func() composite
+	render       compositeRenderFn
+}
+
+// Reconcile a composite resource.
+func (r *compositeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	cr := r.newComposite()
+	if err := r.client.Get(ctx, req.NamespacedName, cr); err != nil {
+		return reconcile.Result{}, errors.Wrap(IgnoreNotFound(err), errGetComposite)
+	}
+	if meta.WasDeleted(cr) {
+		return reconcile.Result{}, nil
+	}
+	crGVK, err := apiutil.GVKForObject(cr, r.scheme)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	children, err := r.render(ctx, r.client, cr)
+	if err != nil {
+		log.Debug("Cannot render", "error", err)
+		cr.SetConditions(nddv1.Unavailable().WithMessage(err.Error()), nddv1.ReconcileError(err))
+		return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, cr), errUpdateCompositeStatus)
+	}
+
+	status := make([]srlv1.ChildResourceStatus, 0, len(children))
+	keep := make(map[childKey]bool)
+	failed, ready := 0, 0
+	for _, c := range children {
+		gvk, err := apiutil.GVKForObject(c.obj, r.scheme)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		s := srlv1.ChildResourceStatus{Kind: gvk.Kind, Name: c.obj.GetName(), NetworkNode: c.networkNode}
+		keep[childKey{Kind: s.Kind, Name: s.Name}] = true
+
+		if err := applyTypedChild(ctx, r.client, cr, crGVK, gvk, c.obj); err != nil {
+			log.Debug("Cannot apply child", "kind", s.Kind, "name", s.Name, "error", err)
+			s.Message = err.Error()
+			failed++
+		} else if s.Ready, s.Message, err = childReady(ctx, r.client, gvk, s.Name); err != nil {
+			s.Message = err.Error()
+		}
+		if s.Ready {
+			ready++
+		}
+		status = append(status, s)
+	}
+
+	previous := make([]childKey, 0, len(cr.GetChildren()))
+	for _, c := range cr.GetChildren() {
+		previous = append(previous, childKey{Kind: c.Kind, Name: c.Name})
+	}
+	if err := pruneChildren(ctx, r.client, cr, previous, keep); err != nil {
+		log.Debug("Cannot prune", "error", err)
+		cr.SetConditions(nddv1.ReconcileError(err))
+	} else if failed != 0 {
+		cr.SetConditions(nddv1.ReconcileError(errors.New(errApplyChildren)))
+	} else {
+		cr.SetConditions(nddv1.ReconcileSuccess())
+	}
+	if ready == len(status) {
+		cr.SetConditions(nddv1.Available())
+	} else {
+		cr.SetConditions(nddv1.Unavailable().WithMessage(fmt.Sprintf(errFmtChildrenNotReady, ready, len(status))))
+	}
+	cr.SetChildren(status)
+
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, cr), errUpdateCompositeStatus)
+}
+
+// childKey identifies a child resource.
+type childKey struct {
+	Kind string
+	Name string
+}
+
+// childName returns a valid resource name for a child resource composed of the
+// supplied parts, e.g. interface names like ethernet-1/1 are converted to ethernet-1-1.
+func childName(parts ...string) string {
+	return strings.NewReplacer("/", "-", ".", "-", "_", "-").Replace(strings.ToLower(strings.Join(parts, "-")))
+}
+
+// childObjectMeta returns the object meta of a child resource of the owner, the
+// child resource is labeled with the name of the owner.
+func childObjectMeta(owner string, parts ...string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:   childName(append([]string{owner}, parts...)...),
+		Labels: map[string]string{srlv1.LabelKeyComposite: owner},
+	}
+}
+
+// childResourceSpec returns the common resource spec of a child resource that
+// targets the network node.
+func childResourceSpec(active bool, dp nddv1.DeletionPolicy, networkNode string) nddv1.ResourceSpec {
+	return nddv1.ResourceSpec{
+		Active:               active,
+		DeletionPolicy:       dp,
+		NetworkNodeReference: &nddv1.Reference{Name: networkNode},
+	}
+}
+
+// applyTypedChild creates or updates a typed child resource owned by the owner.
+func applyTypedChild(ctx context.Context, kube client.Client, owner metav1.Object, ownerGVK, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, errApplyChild)
+	}
+	spec, _ := u["spec"].(map[string]interface{})
+	// active is omitted when false, which would make it default to true
+	spec["active"] = obj.GetActive()
+	return applyChild(ctx, kube, owner, ownerGVK, gvk, obj.GetName(), obj.GetLabels(), spec)
+}
+
+// applyChild creates or updates a child resource owned by the owner. The child
+// resource is only updated when the hash of its spec changed, the finalizers and
+// status set by the controller of the child resource are preserved.
+func applyChild(ctx context.Context, kube client.Client, owner metav1.Object, ownerGVK, gvk schema.GroupVersionKind, name string, labels map[string]string, spec map[string]interface{}) error {
+	d, err := json.Marshal(spec)
+	if err != nil {
+		return errors.Wrap(err, errJSONMarshal)
+	}
+	sum := sha256.Sum256(d)
+	hash := hex.EncodeToString(sum[:])
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, u); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return errors.Wrap(err, errGetChild)
+		}
+		u.SetName(name)
+		u.SetLabels(labels)
+		u.SetAnnotations(map[string]string{srlv1.AnnotationKeyChildHash: hash})
+		meta.AddOwnerReference(u, meta.AsController(meta.TypedReferenceTo(owner, ownerGVK)))
+		u.Object["spec"] = spec
+		return errors.Wrap(kube.Create(ctx, u), errApplyChild)
+	}
+	if !metav1.IsControlledBy(u, owner) {
+		return errors.New(errChildNotControlled)
+	}
+	if u.GetAnnotations()[srlv1.AnnotationKeyChildHash] == hash {
+		return nil
+	}
+	meta.AddLabels(u, labels)
+	meta.AddAnnotations(u, map[string]string{srlv1.AnnotationKeyChildHash: hash})
+	u.Object["spec"] = spec
+	return errors.Wrap(kube.Update(ctx, u), errApplyChild)
+}
+
+// childReady returns true when the child resource reports the Ready condition,
+// otherwise the reason why the child resource is not ready is returned.
+func childReady(ctx context.Context, kube client.Client, gvk schema.GroupVersionKind, name string) (bool, string, error) {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, u); err != nil {
+		return false, "", errors.Wrap(err, errGetChild)
+	}
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		cm, ok := c.(map[string]interface{})
+		if !ok || cm["kind"] != string(nddv1.ConditionKindReady) {
+			continue
+		}
+		if cm["status"] == string(corev1.ConditionTrue) {
+			return true, "", nil
+		}
+		reason, _ := cm["reason"].(string)
+		msg, _ := cm["message"].(string)
+		return false, strings.TrimSpace(reason + " " + msg), nil
+	}
+	return false, "", nil
+}
+
+// pruneChildren deletes the child resources that were generated before, but are
+// no longer generated. Only child resources controlled by the owner are deleted.
+func pruneChildren(ctx context.Context, kube client.Client, owner metav1.Object, previous []childKey, keep map[childKey]bool) error {
+	for _, c := range previous {
+		if keep[c] {
+			continue
+		}
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(srlv1.GroupVersion.WithKind(c.Kind))
+		if err := kube.Get(ctx, types.NamespacedName{Name: c.Name}, u); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrap(err, errGetChild)
+		}
+		if !metav1.IsControlledBy(u, owner) {
+			continue
+		}
+		if err := kube.Delete(ctx, u); IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errDeleteChild)
+		}
+	}
+	return nil
+}
+
+func uint8Ptr(i uint8) *uint8 { return &i }
@@ -0,0 +1,240 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Errors
+	errFmtConfigTreePath = "cannot apply path %s to the config tree"
+)
+
+// The config tree of a network node is the JSON decoded config of the network
+// node, containers are maps and lists are slices of maps with the key leafs.
+
+// treeGet returns the value at the path of the config tree, false is returned
+// when the path does not exist.
+func treeGet(tree interface{}, path *gnmi.Path) (interface{}, bool) {
+	x := tree
+	for _, pe := range path.GetElem() {
+		m, ok := x.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if x, ok = treeChild(m, pe.GetName()); !ok {
+			return nil, false
+		}
+		if len(pe.GetKey()) == 0 {
+			continue
+		}
+		l, ok := x.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		i := treeListIndex(l, pe.GetKey())
+		if i < 0 {
+			return nil, false
+		}
+		x = l[i]
+	}
+	return x, true
+}
+
+// treeSet sets the value at the path of the config tree, the missing containers
+// and list entries are created. When merge is true the containers of the value
+// are merged with the existing containers. The root of the tree is returned.
+func treeSet(tree interface{}, path *gnmi.Path, value interface{}, merge bool) (interface{}, error) {
+	return treeSetElems(tree, path.GetElem(), value, merge, path)
+}
+
+func treeSetElems(x interface{}, elems []*gnmi.PathElem, value interface{}, merge bool, path *gnmi.Path) (interface{}, error) {
+	if len(elems) == 0 {
+		if merge {
+			return treeMerge(x, value), nil
+		}
+		return value, nil
+	}
+	if x == nil {
+		x = make(map[string]interface{})
+	}
+	m, ok := x.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf(errFmtConfigTreePath, xpathOf(path))
+	}
+	pe := elems[0]
+	name := treeChildName(m, pe.GetName())
+	if len(pe.GetKey()) == 0 {
+		child, err := treeSetElems(m[name], elems[1:], value, merge, path)
+		if err != nil {
+			return nil, err
+		}
+		m[name] = child
+		return m, nil
+	}
+
+	var l []interface{}
+	if m[name] != nil {
+		if l, ok = m[name].([]interface{}); !ok {
+			return nil, errors.Errorf(errFmtConfigTreePath, xpathOf(path))
+		}
+	}
+	i := treeListIndex(l, pe.GetKey())
+	var entry interface{}
+	if i >= 0 {
+		entry = l[i]
+	}
+	entry, err := treeSetElems(entry, elems[1:], value, merge, path)
+	if err != nil {
+		return nil, err
+	}
+	em, ok := entry.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf(errFmtConfigTreePath, xpathOf(path))
+	}
+	// the key leafs identify the entry, they are part of the entry
+	for k, v := range pe.GetKey() {
+		if _, ok := em[k]; !ok {
+			em[k] = v
+		}
+	}
+	if i >= 0 {
+		l[i] = em
+	} else {
+		l = append(l, em)
+	}
+	m[name] = l
+	return m, nil
+}
+
+// treeMerge merges the containers of the value into the containers of x, the
+// other values of x are replaced by the value.
+func treeMerge(x, value interface{}) interface{} {
+	xm, ok := x.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	vm, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	for k, v := range vm {
+		name := treeChildName(xm, k)
+		xm[name] = treeMerge(xm[name], v)
+	}
+	return xm
+}
+
+// treeDelete removes the value at the path of the config tree, a path that does
+// not exist is already removed. The root of the tree is returned.
+func treeDelete(tree interface{}, path *gnmi.Path) interface{} {
+	elems := path.GetElem()
+	if len(elems) == 0 {
+		return make(map[string]interface{})
+	}
+	parent, ok := treeGet(tree, &gnmi.Path{Elem: elems[:len(elems)-1]})
+	if !ok {
+		return tree
+	}
+	m, ok := parent.(map[string]interface{})
+	if !ok {
+		return tree
+	}
+	pe := elems[len(elems)-1]
+	name := treeChildName(m, pe.GetName())
+	if len(pe.GetKey()) == 0 {
+		delete(m, name)
+		return tree
+	}
+	l, ok := m[name].([]interface{})
+	if !ok {
+		return tree
+	}
+	if i := treeListIndex(l, pe.GetKey()); i >= 0 {
+		m[name] = append(l[:i], l[i+1:]...)
+	}
+	return tree
+}
+
+// treeChild returns the child of the container, the module prefix of the names
+// is ignored.
+func treeChild(m map[string]interface{}, name string) (interface{}, bool) {
+	x, ok := m[treeChildName(m, name)]
+	return x, ok
+}
+
+// treeChildName returns the name of the child of the container with the name,
+// the name itself is returned when the container has no such child.
+func treeChildName(m map[string]interface{}, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	local := stripModule(name)
+	for k := range m {
+		if stripModule(k) == local {
+			return k
+		}
+	}
+	return name
+}
+
+// treeListIndex returns the index of the list entry with the keys, -1 is
+// returned when the list has no such entry.
+func treeListIndex(l []interface{}, keys map[string]string) int {
+	for i, e := range l {
+		em, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match := true
+		for k, v := range keys {
+			if kv, ok := treeChild(em, k); !ok || fmt.Sprint(kv) != v {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+// joinPath returns the path relative to the prefix.
+func joinPath(prefix, path *gnmi.Path) *gnmi.Path {
+	if len(prefix.GetElem()) == 0 {
+		return path
+	}
+	return &gnmi.Path{Elem: append(append([]*gnmi.PathElem{}, prefix.GetElem()...), path.GetElem()...)}
+}
+
+// xpathOf returns the xpath of the gnmi path with its keys.
+func xpathOf(path *gnmi.Path) string {
+	var sb strings.Builder
+	for _, pe := range path.GetElem() {
+		sb.WriteString("/" + pe.GetName())
+		for k, v := range pe.GetKey() {
+			sb.WriteString("[" + k + "=" + v + "]")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/karimra/gnmic/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/collector"
+)
+
+const (
+	// Errors
+	errFmtDriftPolicy     = "invalid drift policy %q, must be correct, report or ignore"
+	errFmtDriftIgnorePath = "invalid drift ignore path %q"
+	errFmtPollInterval    = "invalid poll interval %q"
+
+	// maxDriftPaths is the number of drifted paths listed in the Drifted
+	// condition
+	maxDriftPaths = 10
+)
+
+// defaultDriftPolicy is the drift policy of the resources without a drift
+// policy annotation, it is set by the autopilot of the options of Configure.
+var defaultDriftPolicy = srlv1.DriftPolicyCorrect
+
+// driftOptions are the drift options of a managed resource, set by its
+// annotations.
+type driftOptions struct {
+	policy      string
+	ignorePaths []*gnmi.Path
+}
+
+// driftOptionsOf returns the drift options of the managed resource, the policy
+// applies when the resource has no drift policy annotation. An error is
+// returned when an annotation is invalid.
+func driftOptionsOf(mg resource.Managed, policy string) (driftOptions, error) {
+	o := driftOptions{policy: policy}
+	if p, ok := mg.GetAnnotations()[srlv1.AnnotationKeyDriftPolicy]; ok {
+		switch p {
+		case srlv1.DriftPolicyCorrect, srlv1.DriftPolicyReport, srlv1.DriftPolicyIgnore:
+			o.policy = p
+		default:
+			return driftOptions{}, errors.Errorf(errFmtDriftPolicy, p)
+		}
+	}
+	for _, xpath := range strings.Split(mg.GetAnnotations()[srlv1.AnnotationKeyDriftIgnorePaths], ",") {
+		xpath = strings.TrimSpace(xpath)
+		if xpath == "" {
+			continue
+		}
+		path, err := utils.ParsePath(xpath)
+		if err != nil {
+			return driftOptions{}, errors.Wrapf(err, errFmtDriftIgnorePath, xpath)
+		}
+		o.ignorePaths = append(o.ignorePaths, path)
+	}
+	if _, err := pollIntervalOf(mg); err != nil {
+		return driftOptions{}, err
+	}
+	return o, nil
+}
+
+// pollIntervalOf returns the poll interval of the poll interval annotation of
+// the object, 0 is returned when it is not set.
+func pollIntervalOf(o metav1.Object) (time.Duration, error) {
+	v, ok := o.GetAnnotations()[srlv1.AnnotationKeyPollInterval]
+	if !ok {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, errors.Errorf(errFmtPollInterval, v)
+	}
+	return d, nil
+}
+
+// ignored returns true when the path is below one of the ignore paths.
+func (o driftOptions) ignored(path *gnmi.Path) bool {
+	for _, ip := range o.ignorePaths {
+		if pathHasPrefix(path, ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathHasPrefix returns true when the elements of the prefix match the first
+// elements of the path, the module names are not compared and the keys of the
+// prefix that are omitted or set to * match all values.
+func pathHasPrefix(path, prefix *gnmi.Path) bool {
+	pe, ppe := path.GetElem(), prefix.GetElem()
+	if len(ppe) > len(pe) {
+		return false
+	}
+	for i, e := range ppe {
+		if stripModule(e.GetName()) != stripModule(pe[i].GetName()) {
+			return false
+		}
+		for k, v := range e.GetKey() {
+			if v != "*" && pe[i].GetKey()[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func stripModule(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// generationApplied returns true when the generation of the managed resource
+// was pushed to the network node, a difference between the spec of an applied
+// generation and the network node is a drift. The applied generation is kept in
+// the status of the resource such that it survives a restart of the provider.
+func generationApplied(mg resource.Managed) bool {
+	a, ok := srlv1.AppliedStatusOf(mg)
+	return ok && a.GetAppliedGeneration() == mg.GetGeneration()
+}
+
+// setGenerationApplied records the generation of the managed resource as
+// applied, the reconciler persists it with the status of the resource.
+func setGenerationApplied(mg resource.Managed) {
+	if a, ok := srlv1.AppliedStatusOf(mg); ok {
+		a.SetAppliedGeneration(mg.GetGeneration())
+	}
+}
+
+// drift applies the drift options of the managed resource to the observation.
+// The ignore paths are removed from the differences of an applied generation,
+// the differences of a generation that was not applied yet are a change of the
+// spec and are pushed as a whole. When the differences of an applied generation
+// are a drift and the drift policy does not correct them, the resource is
+// reported as up to date such that the reconciler does not change the network
+// node. The report policy reports the drifted paths in the
+// Drifted condition of the resource.
+func (e *guardedExternal) drift(mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalObservation, error) {
+	if !obs.Ready || !obs.ResourceExists || meta.WasDeleted(mg) {
+		return obs, nil
+	}
+	o, err := driftOptionsOf(mg, e.config.driftPolicy())
+	if err != nil {
+		return obs, err
+	}
+
+	applied := generationApplied(mg)
+	var drifted []string
+	if obs.ResourceHasData && !obs.ResourceUpToDate && applied {
+		updates := make([]*gnmi.Update, 0, len(obs.ResourceUpdates))
+		for _, u := range obs.ResourceUpdates {
+			if !o.ignored(u.GetPath()) {
+				updates = append(updates, u)
+				drifted = append(drifted, collector.PathString(nil, u.GetPath()))
+			}
+		}
+		deletes := make([]*gnmi.Path, 0, len(obs.ResourceDeletes))
+		for _, d := range obs.ResourceDeletes {
+			if !o.ignored(d) {
+				deletes = append(deletes, d)
+				drifted = append(drifted, collector.PathString(nil, d))
+			}
+		}
+		obs.ResourceUpdates, obs.ResourceDeletes = updates, deletes
+		obs.ResourceUpToDate = len(updates) == 0 && len(deletes) == 0
+	}
+
+	if obs.ResourceHasData && obs.ResourceUpToDate {
+		// the network node matches the spec, which makes the generation applied
+		// also when it was not pushed by this provider instance
+		setGenerationApplied(mg)
+		if mg.GetCondition(srlv1.ConditionKindDrifted).Status == corev1.ConditionTrue {
+			mg.SetConditions(srlv1.NotDrifted())
+		}
+		return obs, nil
+	}
+	// a difference of a generation that was not applied yet is a change of the
+	// spec, which is pushed with any policy
+	if o.policy == srlv1.DriftPolicyCorrect || !applied {
+		return obs, nil
+	}
+
+	e.log.Debug("drift not corrected", "policy", o.policy, "paths", drifted)
+	switch o.policy {
+	case srlv1.DriftPolicyReport:
+		mg.SetConditions(srlv1.Drifted().WithMessage(driftMessage(obs.ResourceHasData, drifted)))
+	case srlv1.DriftPolicyIgnore:
+		if mg.GetCondition(srlv1.ConditionKindDrifted).Status == corev1.ConditionTrue {
+			mg.SetConditions(srlv1.NotDrifted())
+		}
+	}
+	obs.ResourceHasData = true
+	obs.ResourceUpToDate = true
+	obs.ResourceUpdates, obs.ResourceDeletes = nil, nil
+	return obs, nil
+}
+
+// driftMessage returns the message of the Drifted condition, which lists the
+// first drifted paths.
+func driftMessage(hasData bool, paths []string) string {
+	if !hasData {
+		return "the resource is deleted on the network node"
+	}
+	if len(paths) <= maxDriftPaths {
+		return "drifted paths: " + strings.Join(paths, ", ")
+	}
+	return fmt.Sprintf("drifted paths: %s and %d more", strings.Join(paths[:maxDriftPaths], ", "), len(paths)-maxDriftPaths)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+func TestGenerationApplied(t *testing.T) {
+	o := &srlv1.SrlSystemNtp{ObjectMeta: metav1.ObjectMeta{Name: "ntp", Generation: 2}}
+	if generationApplied(o) {
+		t.Errorf("generationApplied(...): want false before the generation is applied")
+	}
+	setGenerationApplied(o)
+	if !generationApplied(o) || o.Status.AppliedGeneration != 2 {
+		t.Errorf("generationApplied(...): want generation 2 applied, got %d", o.Status.AppliedGeneration)
+	}
+	o.SetGeneration(3)
+	if generationApplied(o) {
+		t.Errorf("generationApplied(...): want false after the spec changed")
+	}
+
+	// a resource without an applied status never has its generation applied
+	r := &srlv1.Registration{ObjectMeta: metav1.ObjectMeta{Name: "reg", Generation: 1}}
+	setGenerationApplied(r)
+	if generationApplied(r) {
+		t.Errorf("generationApplied(...): want false for a resource without an applied status")
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errVniNotSet         = "either the vni or the vniPoolRef must be set"
+	errRouteTargetNotSet = "either the routeTarget or the autonomousSystem must be set"
+)
+
+const (
+	defaultTunnelInterface = "vxlan0"
+	defaultIrbInterface    = "irb0"
+)
+
+// routeTarget returns the route target, or the route target target:<as>:<evi>
+// when the route target is not set.
+func routeTarget(rt string, as, evi uint32) (string, error) {
+	switch {
+	case rt != "":
+		return rt, nil
+	case as == 0:
+		return "", errors.New(errRouteTargetNotSet)
+	default:
+		return "target:" + strconv.FormatUint(uint64(as), 10) + ":" + strconv.FormatUint(uint64(evi), 10), nil
+	}
+}
+
+// claimVni returns the vni, or the VXLAN network identifier claimed from the
+// referenced SrlVniPool when the vni is not set.
+func claimVni(ctx context.Context, pc *poolClaimer, vni uint32, ref *srlv1.PoolReference) (uint32, error) {
+	switch {
+	case vni != 0:
+		return vni, nil
+	case ref == nil:
+		return 0, errors.New(errVniNotSet)
+	default:
+		return pc.claimNumber(ctx, srlv1.VniPoolKind, ref.Name, "vni")
+	}
+}
+
+// subinterfaceName returns the name of a subinterface, e.g. ethernet-1/1.10
+func subinterfaceName(ifName string, index uint32) string {
+	return ifName + "." + strconv.FormatUint(uint64(index), 10)
+}
+
+// An evpnService holds the parameters that are common to the EVPN-VXLAN services
+// and renders the network instance, subinterface, vxlan-interface, bgp-evpn and
+// bgp-vpn child resources per network node.
+type evpnService struct {
+	// name of the network instance
+	name string
+	// owner is the name of the composite resource
+	owner           string
+	active          bool
+	deletionPolicy  nddv1.DeletionPolicy
+	networkInstance string
+	tunnelInterface string
+	// vxlanType is the type of the vxlan-interface, bridged or routed
+	vxlanType   string
+	vni         uint32
+	evi         uint32
+	routeTarget string
+}
+
+// vxlanInterfaceName returns the name of the vxlan-interface of the service.
+func (s *evpnService) vxlanInterfaceName() string {
+	return subinterfaceName(s.tunnelInterface, s.vni)
+}
+
+// objectMeta returns the object meta of a child resource of the network node.
+func (s *evpnService) objectMeta(nodeName string, parts ...string) metav1.ObjectMeta {
+	return childObjectMeta(s.owner, append([]string{nodeName}, parts...)...)
+}
+
+// subinterfaceChild renders a subinterface of the network node.
+func (s *evpnService) subinterfaceChild(nodeName, ifName string, si *srlv1.InterfaceSubinterface) compositeChild {
+	return compositeChild{networkNode: nodeName, obj: &srlv1.SrlInterfaceSubinterface{
+		ObjectMeta: s.objectMeta(nodeName, ifName, strconv.FormatUint(uint64(*si.Index), 10)),
+		Spec: srlv1.InterfaceSubinterfaceSpec{
+			ResourceSpec: childResourceSpec(s.active, s.deletionPolicy, nodeName),
+			ForNetworkNode: srlv1.InterfaceSubinterfaceParameters{
+				InterfaceName:            utils.StringPtr(ifName),
+				SrlInterfaceSubinterface: si,
+			},
+		},
+	}}
+}
+
+// vxlanInterfaceChild renders the vxlan-interface of the network node.
+func (s *evpnService) vxlanInterfaceChild(nodeName string) compositeChild {
+	return compositeChild{networkNode: nodeName, obj: &srlv1.SrlTunnelinterfaceVxlaninterface{
+		ObjectMeta: s.objectMeta(nodeName, "vxlan"),
+		Spec: srlv1.TunnelinterfaceVxlaninterfaceSpec{
+			ResourceSpec: childResourceSpec(s.active, s.deletionPolicy, nodeName),
+			ForNetworkNode: srlv1.TunnelinterfaceVxlaninterfaceParameters{
+				TunnelInterfaceName: utils.StringPtr(s.tunnelInterface),
+				SrlTunnelinterfaceVxlaninterface: &srlv1.TunnelinterfaceVxlaninterface{
+					Index:   utils.Uint32Ptr(s.vni),
+					Type:    utils.StringPtr(s.vxlanType),
+					Ingress: &srlv1.TunnelinterfaceVxlaninterfaceIngress{Vni: utils.Uint32Ptr(s.vni)},
+				},
+			},
+		},
+	}}
+}
+
+// networkInstanceChild renders the network instance of the network node with
+// the supplied subinterfaces and the vxlan-interface of the service.
+func (s *evpnService) networkInstanceChild(nodeName string, subinterfaces []string) compositeChild {
+	itfces := make([]*srlv1.NetworkinstanceInterface, 0, len(subinterfaces))
+	for _, itfce := range subinterfaces {
+		itfces = append(itfces, &srlv1.NetworkinstanceInterface{Name: utils.StringPtr(itfce)})
+	}
+	return compositeChild{networkNode: nodeName, obj: &srlv1.SrlNetworkinstance{
+		ObjectMeta: s.objectMeta(nodeName, "ni"),
+		Spec: srlv1.NetworkinstanceSpec{
+			ResourceSpec: childResourceSpec(s.active, s.deletionPolicy, nodeName),
+			ForNetworkNode: srlv1.NetworkinstanceParameters{
+				SrlNetworkinstance: &srlv1.Networkinstance{
+					Name:           utils.StringPtr(s.name),
+					Type:           utils.StringPtr(s.networkInstance),
+					AdminState:     utils.StringPtr("enable"),
+					Interface:      itfces,
+					VxlanInterface: []*srlv1.NetworkinstanceVxlanInterface{{Name: utils.StringPtr(s.vxlanInterfaceName())}},
+				},
+			},
+		},
+	}}
+}
+
+// bgpEvpnChild renders the bgp-evpn instance of the network node.
+func (s *evpnService) bgpEvpnChild(nodeName string, routes *srlv1.NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes) compositeChild {
+	return compositeChild{networkNode: nodeName, obj: &srlv1.SrlNetworkinstanceProtocolsBgpevpn{
+		ObjectMeta: s.objectMeta(nodeName, "bgp-evpn"),
+		Spec: srlv1.NetworkinstanceProtocolsBgpevpnSpec{
+			ResourceSpec: childResourceSpec(s.active, s.deletionPolicy, nodeName),
+			ForNetworkNode: srlv1.NetworkinstanceProtocolsBgpevpnParameters{
+				NetworkInstanceName: utils.StringPtr(s.name),
+				SrlNetworkinstanceProtocolsBgpevpn: &srlv1.NetworkinstanceProtocolsBgpevpn{
+					BgpInstance: []*srlv1.NetworkinstanceProtocolsBgpevpnBgpInstance{{
+						Id:                utils.StringPtr("1"),
+						AdminState:        utils.StringPtr("enable"),
+						EncapsulationType: utils.StringPtr("vxlan"),
+						Evi:               utils.Uint32Ptr(s.evi),
+						VxlanInterface:    utils.StringPtr(s.vxlanInterfaceName()),
+						Routes:            routes,
+					}},
+				},
+			},
+		},
+	}}
+}
+
+// bgpVpnChild renders the bgp-vpn instance of the network node with the route
+// target of the service.
+func (s *evpnService) bgpVpnChild(nodeName string) compositeChild {
+	return compositeChild{networkNode: nodeName, obj: &srlv1.SrlNetworkinstanceProtocolsBgpvpn{
+		ObjectMeta: s.objectMeta(nodeName, "bgp-vpn"),
+		Spec: srlv1.NetworkinstanceProtocolsBgpvpnSpec{
+			ResourceSpec: childResourceSpec(s.active, s.deletionPolicy, nodeName),
+			ForNetworkNode: srlv1.NetworkinstanceProtocolsBgpvpnParameters{
+				NetworkInstanceName: utils.StringPtr(s.name),
+				SrlNetworkinstanceProtocolsBgpvpn: &srlv1.NetworkinstanceProtocolsBgpvpn{
+					BgpInstance: []*srlv1.NetworkinstanceProtocolsBgpvpnBgpInstance{{
+						Id: uint8Ptr(1),
+						RouteTarget: &srlv1.NetworkinstanceProtocolsBgpvpnBgpInstanceRouteTarget{
+							ExportRt: utils.StringPtr(s.routeTarget),
+							ImportRt: utils.StringPtr(s.routeTarget),
+						},
+					}},
+				},
+			},
+		},
+	}}
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/tracing"
+)
+
+const (
+	// Errors
+	errPausedResource        = "reconciliation is paused by annotation, changes are not pushed to the network node"
+	errPausedNetworkNode     = "reconciliation is paused by network node label, changes are not pushed to the network node"
+	errListMaintenanceWindow = "cannot list MaintenanceWindows"
+	errMaintenanceSchedule   = "cannot parse MaintenanceWindow schedule"
+	errMaintenanceSelector   = "cannot parse MaintenanceWindow network node selector"
+	errFmtWaitingForWindow   = "waiting for maintenance window, next window opens at %s"
+)
+
+// newGuardedConnecter wraps the ExternalConnecter of a controller, the level of
+// the resources orders their operations on a network node.
+func newGuardedConnecter(kube client.Client, l logging.Logger, level int, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &guardedConnecter{ExternalConnecter: c, kube: kube, log: l, level: level, limiter: nodeLimits, failures: newCommitFailures()}
+}
+
+// A guardedConnecter connects a guardedExternal, the commit failures are shared
+// by the resources of the controller.
+type guardedConnecter struct {
+	managed.ExternalConnecter
+	kube     client.Client
+	log      logging.Logger
+	level    int
+	limiter  *nodeLimiter
+	failures *commitFailures
+}
+
+// Connect produces the ExternalClient of the wrapped connecter and guards its
+// operations, the dial of the network node is limited like the operations.
+func (c *guardedConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	key := traceKey(mg)
+	attrs := resourceAttributes(mg)
+	ctx, span := tracing.Start(tracing.WithReconcile(ctx, key), "Connect", attrs...)
+	ec, err := c.connect(ctx, mg)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	span.SetAttributes(tracing.AttrNetworkNode.StringSlice(ec.GetTarget()))
+	tracing.End(span, nil)
+	return &guardedExternal{
+		ExternalClient: ec,
+		kube:           c.kube,
+		log:            c.log.WithValues("resource", mg.GetName()),
+		level:          c.level,
+		limiter:        c.limiter,
+		failures:       c.failures,
+		config:         providerConfigs.forNodeName(ctx, c.kube, networkNodeName(mg)),
+		key:            key,
+		attrs:          append(attrs, tracing.AttrNetworkNode.StringSlice(ec.GetTarget())),
+	}, nil
+}
+
+func (c *guardedConnecter) connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	var nodes []string
+	if name := networkNodeName(mg); name != "" {
+		nodes = []string{name}
+	}
+	release, err := c.limiter.acquire(ctx, nodes, c.level)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.ExternalConnecter.Connect(ctx, mg)
+}
+
+// A guardedExternal guards the operations of an ExternalClient, every operation
+// is a span of the reconcile of the resource and is limited by the rate and
+// concurrency limits of the network node.
+type guardedExternal struct {
+	managed.ExternalClient
+	kube     client.Client
+	log      logging.Logger
+	level    int
+	limiter  *nodeLimiter
+	failures *commitFailures
+	// config is the provider config of the network node of the resource
+	config *providerConfig
+	// key of the span of the reconcile of the resource and the attributes of
+	// the spans of the operations
+	key   string
+	attrs []attribute.KeyValue
+}
+
+// start starts the span of the operation as a child of the span of the
+// reconcile of the resource.
+func (e *guardedExternal) start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracing.Start(tracing.WithReconcile(ctx, e.key), name, e.attrs...)
+}
+
+func (e *guardedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := e.start(ctx, "Observe")
+	observation, err := e.observe(ctx, mg)
+	span.SetAttributes(
+		attribute.Bool("observation.exists", observation.ResourceExists),
+		attribute.Bool("observation.up_to_date", observation.ResourceUpToDate),
+		tracing.AttrUpdates.Int(len(observation.ResourceUpdates)),
+		tracing.AttrDeletes.Int(len(observation.ResourceDeletes)),
+	)
+	tracing.End(span, err)
+	return observation, err
+}
+
+// observe is never blocked, such that the resource keeps reporting its state,
+// the observed drift is handled by the drift policy of the resource.
+func (e *guardedExternal) observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	restore, err := resolvePoolRefs(ctx, e.kube, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	defer restore()
+	release, err := e.limiter.acquire(ctx, e.GetTarget(), e.level)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	defer release()
+	observation, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return observation, err
+	}
+	if observation, err = e.drift(mg, observation); err != nil {
+		return observation, err
+	}
+	return e.commitObservation(mg, observation), nil
+}
+
+func (e *guardedExternal) GetConfig(ctx context.Context) ([]byte, error) {
+	ctx, span := e.start(ctx, "GetConfig")
+	cfg, err := e.getConfig(ctx)
+	tracing.End(span, err)
+	return cfg, err
+}
+
+func (e *guardedExternal) getConfig(ctx context.Context) ([]byte, error) {
+	release, err := e.limiter.acquire(ctx, e.GetTarget(), e.level)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return e.ExternalClient.GetConfig(ctx)
+}
+
+// Create records the set request of the resource in the audit trail.
+func (e *guardedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := e.start(ctx, "Create")
+	ctx = withAuditResource(ctx, mg)
+	creation, err := e.create(ctx, mg)
+	tracing.End(span, err)
+	return creation, err
+}
+
+// create is blocked when the change is not allowed, an identifier of the
+// resource collides or the addressing of a subinterface is invalid.
+func (e *guardedExternal) create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := e.allowChange(ctx, mg, time.Now()); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	restore, err := resolvePoolRefs(ctx, e.kube, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	defer restore()
+	if err := e.allowIdentifiers(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.allowAddressing(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	release, err := e.limiter.acquire(ctx, e.GetTarget(), e.level)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	defer release()
+	creation, err := e.ExternalClient.Create(ctx, mg)
+	nodeConfigs.changed(resourceNameOf(mg), e.GetTarget()...)
+	if err == nil {
+		setGenerationApplied(mg)
+	}
+	return creation, e.commitResult(mg, err)
+}
+
+func (e *guardedExternal) Update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	ctx, span := e.start(ctx, "Update")
+	ctx = withAuditResource(ctx, mg)
+	span.SetAttributes(tracing.AttrUpdates.Int(len(obs.ResourceUpdates)), tracing.AttrDeletes.Int(len(obs.ResourceDeletes)))
+	update, err := e.update(ctx, mg, obs)
+	tracing.End(span, err)
+	return update, err
+}
+
+// update is blocked like create, the drift of the observation is corrected.
+func (e *guardedExternal) update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	if err := e.allowChange(ctx, mg, time.Now()); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	restore, err := resolvePoolRefs(ctx, e.kube, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	defer restore()
+	if err := e.allowIdentifiers(ctx, mg); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.allowAddressing(ctx, mg); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	release, err := e.limiter.acquire(ctx, e.GetTarget(), e.level)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	defer release()
+	update, err := e.ExternalClient.Update(ctx, mg, obs)
+	nodeConfigs.changed(resourceNameOf(mg), e.GetTarget()...)
+	if err == nil {
+		setGenerationApplied(mg)
+	}
+	return update, e.commitResult(mg, err)
+}
+
+func (e *guardedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx, span := e.start(ctx, "Delete")
+	ctx = withAuditResource(ctx, mg)
+	err := e.delete(ctx, mg)
+	tracing.End(span, err)
+	return err
+}
+
+// delete is only blocked when the change is not allowed.
+func (e *guardedExternal) delete(ctx context.Context, mg resource.Managed) error {
+	if err := e.allowChange(ctx, mg, time.Now()); err != nil {
+		return err
+	}
+	restore, err := resolvePoolRefs(ctx, e.kube, mg)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	release, err := e.limiter.acquire(ctx, e.GetTarget(), e.level)
+	if err != nil {
+		return err
+	}
+	defer release()
+	err = e.ExternalClient.Delete(ctx, mg)
+	nodeConfigs.changed(resourceNameOf(mg), e.GetTarget()...)
+	return e.commitResult(mg, err)
+}
+
+// allowChange returns an error when a device changing operation is not allowed
+// for the managed resource at the supplied time. The pause and maintenance window
+// conditions are set on the managed resource, they get persisted by the reconciler
+// together with the rest of the status.
+func (e *guardedExternal) allowChange(ctx context.Context, mg resource.Managed, now time.Time) error {
+	if mg.GetAnnotations()[srlv1.AnnotationKeyPaused] == "true" {
+		e.log.Debug("change blocked", "reason", srlv1.ConditionReasonPausedByResource)
+		mg.SetConditions(srlv1.Paused(srlv1.ConditionReasonPausedByResource))
+		return errors.New(errPausedResource)
+	}
+
+	// a resource can be fanned out to multiple network nodes, every network node
+	// the resource is applied to needs to allow the change
+	for _, nodeName := range e.GetTarget() {
+		nn := &ndrv1.NetworkNode{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: nodeName}, nn); err != nil {
+			return errors.Wrap(err, errGetNetworkNode)
+		}
+		if nn.GetLabels()[srlv1.LabelKeyPaused] == "true" {
+			e.log.Debug("change blocked", "reason", srlv1.ConditionReasonPausedByNetworkNode, "networknode", nodeName)
+			mg.SetConditions(srlv1.Paused(srlv1.ConditionReasonPausedByNetworkNode))
+			return errors.New(errPausedNetworkNode)
+		}
+
+		open, next, err := maintenanceWindowOpen(ctx, e.kube, nn, now)
+		if err != nil {
+			return err
+		}
+		if !open {
+			msg := errors.Errorf(errFmtWaitingForWindow, next.Format(time.RFC3339))
+			e.log.Debug("change blocked", "reason", srlv1.ConditionReasonOutsideWindow, "networknode", nodeName, "next", next)
+			mg.SetConditions(srlv1.WaitingForWindow().WithMessage(msg.Error()))
+			return msg
+		}
+	}
+	if mg.GetCondition(srlv1.ConditionKindPaused).Status == corev1.ConditionTrue {
+		mg.SetConditions(srlv1.NotPaused())
+	}
+	if mg.GetCondition(srlv1.ConditionKindWaitingForWindow).Status == corev1.ConditionTrue {
+		mg.SetConditions(srlv1.InsideWindow())
+	}
+	return nil
+}
+
+// maintenanceWindowOpen returns true when no MaintenanceWindow applies to the
+// network node or when at least one of the MaintenanceWindows that apply to the
+// network node is open at the supplied time. When all windows are closed the
+// time at which the first window opens is returned.
+func maintenanceWindowOpen(ctx context.Context, kube client.Client, nn *ndrv1.NetworkNode, now time.Time) (bool, time.Time, error) {
+	mwl := &srlv1.MaintenanceWindowList{}
+	if err := kube.List(ctx, mwl); err != nil {
+		return false, time.Time{}, errors.Wrap(err, errListMaintenanceWindow)
+	}
+
+	applies := false
+	var next time.Time
+	for _, mw := range mwl.Items {
+		if mw.Spec.NetworkNodeSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(mw.Spec.NetworkNodeSelector)
+			if err != nil {
+				return false, time.Time{}, errors.Wrap(err, errMaintenanceSelector)
+			}
+			if !selector.Matches(labels.Set(nn.GetLabels())) {
+				continue
+			}
+		}
+		applies = true
+
+		schedule, err := cron.ParseStandard(mw.Spec.Schedule)
+		if err != nil {
+			return false, time.Time{}, errors.Wrap(err, errMaintenanceSchedule)
+		}
+		// the window is open when it got opened within the last duration
+		if start := schedule.Next(now.Add(-mw.Spec.Duration.Duration)); !start.After(now) {
+			return true, time.Time{}, nil
+		}
+		if n := schedule.Next(now); next.IsZero() || n.Before(next) {
+			next = n
+		}
+	}
+	return !applies, next, nil
+}
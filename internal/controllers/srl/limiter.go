@@ -0,0 +1,195 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultNodeQPS is the default rate of the operations on a network node
+	DefaultNodeQPS = 10
+	// DefaultNodeBurst is the default burst of the operations on a network node
+	DefaultNodeBurst = 20
+	// DefaultNodeMaxInFlight is the default number of concurrent operations on
+	// a network node
+	DefaultNodeMaxInFlight = 4
+)
+
+// NodeLimits are the limits of the operations on the device driver of a network
+// node, they are shared by all the controllers of the provider.
+type NodeLimits struct {
+	// QPS is the rate of the operations per second
+	QPS float64
+	// Burst is the number of operations that can exceed the rate
+	Burst int
+	// MaxInFlight is the number of concurrent operations
+	MaxInFlight int
+}
+
+// DefaultNodeLimits returns the default limits of a network node.
+func DefaultNodeLimits() NodeLimits {
+	return NodeLimits{QPS: DefaultNodeQPS, Burst: DefaultNodeBurst, MaxInFlight: DefaultNodeMaxInFlight}
+}
+
+// nodeLimits is the limiter shared by the controllers, its limits are set by
+// the options of Configure before the controllers are set up.
+var nodeLimits = newNodeLimiter(DefaultNodeLimits())
+
+// A nodeLimiter limits the rate and the concurrency of the operations per
+// network node. When all the slots of a network node are in use, the waiting
+// operations get a slot in the order of the level of their resource, such that
+// parents like interfaces and network-instances are pushed before children.
+type nodeLimiter struct {
+	mu     sync.Mutex
+	limits NodeLimits
+	nodes  map[string]*nodeLimit
+	seq    uint64
+}
+
+type nodeLimit struct {
+	tokens   *rate.Limiter
+	inFlight int
+	waiters  []*nodeWaiter
+}
+
+// A nodeWaiter is an operation that waits for a slot, ready is closed when the
+// slot is handed over to the waiter.
+type nodeWaiter struct {
+	level int
+	seq   uint64
+	ready chan struct{}
+}
+
+func newNodeLimiter(l NodeLimits) *nodeLimiter {
+	return &nodeLimiter{limits: l, nodes: make(map[string]*nodeLimit)}
+}
+
+// setLimits sets the limits, the limits of the network nodes that are already
+// limited are reset.
+func (n *nodeLimiter) setLimits(l NodeLimits) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.limits = l
+	for _, nl := range n.nodes {
+		nl.tokens.SetLimit(rate.Limit(l.QPS))
+		nl.tokens.SetBurst(l.Burst)
+	}
+}
+
+func (n *nodeLimiter) node(name string) *nodeLimit {
+	nl, ok := n.nodes[name]
+	if !ok {
+		nl = &nodeLimit{tokens: rate.NewLimiter(rate.Limit(n.limits.QPS), n.limits.Burst)}
+		n.nodes[name] = nl
+	}
+	return nl
+}
+
+// acquire waits for a slot and a token of every network node, the returned
+// function releases the slots. The network nodes are acquired in order, such
+// that operations on multiple network nodes do not deadlock.
+func (n *nodeLimiter) acquire(ctx context.Context, nodes []string, level int) (func(), error) {
+	sorted := append([]string{}, nodes...)
+	sort.Strings(sorted)
+	releases := make([]func(), 0, len(sorted))
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+	for _, name := range sorted {
+		r, err := n.acquireNode(ctx, name, level)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, r)
+	}
+	return release, nil
+}
+
+func (n *nodeLimiter) acquireNode(ctx context.Context, name string, level int) (func(), error) {
+	n.mu.Lock()
+	nl := n.node(name)
+	if n.limits.MaxInFlight <= 0 || (nl.inFlight < n.limits.MaxInFlight && len(nl.waiters) == 0) {
+		nl.inFlight++
+		n.mu.Unlock()
+	} else {
+		n.seq++
+		w := &nodeWaiter{level: level, seq: n.seq, ready: make(chan struct{})}
+		nl.waiters = append(nl.waiters, w)
+		n.mu.Unlock()
+
+		select {
+		case <-w.ready:
+		case <-ctx.Done():
+			n.mu.Lock()
+			if n.removeWaiter(nl, w) {
+				n.mu.Unlock()
+				return nil, ctx.Err()
+			}
+			n.mu.Unlock()
+			// the slot got handed over while the context got cancelled
+			n.release(nl)
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := nl.tokens.Wait(ctx); err != nil {
+		n.release(nl)
+		return nil, err
+	}
+	var once sync.Once
+	return func() { once.Do(func() { n.release(nl) }) }, nil
+}
+
+// release hands the slot over to the waiter with the lowest level, the slot is
+// freed when no operation is waiting.
+func (n *nodeLimiter) release(nl *nodeLimit) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(nl.waiters) == 0 {
+		nl.inFlight--
+		return
+	}
+	next := 0
+	for i, w := range nl.waiters {
+		if w.level < nl.waiters[next].level || (w.level == nl.waiters[next].level && w.seq < nl.waiters[next].seq) {
+			next = i
+		}
+	}
+	w := nl.waiters[next]
+	nl.waiters = append(nl.waiters[:next], nl.waiters[next+1:]...)
+	close(w.ready)
+}
+
+// removeWaiter removes the waiter, false is returned when the waiter already
+// got a slot.
+func (n *nodeLimiter) removeWaiter(nl *nodeLimit, w *nodeWaiter) bool {
+	for i, ww := range nl.waiters {
+		if ww == w {
+			nl.waiters = append(nl.waiters[:i], nl.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"reflect"
+
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// Errors
+	errListNetworkNodeResources = "cannot list the resources of the network node"
+)
+
+// networkNodeChangedPredicate passes the events of network nodes of which the
+// spec, the labels or the conditions changed, e.g. when the device driver of the
+// network node becomes configured. Status updates that do not change a condition
+// are ignored.
+func networkNodeChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				return true
+			}
+			if !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) {
+				return true
+			}
+			o, ok := e.ObjectOld.(*ndrv1.NetworkNode)
+			if !ok {
+				return true
+			}
+			n, ok := e.ObjectNew.(*ndrv1.NetworkNode)
+			if !ok {
+				return true
+			}
+			return !reflect.DeepEqual(o.Status.Conditions, n.Status.Conditions)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return !e.DeleteStateUnknown
+		},
+	}
+}
+
+// networkNodeMapFunc returns a map function that enqueues the managed resources
+// of the list that match the network node.
+func networkNodeMapFunc(kube client.Client, l logging.Logger, newList func() resource.ManagedList, match func(mg resource.Managed, nn client.Object) bool) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		ml := newList()
+		if err := kube.List(context.TODO(), ml); err != nil {
+			l.Debug(errListNetworkNodeResources, "networknode", o.GetName(), "error", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0)
+		for _, mg := range ml.GetItems() {
+			if match(mg, o) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{
+					Namespace: mg.GetNamespace(),
+					Name:      mg.GetName(),
+				}})
+			}
+		}
+		return reqs
+	}
+}
+
+// referencesNetworkNode returns true when the managed resource references the
+// network node, selects it or was synced to it before.
+func referencesNetworkNode(mg resource.Managed, nn client.Object) bool {
+	if ref := mg.GetNetworkNodeReference(); ref != nil && ref.Name == nn.GetName() {
+		return true
+	}
+	s, ok := mg.(networkNodeSelectable)
+	if !ok {
+		return false
+	}
+	for _, ss := range s.GetNetworkNodeSyncStatus() {
+		if ss.Name == nn.GetName() {
+			return true
+		}
+	}
+	selector, err := metav1.LabelSelectorAsSelector(s.GetNetworkNodeSelector())
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(nn.GetLabels()))
+}
+
+// registersNetworkNode returns true for every network node, since a change of
+// a network node can move it to another registration.
+func registersNetworkNode(mg resource.Managed, nn client.Object) bool {
+	return true
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"time"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/audit"
+	"github.com/yndd/ndd-provider-srl/internal/shard"
+)
+
+// Options are the settings of the provider that are shared by the controllers
+// of the package.
+type Options struct {
+	// NodeLimits are the limits of the operations on the device driver of a
+	// network node
+	NodeLimits NodeLimits
+
+	// ConfigCacheMaxAge is the age after which the cached config of a network
+	// node is read again, 0 disables the cache
+	ConfigCacheMaxAge time.Duration
+
+	// Sharder shards the network nodes across the provider replicas, the
+	// replica reconciles all network nodes when it is nil
+	Sharder *shard.Sharder
+
+	// AuditRecorder records the set requests pushed to the network nodes,
+	// nothing is recorded when it is nil
+	AuditRecorder *audit.Recorder
+
+	// Autopilot corrects the drift of the resources without a drift policy
+	// annotation when true and reports it when false, unless the provider
+	// config of their network node sets the autopilot
+	Autopilot bool
+}
+
+// Configure applies the options shared by the controllers of the package, it
+// is called once before the controllers are set up.
+func Configure(o Options) {
+	nodeLimits.setLimits(o.NodeLimits)
+	nodeConfigs.setMaxAge(o.ConfigCacheMaxAge)
+	shards = o.Sharder
+	changes = o.AuditRecorder
+	defaultDriftPolicy = srlv1.DriftPolicyReport
+	if o.Autopilot {
+		defaultDriftPolicy = srlv1.DriftPolicyCorrect
+	}
+}
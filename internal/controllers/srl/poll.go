@@ -0,0 +1,88 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"time"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// newPolledReconciler wraps a managed reconciler such that the resources with a
+// poll interval annotation are checked for drift at their own interval, the
+// other resources at the poll interval of the provider config of their network
+// node or else at the poll interval of the provider. The managed reconciler
+// polls at the interval of the provider, the requeue of a resource it found up
+// to date is replaced by the poll interval of the resource.
+func newPolledReconciler(kube client.Client, obj client.Object, poll time.Duration, r reconcile.Reconciler) reconcile.Reconciler {
+	return &polledReconciler{Reconciler: r, kube: kube, obj: obj, poll: poll}
+}
+
+type polledReconciler struct {
+	reconcile.Reconciler
+	kube client.Client
+	obj  client.Object
+	poll time.Duration
+}
+
+func (r *polledReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil || result.Requeue || result.RequeueAfter != r.poll {
+		return result, err
+	}
+	o := r.obj.DeepCopyObject().(client.Object)
+	if err := r.kube.Get(ctx, req.NamespacedName, o); err != nil {
+		return result, nil
+	}
+	if !upToDate(o) {
+		return result, nil
+	}
+	if d := r.pollInterval(ctx, o); d > 0 {
+		result.RequeueAfter = d
+	}
+	return result, nil
+}
+
+// pollInterval returns the poll interval of the resource, 0 is returned when
+// the interval of the provider applies.
+func (r *polledReconciler) pollInterval(ctx context.Context, o client.Object) time.Duration {
+	// an invalid poll interval is reported by the observation of the resource
+	if d, err := pollIntervalOf(o); err == nil && d > 0 {
+		return d
+	}
+	if mg, ok := o.(resource.Managed); ok {
+		return providerConfigs.forNodeName(ctx, r.kube, networkNodeName(mg)).pollInterval()
+	}
+	return 0
+}
+
+// upToDate returns true when the managed reconciler found the resource up to
+// date, which is the only outcome that sets it available and synced. The other
+// outcomes set it creating, updating, deleting or unknown.
+func upToDate(o client.Object) bool {
+	mg, ok := o.(resource.Managed)
+	if !ok || meta.WasDeleted(mg) {
+		return false
+	}
+	ready, synced := mg.GetCondition(nddv1.ConditionKindReady), mg.GetCondition(nddv1.ConditionKindSynced)
+	return ready.Reason == nddv1.ConditionReasonAvailable && synced.Reason == nddv1.ConditionReasonReconcileSuccess
+}
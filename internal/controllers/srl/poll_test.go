@@ -0,0 +1,99 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+type reconcileFn func(ctx context.Context, req reconcile.Request) (reconcile.Result, error)
+
+func (fn reconcileFn) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return fn(ctx, req)
+}
+
+func TestPolledReconciler(t *testing.T) {
+	const poll = time.Minute
+	errBoom := errors.New("boom")
+	ntp := func(interval string, c ...nddv1.Condition) *srlv1.SrlSystemNtp {
+		o := &srlv1.SrlSystemNtp{ObjectMeta: metav1.ObjectMeta{Name: "ntp"}}
+		if interval != "" {
+			o.SetAnnotations(map[string]string{srlv1.AnnotationKeyPollInterval: interval})
+		}
+		o.SetConditions(c...)
+		return o
+	}
+
+	cases := map[string]struct {
+		obj    client.Object
+		result reconcile.Result
+		err    error
+		want   reconcile.Result
+	}{
+		"UpToDate": {
+			obj:    ntp("10s", nddv1.Available(), nddv1.ReconcileSuccess()),
+			result: reconcile.Result{RequeueAfter: poll},
+			want:   reconcile.Result{RequeueAfter: 10 * time.Second},
+		},
+		"UpToDateWithoutInterval": {
+			obj:    ntp("", nddv1.Available(), nddv1.ReconcileSuccess()),
+			result: reconcile.Result{RequeueAfter: poll},
+			want:   reconcile.Result{RequeueAfter: poll},
+		},
+		"Updating": {
+			obj:    ntp("10s", nddv1.Updating(), nddv1.ReconcileSuccess()),
+			result: reconcile.Result{RequeueAfter: poll},
+			want:   reconcile.Result{RequeueAfter: poll},
+		},
+		"ShortWait": {
+			obj:    ntp("10s", nddv1.Available(), nddv1.ReconcileSuccess()),
+			result: reconcile.Result{RequeueAfter: 5 * time.Second},
+			want:   reconcile.Result{RequeueAfter: 5 * time.Second},
+		},
+		"Error": {
+			obj:    ntp("10s", nddv1.Available(), nddv1.ReconcileSuccess()),
+			result: reconcile.Result{RequeueAfter: poll},
+			err:    errBoom,
+			want:   reconcile.Result{RequeueAfter: poll},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := newTestClient(t, tc.obj)
+			r := newPolledReconciler(kube, &srlv1.SrlSystemNtp{}, poll, reconcileFn(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return tc.result, tc.err
+			}))
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.obj)})
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Reconcile(...): want error %v, got %v", tc.err, err)
+			}
+			if got != tc.want {
+				t.Errorf("Reconcile(...): want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
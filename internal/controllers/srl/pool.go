@@ -0,0 +1,537 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errGetPool                     = "cannot get pool"
+	errListPools                   = "cannot list pools"
+	errUpdatePoolStatus            = "cannot update pool status"
+	errGetPoolOwner                = "cannot get owner of pool allocation"
+	errClaimPool                   = "cannot claim value from pool"
+	errReleasePool                 = "cannot release values from pool"
+	errUnexpectedPool              = "unexpected pool kind"
+	errFmtPoolNotFound             = "%s %s not found"
+	errFmtPoolDeleted              = "%s %s is being deleted"
+	errFmtInvalidPool              = "invalid pool %s"
+	errFmtInvalidAllocationLength  = "invalid allocation length %d for prefix %s"
+	errFmtPoolExhausted            = "pool %s exhausted"
+	errFmtPoolOverlaps             = "%s %s overlaps with %s %s"
+	errFmtUnexpectedAllocationSize = "%s %s allocates /%d prefixes, expected /%d"
+)
+
+// poolKinds are the kinds of pools.
+var poolKinds = []string{srlv1.IpPoolKind, srlv1.AsnPoolKind, srlv1.VniPoolKind}
+
+// A pool allocates values for claims, the allocations are persisted in the
+// status of the pool.
+type pool interface {
+	client.Object
+	SetConditions(c ...nddv1.Condition)
+	GetAllocations() []srlv1.PoolAllocation
+	SetAllocations(a []srlv1.PoolAllocation)
+}
+
+// A poolRange returns the values of a pool in order.
+type poolRange interface {
+	// value returns the i-th value of the pool, false is returned when the pool
+	// has less values
+	value(i uint64) (string, bool)
+
+	// overlaps returns true when both values overlap
+	overlaps(a, b string) bool
+
+	// intersects returns true when the range shares values with the other range
+	intersects(o poolRange) bool
+}
+
+// newPoolRange returns the range of values of the pool.
+func newPoolRange(p pool) (poolRange, error) {
+	switch o := p.(type) {
+	case *srlv1.SrlIpPool:
+		return newPrefixRange(o.Spec.Prefix, int(o.Spec.AllocationLength))
+	case *srlv1.SrlAsnPool:
+		return newNumberRange(o.GetName(), o.Spec.Start, o.Spec.End)
+	case *srlv1.SrlVniPool:
+		return newNumberRange(o.GetName(), o.Spec.Start, o.Spec.End)
+	default:
+		return nil, errors.New(errUnexpectedPool)
+	}
+}
+
+// poolKind returns the kind of the pool.
+func poolKind(p pool) string {
+	switch p.(type) {
+	case *srlv1.SrlIpPool:
+		return srlv1.IpPoolKind
+	case *srlv1.SrlAsnPool:
+		return srlv1.AsnPoolKind
+	case *srlv1.SrlVniPool:
+		return srlv1.VniPoolKind
+	default:
+		return ""
+	}
+}
+
+// precedingOverlap returns the pool that was created before the pool and shares
+// values with it, nil is returned when there is none. Only the oldest of
+// overlapping pools allocates values, such that two pools never allocate the
+// same value concurrently, also when they were both admitted at the same time.
+func precedingOverlap(pools []pool, p pool, r poolRange) pool {
+	for _, o := range pools {
+		if o.GetName() == p.GetName() || meta.WasDeleted(o) || !precedes(o, p) {
+			continue
+		}
+		or, err := newPoolRange(o)
+		if err != nil {
+			continue
+		}
+		if r.intersects(or) {
+			return o
+		}
+	}
+	return nil
+}
+
+// precedes returns true when pool a was created before pool b, pools created
+// at the same time are ordered by name.
+func precedes(a, b pool) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}
+
+// listPools returns the pools of the kind.
+func listPools(ctx context.Context, kube client.Client, kind string) ([]pool, error) {
+	pools := make([]pool, 0)
+	switch kind {
+	case srlv1.IpPoolKind:
+		l := &srlv1.SrlIpPoolList{}
+		if err := kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errListPools)
+		}
+		for i := range l.Items {
+			pools = append(pools, &l.Items[i])
+		}
+	case srlv1.AsnPoolKind:
+		l := &srlv1.SrlAsnPoolList{}
+		if err := kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errListPools)
+		}
+		for i := range l.Items {
+			pools = append(pools, &l.Items[i])
+		}
+	case srlv1.VniPoolKind:
+		l := &srlv1.SrlVniPoolList{}
+		if err := kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errListPools)
+		}
+		for i := range l.Items {
+			pools = append(pools, &l.Items[i])
+		}
+	default:
+		return nil, errors.New(errUnexpectedPool)
+	}
+	return pools, nil
+}
+
+// A poolClaimer claims the values of an owner from the pools and releases the
+// values that the owner no longer claims. The values of an owner are stable, a
+// claim returns the value allocated before for the same claim.
+type poolClaimer struct {
+	kube  client.Client
+	owner string
+	// pools are listed once per kind and kept up to date with the allocations
+	pools map[string][]pool
+	// claimed are the claims made through the poolClaimer
+	claimed map[string]bool
+}
+
+// newPoolClaimer returns a poolClaimer for the owner of the kind.
+func newPoolClaimer(kube client.Client, kind, name string) *poolClaimer {
+	return &poolClaimer{
+		kube:    kube,
+		owner:   kind + "/" + name,
+		pools:   make(map[string][]pool),
+		claimed: make(map[string]bool),
+	}
+}
+
+// list returns the pools of the kind.
+func (c *poolClaimer) list(ctx context.Context, kind string) ([]pool, error) {
+	if pools, ok := c.pools[kind]; ok {
+		return pools, nil
+	}
+	pools, err := listPools(ctx, c.kube, kind)
+	if err != nil {
+		return nil, err
+	}
+	c.pools[kind] = pools
+	return pools, nil
+}
+
+// get returns the pool of the kind.
+func (c *poolClaimer) get(ctx context.Context, kind, name string) (pool, error) {
+	pools, err := c.list(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pools {
+		if p.GetName() == name {
+			if meta.WasDeleted(p) {
+				return nil, errors.Errorf(errFmtPoolDeleted, kind, name)
+			}
+			return p, nil
+		}
+	}
+	return nil, errors.Errorf(errFmtPoolNotFound, kind, name)
+}
+
+// claim returns the value allocated from the pool for the claim. A new value is
+// not allocated from a pool that overlaps with a pool created before it, the
+// allocations of a pool are updated with optimistic concurrency, such that
+// concurrent claims never allocate the same value. A new value also does not
+// overlap with the values allocated from the other pools of the same kind
+// before they overlapped.
+func (c *poolClaimer) claim(ctx context.Context, kind, name, claim string) (string, error) {
+	c.claimed[kind+"/"+name+"/"+claim] = true
+
+	var value string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		p, err := c.get(ctx, kind, name)
+		if err != nil {
+			return err
+		}
+		for _, a := range p.GetAllocations() {
+			if a.Owner == c.owner && a.Claim == claim {
+				value = a.Value
+				return nil
+			}
+		}
+		r, err := newPoolRange(p)
+		if err != nil {
+			return err
+		}
+		pools, err := c.list(ctx, kind)
+		if err != nil {
+			return err
+		}
+		if o := precedingOverlap(pools, p, r); o != nil {
+			return errors.Errorf(errFmtPoolOverlaps, kind, name, kind, o.GetName())
+		}
+		for i := uint64(0); ; i++ {
+			v, ok := r.value(i)
+			if !ok {
+				return errors.Errorf(errFmtPoolExhausted, name)
+			}
+			if !allocated(pools, r, v) {
+				value = v
+				break
+			}
+		}
+		p.SetAllocations(append(p.GetAllocations(), srlv1.PoolAllocation{Owner: c.owner, Claim: claim, Value: value}))
+		if err := c.kube.Status().Update(ctx, p); err != nil {
+			// the pools are listed again when the allocation is retried
+			delete(c.pools, kind)
+			return err
+		}
+		return nil
+	})
+	return value, errors.Wrap(err, errClaimPool)
+}
+
+// claimNumber returns the number allocated from the pool for the claim.
+func (c *poolClaimer) claimNumber(ctx context.Context, kind, name, claim string) (uint32, error) {
+	v, err := c.claim(ctx, kind, name, claim)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(v, 10, 32)
+	return uint32(n), errors.Wrap(err, errClaimPool)
+}
+
+// claimPrefix returns the prefix allocated from the SrlIpPool for the claim, the
+// SrlIpPool must allocate prefixes of the length.
+func (c *poolClaimer) claimPrefix(ctx context.Context, name, claim string, length uint8) (string, error) {
+	p, err := c.get(ctx, srlv1.IpPoolKind, name)
+	if err != nil {
+		return "", errors.Wrap(err, errClaimPool)
+	}
+	if l := p.(*srlv1.SrlIpPool).Spec.AllocationLength; l != length {
+		return "", errors.Errorf(errFmtUnexpectedAllocationSize, srlv1.IpPoolKind, name, l, length)
+	}
+	return c.claim(ctx, srlv1.IpPoolKind, name, claim)
+}
+
+// release releases the values of the owner that were not claimed through the
+// poolClaimer.
+func (c *poolClaimer) release(ctx context.Context) error {
+	for _, kind := range poolKinds {
+		pools, err := c.list(ctx, kind)
+		if err != nil {
+			return err
+		}
+		for _, p := range pools {
+			keep := make([]srlv1.PoolAllocation, 0, len(p.GetAllocations()))
+			for _, a := range p.GetAllocations() {
+				if a.Owner != c.owner || c.claimed[kind+"/"+p.GetName()+"/"+a.Claim] {
+					keep = append(keep, a)
+				}
+			}
+			if len(keep) == len(p.GetAllocations()) {
+				continue
+			}
+			p.SetAllocations(keep)
+			if err := c.kube.Status().Update(ctx, p); err != nil {
+				return errors.Wrap(err, errReleasePool)
+			}
+		}
+	}
+	return nil
+}
+
+// allocated returns true when the value overlaps with a value allocated from
+// one of the pools.
+func allocated(pools []pool, r poolRange, v string) bool {
+	for _, p := range pools {
+		for _, a := range p.GetAllocations() {
+			if r.overlaps(a.Value, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// A numberRange allocates the numbers from start to end.
+type numberRange struct {
+	start uint32
+	end   uint32
+}
+
+// newNumberRange returns the range of numbers from start to end.
+func newNumberRange(name string, start, end uint32) (*numberRange, error) {
+	if end < start {
+		return nil, errors.Errorf(errFmtInvalidPool, name)
+	}
+	return &numberRange{start: start, end: end}, nil
+}
+
+func (r *numberRange) value(i uint64) (string, bool) {
+	v := uint64(r.start) + i
+	if v > uint64(r.end) {
+		return "", false
+	}
+	return strconv.FormatUint(v, 10), true
+}
+
+func (r *numberRange) overlaps(a, b string) bool {
+	return a == b
+}
+
+func (r *numberRange) intersects(o poolRange) bool {
+	or, ok := o.(*numberRange)
+	return ok && r.start <= or.end && or.start <= r.end
+}
+
+// A prefixRange allocates the prefixes of the allocation length from a prefix.
+type prefixRange struct {
+	prefix *net.IPNet
+	ip     net.IP
+	bits   int
+	length int
+	size   uint64
+}
+
+// newPrefixRange returns the range of prefixes of the allocation length within
+// the prefix.
+func newPrefixRange(prefix string, length int) (*prefixRange, error) {
+	_, n, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, errors.Errorf(errFmtInvalidPool, prefix)
+	}
+	ones, bits := n.Mask.Size()
+	if length < ones || length > bits {
+		return nil, errors.Errorf(errFmtInvalidAllocationLength, length, prefix)
+	}
+	r := &prefixRange{prefix: n, ip: n.IP, bits: bits, length: length, size: math.MaxUint64}
+	if ip4 := n.IP.To4(); ip4 != nil {
+		r.ip = ip4
+	}
+	if length-ones < 64 {
+		r.size = uint64(1) << uint(length-ones)
+	}
+	return r, nil
+}
+
+func (r *prefixRange) value(i uint64) (string, bool) {
+	if i >= r.size {
+		return "", false
+	}
+	v := new(big.Int).SetBytes(r.ip)
+	v.Add(v, new(big.Int).Lsh(new(big.Int).SetUint64(i), uint(r.bits-r.length)))
+	ip := make(net.IP, len(r.ip))
+	v.FillBytes(ip)
+	return ip.String() + "/" + strconv.Itoa(r.length), true
+}
+
+func (r *prefixRange) overlaps(a, b string) bool {
+	_, na, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+	_, nb, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+	return na.Contains(nb.IP) || nb.Contains(na.IP)
+}
+
+func (r *prefixRange) intersects(o poolRange) bool {
+	or, ok := o.(*prefixRange)
+	return ok && (r.prefix.Contains(or.prefix.IP) || or.prefix.Contains(r.prefix.IP))
+}
+
+// isIPv4Prefix returns true when the prefix is an IPv4 prefix.
+func isIPv4Prefix(prefix string) bool {
+	ip, _, err := net.ParseCIDR(prefix)
+	return err == nil && ip.To4() != nil
+}
+
+// prefixAddress returns the i-th address of the prefix.
+func prefixAddress(prefix string, i uint64) (string, error) {
+	_, n, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", errors.Errorf(errFmtInvalidPool, prefix)
+	}
+	_, bits := n.Mask.Size()
+	r, err := newPrefixRange(prefix, bits)
+	if err != nil {
+		return "", err
+	}
+	v, ok := r.value(i)
+	if !ok {
+		return "", errors.Errorf(errFmtPoolExhausted, prefix)
+	}
+	return strings.Split(v, "/")[0], nil
+}
+
+// A poolReconciler validates a pool and releases the values allocated to owners
+// that no longer exist.
+type poolReconciler struct {
+	client  client.Client
+	log     logging.Logger
+	poll    time.Duration
+	newPool func() pool
+}
+
+// Reconcile a pool.
+func (r *poolReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	p := r.newPool()
+	if err := r.client.Get(ctx, req.NamespacedName, p); err != nil {
+		return reconcile.Result{}, errors.Wrap(IgnoreNotFound(err), errGetPool)
+	}
+	if meta.WasDeleted(p) {
+		return reconcile.Result{}, nil
+	}
+
+	pr, err := newPoolRange(p)
+	if err != nil {
+		log.Debug("Invalid pool", "error", err)
+		p.SetConditions(nddv1.Unavailable().WithMessage(err.Error()), nddv1.ReconcileError(err))
+		return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdatePoolStatus)
+	}
+
+	// a pool that overlaps with a pool created before it does not allocate values
+	kind := poolKind(p)
+	pools, err := listPools(ctx, r.client, kind)
+	if err != nil {
+		log.Debug("Cannot list pools", "error", err)
+		p.SetConditions(nddv1.ReconcileError(err))
+		return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdatePoolStatus)
+	}
+	available := nddv1.Available()
+	if o := precedingOverlap(pools, p, pr); o != nil {
+		err := errors.Errorf(errFmtPoolOverlaps, kind, p.GetName(), kind, o.GetName())
+		log.Debug("Overlapping pool", "error", err)
+		available = nddv1.Unavailable().WithMessage(err.Error())
+	}
+
+	keep := make([]srlv1.PoolAllocation, 0, len(p.GetAllocations()))
+	for _, a := range p.GetAllocations() {
+		exists, err := r.ownerExists(ctx, a.Owner)
+		if err != nil {
+			log.Debug("Cannot get owner", "owner", a.Owner, "error", err)
+			p.SetConditions(available, nddv1.ReconcileError(err))
+			return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdatePoolStatus)
+		}
+		if !exists {
+			log.Debug("Releasing allocation", "owner", a.Owner, "claim", a.Claim, "value", a.Value)
+			continue
+		}
+		keep = append(keep, a)
+	}
+	p.SetAllocations(keep)
+	p.SetConditions(available, nddv1.ReconcileSuccess())
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdatePoolStatus)
+}
+
+// ownerExists returns false when the owner <kind>/<name> of an allocation no
+// longer exists or is being deleted.
+func (r *poolReconciler) ownerExists(ctx context.Context, owner string) (bool, error) {
+	split := strings.SplitN(owner, "/", 2)
+	if len(split) != 2 {
+		return false, nil
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(srlv1.GroupVersion.WithKind(split[0]))
+	if err := r.client.Get(ctx, types.NamespacedName{Name: split[1]}, u); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, errGetPoolOwner)
+	}
+	return !meta.WasDeleted(u), nil
+}
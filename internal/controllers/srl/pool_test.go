@@ -0,0 +1,188 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+// atomicClient serializes the updates of the fake client, such that the
+// resource version check of an update is atomic as it is in the api server.
+type atomicClient struct {
+	client.Client
+	mu *sync.Mutex
+}
+
+func (c atomicClient) Status() client.StatusWriter {
+	return atomicStatusWriter{StatusWriter: c.Client.Status(), mu: c.mu}
+}
+
+type atomicStatusWriter struct {
+	client.StatusWriter
+	mu *sync.Mutex
+}
+
+func (w atomicStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := srlv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return atomicClient{Client: fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(), mu: &sync.Mutex{}}
+}
+
+func newTestIpPool(name, prefix string, length uint8, created time.Time) *srlv1.SrlIpPool {
+	return &srlv1.SrlIpPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+		Spec:       srlv1.IpPoolSpec{Prefix: prefix, AllocationLength: length},
+	}
+}
+
+func TestPoolRangeIntersects(t *testing.T) {
+	cases := map[string]struct {
+		a, b func() (poolRange, error)
+		want bool
+	}{
+		"NumbersOverlap": {
+			a:    func() (poolRange, error) { return newNumberRange("a", 1, 10) },
+			b:    func() (poolRange, error) { return newNumberRange("b", 10, 20) },
+			want: true,
+		},
+		"NumbersDisjoint": {
+			a:    func() (poolRange, error) { return newNumberRange("a", 1, 10) },
+			b:    func() (poolRange, error) { return newNumberRange("b", 11, 20) },
+			want: false,
+		},
+		"PrefixContained": {
+			a:    func() (poolRange, error) { return newPrefixRange("10.0.0.0/16", 31) },
+			b:    func() (poolRange, error) { return newPrefixRange("10.0.1.0/24", 32) },
+			want: true,
+		},
+		"PrefixesDisjoint": {
+			a:    func() (poolRange, error) { return newPrefixRange("10.0.0.0/24", 31) },
+			b:    func() (poolRange, error) { return newPrefixRange("10.0.1.0/24", 31) },
+			want: false,
+		},
+		"AddressFamilies": {
+			a:    func() (poolRange, error) { return newPrefixRange("0.0.0.0/0", 32) },
+			b:    func() (poolRange, error) { return newPrefixRange("::/0", 128) },
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, err := tc.a()
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := tc.b()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.intersects(b); got != tc.want {
+				t.Errorf("a.intersects(b): want %t, got %t", tc.want, got)
+			}
+			if got := b.intersects(a); got != tc.want {
+				t.Errorf("b.intersects(a): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestConcurrentClaims claims prefixes concurrently from two overlapping pools
+// that were both admitted, only the oldest pool allocates and no prefix is
+// allocated twice.
+func TestConcurrentClaims(t *testing.T) {
+	now := time.Now()
+	kube := newTestClient(t,
+		newTestIpPool("old", "10.0.0.0/24", 31, now.Add(-time.Minute)),
+		newTestIpPool("new", "10.0.0.0/16", 31, now),
+	)
+	ctx := context.Background()
+
+	const claimers = 4
+	type result struct {
+		pool  string
+		value string
+		err   error
+	}
+	results := make(chan result, 2*claimers)
+	var wg sync.WaitGroup
+	for i := 0; i < claimers; i++ {
+		for _, p := range []string{"old", "new"} {
+			wg.Add(1)
+			go func(owner, p string) {
+				defer wg.Done()
+				v, err := newPoolClaimer(kube, srlv1.InterfaceSubinterfaceKind, owner).claim(ctx, srlv1.IpPoolKind, p, "ipv4/primary")
+				results <- result{pool: p, value: v, err: err}
+			}(fmt.Sprintf("%s-%d", p, i), p)
+		}
+	}
+	wg.Wait()
+	close(results)
+
+	var values []string
+	for r := range results {
+		switch {
+		case r.pool == "new" && r.err == nil:
+			t.Errorf("claim from overlapping pool %s: want error, got %s", r.pool, r.value)
+		case r.pool == "old" && r.err != nil:
+			t.Errorf("claim from pool %s: %v", r.pool, r.err)
+		case r.pool == "old":
+			values = append(values, r.value)
+		}
+	}
+	r, _ := newPrefixRange("10.0.0.0/24", 31)
+	for i := range values {
+		for j := i + 1; j < len(values); j++ {
+			if r.overlaps(values[i], values[j]) {
+				t.Errorf("claims allocated overlapping prefixes %s and %s", values[i], values[j])
+			}
+		}
+	}
+
+	pools, err := listPools(ctx, kube, srlv1.IpPoolKind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range pools {
+		want := 0
+		if p.GetName() == "old" {
+			want = claimers
+		}
+		if got := len(p.GetAllocations()); got != want {
+			t.Errorf("allocations of pool %s: want %d, got %d", p.GetName(), want, got)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errFmtPoolRefNotSet = "either %s or %s must be set"
+)
+
+// resolvePoolRefs sets the values of the pool references of the managed resource
+// to the values claimed from the pools and removes the references, such that
+// the external client sends and compares the claimed values. The returned
+// function restores the references, the claimed values are not persisted in
+// the spec of the resource. The allocations of a resource that no longer
+// references a pool are released when the resource is deleted.
+func resolvePoolRefs(ctx context.Context, kube client.Client, mg resource.Managed) (func(), error) {
+	var resolvers []poolRefResolver
+	switch o := mg.(type) {
+	case *srlv1.SrlInterfaceSubinterface:
+		if si := o.Spec.ForNetworkNode.SrlInterfaceSubinterface; si != nil && si.Ipv4 != nil {
+			claims := ipv4AddressClaims(si.Ipv4.Address)
+			for i, a := range si.Ipv4.Address {
+				if a != nil {
+					resolvers = append(resolvers, ipv4AddressResolver(a, claims[i]))
+				}
+			}
+		}
+	case *srlv1.SrlNetworkinstance:
+		if ni := o.Spec.ForNetworkNode.SrlNetworkinstance; ni != nil {
+			resolvers = append(resolvers, routerIDResolver(ni))
+		}
+	case *srlv1.SrlNetworkinstanceProtocolsBgp:
+		if bgp := o.Spec.ForNetworkNode.SrlNetworkinstanceProtocolsBgp; bgp != nil {
+			resolvers = append(resolvers, autonomousSystemResolver(bgp))
+		}
+	}
+
+	pc := newPoolClaimer(kube, groupVersionKind(mg).Kind, mg.GetName())
+	restores := make([]func(), 0, len(resolvers))
+	restore := func() {
+		for _, r := range restores {
+			r()
+		}
+	}
+	for _, r := range resolvers {
+		rs, err := r(ctx, pc)
+		if err != nil {
+			restore()
+			return func() {}, err
+		}
+		if rs != nil {
+			restores = append(restores, rs)
+		}
+	}
+	return restore, nil
+}
+
+// A poolRefResolver resolves a pool reference of a managed resource, nil is
+// returned when the resource does not reference the pool.
+type poolRefResolver func(ctx context.Context, pc *poolClaimer) (func(), error)
+
+// ipv4AddressClaims returns the claims of the addresses that reference a pool,
+// such that an address keeps its prefix when the addresses are reordered. The
+// claim of the primary address is ipv4/primary, the claims of the other addresses
+// are ipv4/<pool>/<n> with n the position among the other addresses referencing
+// the same pool. Reordering those only swaps prefixes between addresses of the
+// same pool, the set of configured prefixes does not change.
+func ipv4AddressClaims(addresses []*srlv1.InterfaceSubinterfaceIpv4Address) []string {
+	claims := make([]string, len(addresses))
+	n := make(map[string]int)
+	for i, a := range addresses {
+		if a == nil || a.IpPrefixPoolRef == nil {
+			continue
+		}
+		if a.Primary != nil {
+			claims[i] = "ipv4/primary"
+			continue
+		}
+		pool := a.IpPrefixPoolRef.Name
+		claims[i] = "ipv4/" + pool + "/" + strconv.Itoa(n[pool])
+		n[pool]++
+	}
+	return claims
+}
+
+// ipv4AddressResolver claims the prefix of the address from the SrlIpPool, the
+// address is the first host address of the claimed prefix.
+func ipv4AddressResolver(a *srlv1.InterfaceSubinterfaceIpv4Address, claim string) poolRefResolver {
+	return func(ctx context.Context, pc *poolClaimer) (func(), error) {
+		ref := a.IpPrefixPoolRef
+		switch {
+		case ref == nil && a.IpPrefix == nil:
+			return nil, errors.Errorf(errFmtPoolRefNotSet, "ip-prefix", "ip-prefix-pool-ref")
+		case ref == nil || a.IpPrefix != nil:
+			return nil, nil
+		}
+		prefix, err := pc.claim(ctx, srlv1.IpPoolKind, ref.Name, claim)
+		if err != nil {
+			return nil, err
+		}
+		address, err := hostPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		a.IpPrefix, a.IpPrefixPoolRef = &address, nil
+		return func() { a.IpPrefix, a.IpPrefixPoolRef = nil, ref }, nil
+	}
+}
+
+// routerIDResolver claims the router-id of the network instance from the
+// SrlIpPool.
+func routerIDResolver(ni *srlv1.Networkinstance) poolRefResolver {
+	return func(ctx context.Context, pc *poolClaimer) (func(), error) {
+		ref := ni.RouterIdPoolRef
+		if ref == nil || ni.RouterId != nil {
+			return nil, nil
+		}
+		prefix, err := pc.claimPrefix(ctx, ref.Name, "router-id", 32)
+		if err != nil {
+			return nil, err
+		}
+		routerID, err := prefixAddress(prefix, 0)
+		if err != nil {
+			return nil, err
+		}
+		ni.RouterId, ni.RouterIdPoolRef = &routerID, nil
+		return func() { ni.RouterId, ni.RouterIdPoolRef = nil, ref }, nil
+	}
+}
+
+// autonomousSystemResolver claims the autonomous system of the bgp instance
+// from the SrlAsnPool.
+func autonomousSystemResolver(bgp *srlv1.NetworkinstanceProtocolsBgp) poolRefResolver {
+	return func(ctx context.Context, pc *poolClaimer) (func(), error) {
+		ref := bgp.AutonomousSystemPoolRef
+		switch {
+		case ref == nil && bgp.AutonomousSystem == nil:
+			return nil, errors.Errorf(errFmtPoolRefNotSet, "autonomous-system", "autonomous-system-pool-ref")
+		case ref == nil || bgp.AutonomousSystem != nil:
+			return nil, nil
+		}
+		as, err := pc.claimNumber(ctx, srlv1.AsnPoolKind, ref.Name, "as")
+		if err != nil {
+			return nil, err
+		}
+		bgp.AutonomousSystem, bgp.AutonomousSystemPoolRef = &as, nil
+		return func() { bgp.AutonomousSystem, bgp.AutonomousSystemPoolRef = nil, ref }, nil
+	}
+}
+
+// hostPrefix returns the first host address of the prefix with the length of
+// the prefix, the /31 and /32 prefixes have no network address.
+func hostPrefix(prefix string) (string, error) {
+	_, n, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", errors.Errorf(errFmtInvalidIpPrefix, prefix)
+	}
+	ones, _ := n.Mask.Size()
+	i := uint64(1)
+	if ones >= 31 {
+		i = 0
+	}
+	address, err := prefixAddress(prefix, i)
+	if err != nil {
+		return "", err
+	}
+	return address + "/" + strconv.Itoa(ones), nil
+}
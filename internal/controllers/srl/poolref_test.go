@@ -0,0 +1,69 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"reflect"
+	"testing"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+func TestIpv4AddressClaims(t *testing.T) {
+	primary := "[null]"
+	literal := "192.168.0.1/24"
+	address := func(pool string, isPrimary bool) *srlv1.InterfaceSubinterfaceIpv4Address {
+		a := &srlv1.InterfaceSubinterfaceIpv4Address{IpPrefixPoolRef: &srlv1.PoolReference{Name: pool}}
+		if isPrimary {
+			a.Primary = &primary
+		}
+		return a
+	}
+
+	cases := map[string]struct {
+		addresses []*srlv1.InterfaceSubinterfaceIpv4Address
+		want      []string
+	}{
+		"Primary": {
+			addresses: []*srlv1.InterfaceSubinterfaceIpv4Address{address("a", false), address("a", true)},
+			want:      []string{"ipv4/a/0", "ipv4/primary"},
+		},
+		"PrimaryReordered": {
+			addresses: []*srlv1.InterfaceSubinterfaceIpv4Address{address("a", true), address("a", false)},
+			want:      []string{"ipv4/primary", "ipv4/a/0"},
+		},
+		"Pools": {
+			addresses: []*srlv1.InterfaceSubinterfaceIpv4Address{address("a", false), address("b", false), address("a", false)},
+			want:      []string{"ipv4/a/0", "ipv4/b/0", "ipv4/a/1"},
+		},
+		"PoolsReordered": {
+			addresses: []*srlv1.InterfaceSubinterfaceIpv4Address{address("b", false), address("a", false), address("a", false)},
+			want:      []string{"ipv4/b/0", "ipv4/a/0", "ipv4/a/1"},
+		},
+		"Literal": {
+			addresses: []*srlv1.InterfaceSubinterfaceIpv4Address{{IpPrefix: &literal}, nil, address("a", false)},
+			want:      []string{"", "", "ipv4/a/0"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ipv4AddressClaims(tc.addresses); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ipv4AddressClaims(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	gnmitypes "github.com/karimra/gnmic/types"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/collector"
+)
+
+const (
+	// defaults of the connections to the device drivers
+	defaultServicePrefix = ndrv1.PrefixService
+	defaultServiceDomain = ndrv1.Namespace + ".svc.cluster.local"
+	defaultGNMITimeout   = 10 * time.Second
+	defaultUsername      = "admin"
+	defaultPassword      = "admin"
+)
+
+// providerConfigs holds the loaded SrlProviderConfigs, the controllers read the
+// settings of a network node from it on every reconcile such that changes are
+// applied without a restart.
+var providerConfigs = newProviderConfigStore()
+
+// A providerConfig is a SrlProviderConfig of which the secrets are loaded.
+type providerConfig struct {
+	spec     srlv1.ProviderConfigSpec
+	username string
+	password string
+	// files of the TLS secret and the directory they are written to
+	tlsCA   string
+	tlsCert string
+	tlsKey  string
+	tlsDir  string
+}
+
+type providerConfigStore struct {
+	mu      sync.RWMutex
+	configs map[string]*providerConfig
+}
+
+func newProviderConfigStore() *providerConfigStore {
+	return &providerConfigStore{configs: make(map[string]*providerConfig)}
+}
+
+func (s *providerConfigStore) set(name string, pc *providerConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.configs[name] = pc
+}
+
+func (s *providerConfigStore) delete(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.configs, name)
+}
+
+func (s *providerConfigStore) get(name string) *providerConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.configs[name]
+}
+
+// forNode returns the provider config that applies to the network node, which
+// is the config referenced by the provider config label of the network node or
+// the default config. nil is returned when the config is not loaded, in which
+// case the flags of the provider apply.
+func (s *providerConfigStore) forNode(nn *ndrv1.NetworkNode) *providerConfig {
+	if name := nn.GetLabels()[srlv1.LabelKeyProviderConfig]; name != "" {
+		if pc := s.get(name); pc != nil {
+			return pc
+		}
+	}
+	return s.get(srlv1.DefaultProviderConfigName)
+}
+
+// forNodeName returns the provider config that applies to the network node with
+// the name, the default config is returned when the network node is not found.
+func (s *providerConfigStore) forNodeName(ctx context.Context, kube client.Client, name string) *providerConfig {
+	nn := &ndrv1.NetworkNode{}
+	if name == "" {
+		return s.get(srlv1.DefaultProviderConfigName)
+	}
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, nn); err != nil {
+		return s.get(srlv1.DefaultProviderConfigName)
+	}
+	return s.forNode(nn)
+}
+
+// targetConfig returns the config of the gnmi target of the device driver of
+// the network node.
+func targetConfig(nn *ndrv1.NetworkNode) *gnmitypes.TargetConfig {
+	return providerConfigs.forNode(nn).targetConfig(nn)
+}
+
+func (pc *providerConfig) targetConfig(nn *ndrv1.NetworkNode) *gnmitypes.TargetConfig {
+	var dd srlv1.DeviceDriverConnection
+	if pc != nil {
+		dd = pc.spec.DeviceDriver
+	}
+	prefix, domain := defaultServicePrefix, defaultServiceDomain
+	if dd.ServicePrefix != nil {
+		prefix = *dd.ServicePrefix
+	}
+	if dd.ServiceDomain != nil {
+		domain = *dd.ServiceDomain
+	}
+	timeout := defaultGNMITimeout
+	if dd.Timeout != nil && dd.Timeout.Duration > 0 {
+		timeout = dd.Timeout.Duration
+	}
+	retryTimer := collector.DefaultRetryTimer
+	if dd.RetryTimer != nil && dd.RetryTimer.Duration > 0 {
+		retryTimer = dd.RetryTimer.Duration
+	}
+	bufferSize := uint(collector.DefaultBufferSize)
+	if dd.BufferSize != nil && *dd.BufferSize > 0 {
+		bufferSize = uint(*dd.BufferSize)
+	}
+	username, password := defaultUsername, defaultPassword
+	var tlsCA, tlsCert, tlsKey string
+	if pc != nil {
+		if pc.username != "" {
+			username, password = pc.username, pc.password
+		}
+		tlsCA, tlsCert, tlsKey = pc.tlsCA, pc.tlsCert, pc.tlsKey
+	}
+	return &gnmitypes.TargetConfig{
+		Name:       nn.GetName(),
+		Address:    prefix + "-" + nn.GetName() + "." + domain + ":" + strconv.Itoa(*nn.Spec.GrpcServerPort),
+		Username:   utils.StringPtr(username),
+		Password:   utils.StringPtr(password),
+		Timeout:    timeout,
+		RetryTimer: retryTimer,
+		BufferSize: bufferSize,
+		SkipVerify: utils.BoolPtr(boolPtrValue(dd.SkipVerify, true)),
+		Insecure:   utils.BoolPtr(boolPtrValue(dd.Insecure, true)),
+		TLSCA:      utils.StringPtr(tlsCA),
+		TLSCert:    utils.StringPtr(tlsCert),
+		TLSKey:     utils.StringPtr(tlsKey),
+		Gzip:       utils.BoolPtr(boolPtrValue(dd.Gzip, false)),
+	}
+}
+
+// driftPolicy returns the drift policy of the resources without a drift policy
+// annotation, the autopilot of the config corrects the drift.
+func (pc *providerConfig) driftPolicy() string {
+	if pc == nil || pc.spec.Autopilot == nil {
+		return defaultDriftPolicy
+	}
+	if *pc.spec.Autopilot {
+		return srlv1.DriftPolicyCorrect
+	}
+	return srlv1.DriftPolicyReport
+}
+
+// pollInterval returns the poll interval of the resources without a poll
+// interval annotation, 0 is returned when the config does not set it.
+func (pc *providerConfig) pollInterval() time.Duration {
+	if pc == nil || pc.spec.PollInterval == nil || pc.spec.PollInterval.Duration <= 0 {
+		return 0
+	}
+	return pc.spec.PollInterval.Duration
+}
+
+func boolPtrValue(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
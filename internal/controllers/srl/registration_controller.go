@@ -19,12 +19,16 @@ package srl
 import (
 	"context"
 	"encoding/json"
-	"strconv"
+	"sort"
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/yndd/ndd-provider-srl/internal/collector"
@@ -37,10 +41,12 @@ import (
 	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
 	"github.com/yndd/ndd-runtime/pkg/event"
 	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
 )
@@ -53,13 +59,14 @@ const (
 	errUnexpectedRegistration       = "the managed resource is not a Registration resource"
 	errKubeUpdateRegistrationFailed = "cannot update Registration"
 	errRegistrationGet              = "cannot get Registration"
+	errListRegistration             = "cannot list Registrations"
 	errRegistrationCreate           = "cannot create Registration"
 	errRegistrationUpdate           = "cannot update Registration"
 	errRegistrationDelete           = "cannot delete Registration"
 )
 
 // SetupRegistration adds a controller that reconciles Registrations.
-func SetupRegistration(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, subChan chan collector.TargetUpdate) error {
+func SetupRegistration(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, subChan chan collector.TargetUpdate, status *collector.SubscriptionStatus) error {
 
 	name := managed.ControllerName(srlv1.RegistrationGroupKind)
 
@@ -68,25 +75,66 @@ func SetupRegistration(mgr ctrl.Manager, o controller.Options, l logging.Logger,
 		managed.WithExternalConnecter(&connectorRegistration{
 			log:     l,
 			subChan: subChan,
+			status:  status,
 			kube:    mgr.GetClient(),
 			usage:   resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
 			//newClientFn: regclient.NewClient},
 			newClientFn: target.NewTarget},
 		),
 		managed.WithValidator(&validatorRegistration{log: l}),
+		managed.WithPollInterval(poll),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
-		For(&srlv1.Registration{}).
-		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
-		//Watches(
-		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
-		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
-		//).
-		Complete(r)
+		For(&srlv1.Registration{}, builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate())).
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(networkNodeMapFunc(mgr.GetClient(), l, func() resource.ManagedList { return &srlv1.RegistrationList{} }, registersNetworkNode)),
+			builder.WithPredicates(networkNodeChangedPredicate()),
+		).
+		Watches(
+			&source.Kind{Type: &srlv1.Registration{}},
+			handler.EnqueueRequestsFromMapFunc(registrationMapFunc(mgr.GetClient(), l)),
+			builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate()),
+		).
+		Watches(
+			&source.Kind{Type: &srlv1.SrlProviderConfig{}},
+			handler.EnqueueRequestsFromMapFunc(providerConfigMapFunc(mgr.GetClient(), l)),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.RegistrationList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(&registrationReconciler{Reconciler: r, kube: mgr.GetClient(), subChan: subChan})
+}
+
+// A registrationReconciler stops the subscriptions of a registration that got
+// deleted. With sharding every replica subscribes to the network nodes of its
+// shard and writes the subscription states of those network nodes only. The
+// finalizer of a deleted registration is kept until the replicas deregistered
+// it from the network nodes of their shards, such that every replica stops its
+// subscriptions once the registration is gone.
+type registrationReconciler struct {
+	reconcile.Reconciler
+	kube    client.Client
+	subChan chan collector.TargetUpdate
+}
+
+func (r *registrationReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	if err := r.kube.Get(ctx, req.NamespacedName, &srlv1.Registration{}); err != nil {
+		if IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, errors.Wrap(err, errRegistrationGet)
+		}
+		select {
+		case r.subChan <- collector.TargetUpdate{Action: collector.TargetDeleteOwner, Owner: req.Name}:
+		case <-ctx.Done():
+		}
+		return reconcile.Result{}, nil
+	}
+	return r.Reconciler.Reconcile(ctx, req)
 }
 
 type validatorRegistration struct {
@@ -122,6 +170,7 @@ func (v *validatorRegistration) ValidateResourceIndexes(ctx context.Context, mg
 type connectorRegistration struct {
 	log         logging.Logger
 	subChan     chan collector.TargetUpdate
+	status      *collector.SubscriptionStatus
 	kube        client.Client
 	usage       resource.Tracker
 	newClientFn func(c *types.TargetConfig) *target.Target
@@ -143,32 +192,40 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 		return nil, errors.Wrap(err, errTrackTCUsage)
 	}
 
+	if _, err := registrationSelector(o); err != nil {
+		return nil, errors.Wrap(err, errNetworkNodeSelector)
+	}
+
 	selectors := []client.ListOption{}
 	nnl := &ndrv1.NetworkNodeList{}
 	if err := c.kube.List(ctx, nnl, selectors...); err != nil {
 		return nil, errors.Wrap(err, errGetNetworkNode)
 	}
+	rl := &srlv1.RegistrationList{}
+	if err := c.kube.List(ctx, rl); err != nil {
+		return nil, errors.Wrap(err, errListRegistration)
+	}
+	regs := registrations(o, rl.Items)
 
-	// find all targets that have are in configured status
+	// find all targets that have are in configured status and that are
+	// registered with this registration, the targets of the network nodes in
+	// the shards of other replicas are subscribed by those replicas
 	var ts []*nddv1.Target
+	others := make([]string, 0)
 	for _, nn := range nnl.Items {
 		log.Debug("Network Node", "Name", nn.GetName(), "Status", nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status)
+		if owner := registrationOwner(&nn, regs); owner != o.GetName() {
+			log.Debug("Network Node registered with another registration", "Name", nn.GetName(), "Registration", owner)
+			continue
+		}
 		if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status == corev1.ConditionTrue {
+			if !shards.Owns(nn.GetName()) {
+				others = append(others, nn.GetName())
+				continue
+			}
 			t := &nddv1.Target{
-				Name: nn.GetName(),
-				Config: &types.TargetConfig{
-					Name:       nn.GetName(),
-					Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-					Username:   utils.StringPtr("admin"),
-					Password:   utils.StringPtr("admin"),
-					Timeout:    10 * time.Second,
-					SkipVerify: utils.BoolPtr(true),
-					Insecure:   utils.BoolPtr(true),
-					TLSCA:      utils.StringPtr(""), //TODO TLS
-					TLSCert:    utils.StringPtr(""), //TODO TLS
-					TLSKey:     utils.StringPtr(""),
-					Gzip:       utils.BoolPtr(false),
-				},
+				Name:   nn.GetName(),
+				Config: targetConfig(&nn),
 			}
 			ts = append(ts, t)
 		}
@@ -190,6 +247,7 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 			deletedTargets = append(deletedTargets, collector.TargetUpdate{
 				Name:   origTarget,
 				Action: collector.TargetDelete,
+				Owner:  o.GetName(),
 			})
 		}
 	}
@@ -197,36 +255,38 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 	allTargets := make([]collector.TargetUpdate, 0)
 	for _, allTarget := range ts {
 		allTargets = append(allTargets, collector.TargetUpdate{
-			Name:   allTarget.Name,
-			Action: collector.TargetAdd,
-			TargetConfig: &types.TargetConfig{
-				Name:       allTarget.Name,
-				Address:    allTarget.Config.Address,
-				Username:   utils.StringPtr("admin"),
-				Password:   utils.StringPtr("admin"),
-				Timeout:    10 * time.Second,
-				SkipVerify: utils.BoolPtr(true),
-				Insecure:   utils.BoolPtr(true),
-				TLSCA:      utils.StringPtr(""), //TODO TLS
-				TLSCert:    utils.StringPtr(""), //TODO TLS
-				TLSKey:     utils.StringPtr(""),
-				Gzip:       utils.BoolPtr(false),
-			},
+			Name:                allTarget.Name,
+			Action:              collector.TargetAdd,
+			Owner:               o.GetName(),
+			SubscriptionOptions: subscriptionOptions(o.Spec.ForNetworkNode.Subscription),
+			TargetConfig:        allTarget.Config,
 		})
 	}
 
+	// the target updates are not sent when the manager stops, since the deviation
+	// server no longer receives them
 	for _, sub := range deletedTargets {
 		log.Debug("Stop Subscription", "target", sub.Name)
-		c.subChan <- sub
+		select {
+		case c.subChan <- sub:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 	}
 	for _, sub := range allTargets {
 		log.Debug("Start Subscription", "target", sub.Name)
-		c.subChan <- sub
+		select {
+		case c.subChan <- sub:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 	}
 
+	o.Status.Subscriptions = c.subscriptions(ts, others, o.Status.Subscriptions)
+
 	// when no targets are found we return a not found error
 	// this unifies the reconcile code when a dedicate network node is looked up
-	if len(ts) == 0 {
+	if len(ts) == 0 && len(others) == 0 {
 		return nil, errors.New(errNoTargetFound)
 	}
 
@@ -235,7 +295,7 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 	tns := make([]string, 0)
 	for _, t := range ts {
 		cl := target.NewTarget(t.Config)
-		if err := cl.CreateGNMIClient(ctx); err != nil {
+		if err := cl.CreateGNMIClient(ctx, gnmiDialOptions(cl.Config.Name)...); err != nil {
 			return nil, errors.Wrap(err, errNewClient)
 		}
 		cls = append(cls, cl)
@@ -266,7 +326,169 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 
 	log.Debug("Connect info", "clients", cls, "targets", tns)
 
-	return &externalRegistration{clients: cls, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, nil
+	return &externalRegistration{clients: cls, targets: tns, others: others, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, nil
+}
+
+// subscriptionOptions returns the subscription options of the registration, the
+// options that are not set are filled in by the deviation server.
+func subscriptionOptions(o *srlv1.RegistrationSubscriptionOptions) collector.SubscriptionOptions {
+	opts := collector.SubscriptionOptions{}
+	if o == nil {
+		return opts
+	}
+	if o.Mode != nil {
+		opts.Mode = *o.Mode
+	}
+	if o.SampleInterval != nil {
+		opts.SampleInterval = o.SampleInterval.Duration
+	}
+	if o.HeartbeatInterval != nil {
+		opts.HeartbeatInterval = o.HeartbeatInterval.Duration
+	}
+	if o.Encoding != nil {
+		opts.Encoding = *o.Encoding
+	}
+	opts.SuppressRedundant = o.SuppressRedundant
+	return opts
+}
+
+// subscriptions returns the state of the subscriptions to the targets, the
+// observed generations and deregistrations of the previous state are kept. The
+// state of the targets of other shards is only written by the replicas of those
+// shards and is kept.
+func (c *connectorRegistration) subscriptions(ts []*nddv1.Target, others []string, previous []srlv1.RegistrationSubscription) []srlv1.RegistrationSubscription {
+	prev := make(map[string]srlv1.RegistrationSubscription)
+	for _, sub := range previous {
+		prev[sub.NetworkNode] = sub
+	}
+	subs := make([]srlv1.RegistrationSubscription, 0, len(ts)+len(others))
+	for _, t := range ts {
+		sub := srlv1.RegistrationSubscription{
+			NetworkNode:        t.Name,
+			ObservedGeneration: prev[t.Name].ObservedGeneration,
+			Deregistered:       prev[t.Name].Deregistered,
+		}
+		if st, ok := c.status.Get(t.Name); ok {
+			sub.Connected = st.Connected
+			sub.ReconnectAttempts = st.ReconnectAttempts
+			sub.LastError = st.LastError
+			sub.LastTransitionTime = metav1.NewTime(st.LastTransitionTime)
+		}
+		subs = append(subs, sub)
+	}
+	for _, name := range others {
+		sub, ok := prev[name]
+		if !ok {
+			sub = srlv1.RegistrationSubscription{NetworkNode: name}
+		}
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].NetworkNode < subs[j].NetworkNode })
+	return subs
+}
+
+// registrationSelector returns the selector of the network nodes of the
+// registration, all network nodes are selected when it has no selector.
+func registrationSelector(o *srlv1.Registration) (labels.Selector, error) {
+	if o.GetNetworkNodeSelector() == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(o.GetNetworkNodeSelector())
+}
+
+// registrations returns the registrations sorted by precedence, the listed
+// version of the reconciled registration is replaced by the reconciled one.
+func registrations(o *srlv1.Registration, items []srlv1.Registration) []*srlv1.Registration {
+	regs := []*srlv1.Registration{o}
+	for i := range items {
+		if items[i].GetName() != o.GetName() {
+			regs = append(regs, &items[i])
+		}
+	}
+	sort.SliceStable(regs, func(i, j int) bool { return registrationPrecedes(regs[i], regs[j]) })
+	return regs
+}
+
+// registrationPrecedes returns true when registration a takes precedence over
+// registration b: the highest priority, then the registration with a selector
+// and then the lowest name.
+func registrationPrecedes(a, b *srlv1.Registration) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	if (a.GetNetworkNodeSelector() != nil) != (b.GetNetworkNodeSelector() != nil) {
+		return a.GetNetworkNodeSelector() != nil
+	}
+	return a.GetName() < b.GetName()
+}
+
+// registrationOwner returns the name of the registration the network node is
+// registered with, which is the first registration by precedence that selects
+// it. A network node that is not selected by any registration stays with the
+// deleted registration it was registered with, such that the registration is
+// removed from its device driver.
+func registrationOwner(nn *ndrv1.NetworkNode, regs []*srlv1.Registration) string {
+	for _, r := range regs {
+		if meta.WasDeleted(r) {
+			continue
+		}
+		selector, err := registrationSelector(r)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(nn.GetLabels())) {
+			return r.GetName()
+		}
+	}
+	for _, r := range regs {
+		if !meta.WasDeleted(r) {
+			continue
+		}
+		for _, t := range r.GetTarget() {
+			if t == nn.GetName() {
+				return r.GetName()
+			}
+		}
+	}
+	return ""
+}
+
+// registrationMapFunc enqueues the other registrations when a registration
+// changes, since the network nodes a registration is registered with depend on
+// the selectors and priorities of all registrations.
+func registrationMapFunc(kube client.Client, l logging.Logger) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		rl := &srlv1.RegistrationList{}
+		if err := kube.List(context.TODO(), rl); err != nil {
+			l.Debug(errListRegistration, "error", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(rl.Items))
+		for _, r := range rl.Items {
+			if r.GetName() != o.GetName() {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKey{Name: r.GetName()}})
+			}
+		}
+		return reqs
+	}
+}
+
+// providerConfigMapFunc enqueues all registrations when a SrlProviderConfig
+// changes, such that the subscriptions reconnect with the new connection
+// settings of the device drivers.
+func providerConfigMapFunc(kube client.Client, l logging.Logger) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		rl := &srlv1.RegistrationList{}
+		if err := kube.List(context.TODO(), rl); err != nil {
+			l.Debug(errListRegistration, "error", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(rl.Items))
+		for _, r := range rl.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKey{Name: r.GetName()}})
+		}
+		return reqs
+	}
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -283,6 +505,8 @@ type externalRegistration struct {
 	targets []string
 	parser  parser.Parser
 	log     logging.Logger
+	// others are the targets of the registration in the shards of other replicas
+	others []string
 }
 
 func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -293,6 +517,20 @@ func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed)
 	log := e.log.WithValues("Resource", o.GetName())
 	log.Debug("Observing ...")
 
+	// a deleted registration exists until it is deregistered from the network
+	// nodes of all shards, the replicas delete it from the network nodes of
+	// their shard
+	if meta.WasDeleted(o) {
+		exists := !e.deregistered(o, e.GetTarget())
+		log.Debug("Observing deleted registration", "exists", exists)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   exists,
+			ResourceUpToDate: true,
+			ResourceHasData:  exists,
+		}, nil
+	}
+
 	path := []*gnmi.Path{
 		{
 			Elem: []*gnmi.PathElem{
@@ -300,7 +538,7 @@ func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed)
 			},
 		},
 	}
-	//d, err := json.Marshal(o.Spec.ForNetworkNode)
+	//d, err := json.Marshal(o.Spec.ForNetworkNode.Register)
 	//if err != nil {
 	//	return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
 	//}
@@ -356,6 +594,19 @@ func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed)
 		}
 	*/
 
+	// the registration is updated when the current generation is not registered
+	// with all network nodes, e.g. after a change of the subscriptions or the
+	// exception paths or when a network node got selected
+	if !e.registered(o) {
+		log.Debug("Observing registration not up to date", "generation", o.GetGeneration())
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceUpToDate: false,
+			ResourceHasData:  true,
+		}, nil
+	}
+
 	// when all network device driver reports the proper device type
 	// we return exists and up to date
 	return managed.ExternalObservation{
@@ -368,6 +619,7 @@ func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed)
 }
 
 func (e *externalRegistration) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx = withAuditResource(ctx, mg)
 	o, ok := mg.(*srlv1.Registration)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedRegistration)
@@ -380,7 +632,7 @@ func (e *externalRegistration) Create(ctx context.Context, mg resource.Managed)
 			{Name: nddv1.RegisterPathElemName, Key: map[string]string{nddv1.RegisterPathElemKey: string(srlv1.DeviceType)}},
 		},
 	}
-	d, err := json.Marshal(o.Spec.ForNetworkNode)
+	d, err := json.Marshal(o.Spec.ForNetworkNode.Register)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errJSONMarshal)
 	}
@@ -398,6 +650,7 @@ func (e *externalRegistration) Create(ctx context.Context, mg resource.Managed)
 			return managed.ExternalCreation{}, errors.New(errRegistrationCreate)
 		}
 	}
+	e.setRegistered(o)
 
 	/*
 		for _, cl := range e.clients {
@@ -418,6 +671,7 @@ func (e *externalRegistration) Create(ctx context.Context, mg resource.Managed)
 }
 
 func (e *externalRegistration) Update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	ctx = withAuditResource(ctx, mg)
 	o, ok := mg.(*srlv1.Registration)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedRegistration)
@@ -430,12 +684,14 @@ func (e *externalRegistration) Update(ctx context.Context, mg resource.Managed,
 			{Name: nddv1.RegisterPathElemName, Key: map[string]string{nddv1.RegisterPathElemKey: string(srlv1.DeviceType)}},
 		},
 	}
-	d, err := json.Marshal(o.Spec.ForNetworkNode)
+	d, err := json.Marshal(o.Spec.ForNetworkNode.Register)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errJSONMarshal)
 	}
+	// the registration is replaced such that the subscriptions and exception
+	// paths that are removed from the lists are removed on the device driver
 	req := &gnmi.SetRequest{
-		Update: []*gnmi.Update{
+		Replace: []*gnmi.Update{
 			{
 				Path: path,
 				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: d}},
@@ -448,6 +704,7 @@ func (e *externalRegistration) Update(ctx context.Context, mg resource.Managed,
 			return managed.ExternalUpdate{}, errors.New(errRegistrationUpdate)
 		}
 	}
+	e.setRegistered(o)
 
 	/*
 		for _, cl := range e.clients {
@@ -467,6 +724,7 @@ func (e *externalRegistration) Update(ctx context.Context, mg resource.Managed,
 }
 
 func (e *externalRegistration) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx = withAuditResource(ctx, mg)
 	o, ok := mg.(*srlv1.Registration)
 	if !ok {
 		return errors.New(errUnexpectedRegistration)
@@ -484,12 +742,18 @@ func (e *externalRegistration) Delete(ctx context.Context, mg resource.Managed)
 	req := &gnmi.SetRequest{
 		Delete: paths,
 	}
-	for _, cl := range e.clients {
+	for i, cl := range e.clients {
+		if e.deregistered(o, e.targets[i:i+1]) {
+			continue
+		}
 		_, err := cl.Set(ctx, req)
 		if err != nil {
 			return errors.New(errRegistrationDelete)
 		}
 	}
+	if meta.WasDeleted(o) {
+		e.setDeregistered(o)
+	}
 
 	/*
 		for _, cl := range e.clients {
@@ -504,8 +768,70 @@ func (e *externalRegistration) Delete(ctx context.Context, mg resource.Managed)
 	return nil
 }
 
+// registered returns true when the current generation of the registration is
+// registered with all network nodes.
+func (e *externalRegistration) registered(o *srlv1.Registration) bool {
+	generations := make(map[string]int64)
+	for _, sub := range o.Status.Subscriptions {
+		generations[sub.NetworkNode] = sub.ObservedGeneration
+	}
+	for _, t := range e.targets {
+		if generations[t] != o.GetGeneration() {
+			return false
+		}
+	}
+	return true
+}
+
+// setRegistered records the current generation of the registration is
+// registered with the network nodes of the shard.
+func (e *externalRegistration) setRegistered(o *srlv1.Registration) {
+	registered := make(map[string]bool)
+	for _, t := range e.targets {
+		registered[t] = true
+	}
+	for i := range o.Status.Subscriptions {
+		if registered[o.Status.Subscriptions[i].NetworkNode] {
+			o.Status.Subscriptions[i].ObservedGeneration = o.GetGeneration()
+		}
+	}
+}
+
+// deregistered returns true when the deleted registration is deregistered from
+// the network nodes.
+func (e *externalRegistration) deregistered(o *srlv1.Registration, nodes []string) bool {
+	deregistered := make(map[string]bool)
+	for _, sub := range o.Status.Subscriptions {
+		deregistered[sub.NetworkNode] = sub.Deregistered
+	}
+	for _, n := range nodes {
+		if !deregistered[n] {
+			return false
+		}
+	}
+	return true
+}
+
+// setDeregistered records the deleted registration is deregistered from the
+// network nodes of the shard.
+func (e *externalRegistration) setDeregistered(o *srlv1.Registration) {
+	deregistered := make(map[string]bool)
+	for _, t := range e.targets {
+		deregistered[t] = true
+	}
+	for i := range o.Status.Subscriptions {
+		if deregistered[o.Status.Subscriptions[i].NetworkNode] {
+			o.Status.Subscriptions[i].Deregistered = true
+		}
+	}
+}
+
+// GetTarget returns the targets of the registration of all shards, such that
+// the replicas report the same targets.
 func (e *externalRegistration) GetTarget() []string {
-	return e.targets
+	ts := append(append([]string{}, e.targets...), e.others...)
+	sort.Strings(ts)
+	return ts
 }
 
 func (e *externalRegistration) GetConfig(ctx context.Context) ([]byte, error) {
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+// TestObserveDeletedRegistration checks that a deleted registration exists
+// until it is deregistered from the network nodes of all shards.
+func TestObserveDeletedRegistration(t *testing.T) {
+	cases := map[string]struct {
+		deregistered []string
+		want         bool
+	}{
+		"None": {
+			want: true,
+		},
+		"OwnShard": {
+			deregistered: []string{"leaf1"},
+			want:         true,
+		},
+		"OtherShard": {
+			deregistered: []string{"leaf2"},
+			want:         true,
+		},
+		"AllShards": {
+			deregistered: []string{"leaf1", "leaf2"},
+			want:         false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := metav1.Now()
+			o := &srlv1.Registration{ObjectMeta: metav1.ObjectMeta{Name: "reg", DeletionTimestamp: &now}}
+			deregistered := make(map[string]bool)
+			for _, n := range tc.deregistered {
+				deregistered[n] = true
+			}
+			for _, n := range []string{"leaf1", "leaf2"} {
+				o.Status.Subscriptions = append(o.Status.Subscriptions, srlv1.RegistrationSubscription{NetworkNode: n, Deregistered: deregistered[n]})
+			}
+			// leaf2 is in the shard of another replica
+			e := &externalRegistration{targets: []string{"leaf1"}, others: []string{"leaf2"}, log: logging.NewNopLogger()}
+			obs, err := e.Observe(context.Background(), o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if obs.ResourceExists != tc.want {
+				t.Errorf("Observe(...): want exists %t, got %t", tc.want, obs.ResourceExists)
+			}
+		})
+	}
+}
+
+func TestSetDeregistered(t *testing.T) {
+	o := &srlv1.Registration{Status: srlv1.RegistrationStatus{Subscriptions: []srlv1.RegistrationSubscription{
+		{NetworkNode: "leaf1"},
+		{NetworkNode: "leaf2"},
+	}}}
+	e := &externalRegistration{targets: []string{"leaf1"}, others: []string{"leaf2"}}
+	e.setDeregistered(o)
+	if !e.deregistered(o, []string{"leaf1"}) {
+		t.Errorf("leaf1 of the shard: want deregistered")
+	}
+	if e.deregistered(o, []string{"leaf2"}) {
+		t.Errorf("leaf2 of another shard: want not deregistered")
+	}
+}
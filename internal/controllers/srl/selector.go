@@ -0,0 +1,337 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errNetworkNodeSelector = "cannot parse network node selector"
+	errObserveAllNodes     = "cannot observe the resource on any of the selected network nodes"
+	errConnectAllNodes     = "cannot connect to any of the selected network nodes"
+)
+
+// A networkNodeSelectable is a managed resource that can be fanned out to
+// multiple network nodes using a network node selector.
+type networkNodeSelectable interface {
+	resource.Managed
+	GetNetworkNodeSelector() *metav1.LabelSelector
+	GetNetworkNodeSyncStatus() []srlv1.NetworkNodeSyncStatus
+	SetNetworkNodeSyncStatus(s []srlv1.NetworkNodeSyncStatus)
+}
+
+// A networkNodeConnectFn produces an ExternalClient for a single network node.
+type networkNodeConnectFn func(ctx context.Context, log logging.Logger, nn *ndrv1.NetworkNode) (managed.ExternalClient, error)
+
+// connectNetworkNodeSelector produces an ExternalClient that fans the managed
+// resource out to all configured network nodes matching its network node selector.
+// Network nodes that were synced before, but are no longer selected, are connected
+// as well such that the resource can be removed from them.
+func connectNetworkNodeSelector(ctx context.Context, kube client.Client, log logging.Logger, mg networkNodeSelectable, connect networkNodeConnectFn) (managed.ExternalClient, error) {
+	selector, err := metav1.LabelSelectorAsSelector(mg.GetNetworkNodeSelector())
+	if err != nil {
+		return nil, errors.Wrap(err, errNetworkNodeSelector)
+	}
+
+	nnl := &ndrv1.NetworkNodeList{}
+	if err := kube.List(ctx, nnl); err != nil {
+		return nil, errors.Wrap(err, errGetNetworkNode)
+	}
+
+	previous := make(map[string]bool)
+	for _, s := range mg.GetNetworkNodeSyncStatus() {
+		previous[s.Name] = true
+	}
+
+	e := &fanoutExternal{
+		clients:      make(map[string]managed.ExternalClient),
+		observations: make(map[string]managed.ExternalObservation),
+		unreachable:  make(map[string]error),
+		log:          log,
+	}
+	for _, nn := range nnl.Items {
+		nn := nn
+		selected := selector.Matches(labels.Set(nn.GetLabels()))
+		if !selected && !previous[nn.GetName()] {
+			continue
+		}
+		// network nodes that are not configured are skipped, they get picked up
+		// once their device driver is configured
+		if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
+			log.Debug("network node not configured", "networknode", nn.GetName())
+			continue
+		}
+		ec, err := connect(ctx, log.WithValues("networknode", nn.GetName()), &nn)
+		if err != nil {
+			// a network node that cannot be connected is reported in the status
+			// of the resource, the other network nodes are converged on their own
+			log.Debug("cannot connect", "networknode", nn.GetName(), "error", err)
+			e.unreachable[nn.GetName()] = err
+			continue
+		}
+		e.clients[nn.GetName()] = ec
+		if selected {
+			e.targets = append(e.targets, nn.GetName())
+		} else {
+			e.stale = append(e.stale, nn.GetName())
+		}
+	}
+
+	// when no targets are found we return a not found error
+	// this unifies the reconcile code when a dedicate network node is looked up
+	if len(e.targets) == 0 && len(e.stale) == 0 {
+		if len(e.unreachable) != 0 {
+			return nil, errors.New(errConnectAllNodes)
+		}
+		return nil, errors.New(errNoTargetFound)
+	}
+	sort.Strings(e.targets)
+	sort.Strings(e.stale)
+
+	return e, nil
+}
+
+// A fanoutExternal observes, then either creates, updates, or deletes the
+// external resource on every selected network node. Each network node is
+// converged based on its own observation, the reconciler only gets to see
+// the aggregated observation.
+type fanoutExternal struct {
+	clients map[string]managed.ExternalClient
+	// targets are the network nodes selected by the network node selector
+	targets []string
+	// stale are the network nodes that are no longer selected
+	stale []string
+	// unreachable are the network nodes that cannot be connected, with the
+	// error of the connect
+	unreachable  map[string]error
+	observations map[string]managed.ExternalObservation
+	converged    bool
+	log          logging.Logger
+}
+
+// Observe observes the resource on every network node, records the result per
+// network node in the status of the resource and returns the aggregated observation.
+func (e *fanoutExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, ok := mg.(networkNodeSelectable)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNetworkNodeSelector)
+	}
+
+	// a deleted resource needs to be removed from every network node that has it,
+	// for all other operations the resource needs to be present everywhere
+	deleting := meta.WasDeleted(mg)
+	agg := managed.ExternalObservation{
+		Ready:            true,
+		ResourceExists:   !deleting,
+		ResourceHasData:  !deleting,
+		ResourceUpToDate: true,
+	}
+
+	status := make([]srlv1.NetworkNodeSyncStatus, 0, len(e.targets))
+	failed := 0
+	for _, nodeName := range e.targets {
+		obs, err := e.clients[nodeName].Observe(ctx, mg)
+		if err != nil {
+			e.log.Debug("cannot observe", "networknode", nodeName, "error", err)
+			status = append(status, srlv1.NetworkNodeSyncStatus{Name: nodeName, Synced: false, Message: err.Error()})
+			agg.ResourceUpToDate = false
+			failed++
+			continue
+		}
+		e.observations[nodeName] = obs
+		status = append(status, srlv1.NetworkNodeSyncStatus{
+			Name:   nodeName,
+			Synced: obs.Ready && obs.ResourceExists && obs.ResourceHasData && obs.ResourceUpToDate,
+		})
+
+		agg.Ready = agg.Ready && obs.Ready
+		agg.ResourceUpToDate = agg.ResourceUpToDate && obs.ResourceUpToDate
+		if deleting {
+			agg.ResourceExists = agg.ResourceExists || obs.ResourceExists
+			agg.ResourceHasData = agg.ResourceHasData || obs.ResourceHasData
+		} else {
+			agg.ResourceExists = agg.ResourceExists && obs.ResourceExists
+			agg.ResourceHasData = agg.ResourceHasData && obs.ResourceHasData
+		}
+	}
+	if len(e.targets) != 0 && failed == len(e.targets) {
+		return managed.ExternalObservation{}, errors.New(errObserveAllNodes)
+	}
+
+	// network nodes that cannot be connected are not in sync, the resource is
+	// converged on them once they can be connected again and a deleted resource
+	// is kept until it is removed from them
+	unreachable := make([]string, 0, len(e.unreachable))
+	for nodeName := range e.unreachable {
+		unreachable = append(unreachable, nodeName)
+	}
+	sort.Strings(unreachable)
+	for _, nodeName := range unreachable {
+		status = append(status, srlv1.NetworkNodeSyncStatus{Name: nodeName, Synced: false, Message: e.unreachable[nodeName].Error()})
+		agg.ResourceUpToDate = false
+		agg.ResourceExists = agg.ResourceExists || deleting
+	}
+
+	// network nodes that are no longer selected keep their status until the
+	// resource is removed from them
+	for _, nodeName := range e.stale {
+		obs, err := e.clients[nodeName].Observe(ctx, mg)
+		if err != nil {
+			status = append(status, srlv1.NetworkNodeSyncStatus{Name: nodeName, Synced: false, Message: err.Error()})
+			continue
+		}
+		if obs.ResourceExists || obs.ResourceHasData {
+			e.observations[nodeName] = obs
+			status = append(status, srlv1.NetworkNodeSyncStatus{Name: nodeName, Synced: false, Message: "network node no longer selected"})
+			agg.ResourceUpToDate = false
+			agg.ResourceExists = agg.ResourceExists || deleting
+		}
+	}
+
+	o.SetNetworkNodeSyncStatus(status)
+	return agg, nil
+}
+
+// Create converges the resource on every network node.
+func (e *fanoutExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, e.converge(ctx, mg)
+}
+
+// Update converges the resource on every network node. Deletes that are not
+// originating from the aggregated observation, e.g. the deletes resulting from
+// changed resource indexes, apply to all selected network nodes.
+func (e *fanoutExternal) Update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	if len(obs.ResourceDeletes) != 0 {
+		deletes := managed.ExternalObservation{
+			ResourceDeletes: obs.ResourceDeletes,
+			ResourceUpdates: make([]*gnmi.Update, 0),
+		}
+		for _, nodeName := range e.targets {
+			if _, err := e.clients[nodeName].Update(ctx, mg, deletes); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, "networknode %s", nodeName)
+			}
+		}
+	}
+	return managed.ExternalUpdate{}, e.converge(ctx, mg)
+}
+
+// Delete removes the resource from every network node that has it.
+func (e *fanoutExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	for _, nodeName := range e.GetTarget() {
+		obs, ok := e.observations[nodeName]
+		if !ok || !(obs.ResourceExists || obs.ResourceHasData) {
+			continue
+		}
+		if err := e.clients[nodeName].Delete(ctx, mg); err != nil {
+			return errors.Wrapf(err, "networknode %s", nodeName)
+		}
+	}
+	return nil
+}
+
+// converge creates or updates the resource on every selected network node based on
+// its own observation and removes it from the network nodes that are no longer
+// selected. The reconciler can call Update and Create within the same reconcile,
+// converge only acts once per reconcile.
+func (e *fanoutExternal) converge(ctx context.Context, mg resource.Managed) error {
+	if e.converged {
+		return nil
+	}
+	e.converged = true
+
+	for _, nodeName := range e.targets {
+		obs, ok := e.observations[nodeName]
+		if !ok || !obs.Ready {
+			continue
+		}
+		cl := e.clients[nodeName]
+		switch {
+		case !obs.ResourceExists && obs.ResourceHasData:
+			// unmanaged data is moved to a managed resource, the same way the
+			// reconciler handles a single network node
+			if len(obs.ResourceDeletes) != 0 {
+				deletes := managed.ExternalObservation{ResourceDeletes: obs.ResourceDeletes, ResourceUpdates: make([]*gnmi.Update, 0)}
+				if _, err := cl.Update(ctx, mg, deletes); err != nil {
+					return errors.Wrapf(err, "networknode %s", nodeName)
+				}
+			}
+			if _, err := cl.Create(ctx, mg); err != nil {
+				return errors.Wrapf(err, "networknode %s", nodeName)
+			}
+		case !obs.ResourceExists, !obs.ResourceHasData:
+			if _, err := cl.Create(ctx, mg); err != nil {
+				return errors.Wrapf(err, "networknode %s", nodeName)
+			}
+		case !obs.ResourceUpToDate:
+			if _, err := cl.Update(ctx, mg, obs); err != nil {
+				return errors.Wrapf(err, "networknode %s", nodeName)
+			}
+		}
+	}
+	for _, nodeName := range e.stale {
+		if _, ok := e.observations[nodeName]; !ok {
+			continue
+		}
+		if err := e.clients[nodeName].Delete(ctx, mg); err != nil {
+			return errors.Wrapf(err, "networknode %s", nodeName)
+		}
+	}
+	return nil
+}
+
+// GetTarget returns the network nodes the resource is applied to, which are the
+// selected network nodes and the network nodes the resource is removed from,
+// such that the changes of both are guarded.
+func (e *fanoutExternal) GetTarget() []string {
+	nodeNames := make([]string, 0, len(e.targets)+len(e.stale))
+	nodeNames = append(nodeNames, e.targets...)
+	return append(nodeNames, e.stale...)
+}
+
+// GetConfig returns the configuration of the first selected network node, which
+// is used for the validation of the external leafrefs and the parent dependencies.
+func (e *fanoutExternal) GetConfig(ctx context.Context) ([]byte, error) {
+	if len(e.targets) == 0 {
+		return make([]byte, 0), nil
+	}
+	return e.clients[e.targets[0]].GetConfig(ctx)
+}
+
+// GetResourceName returns the resource name of the path on the first selected network node
+func (e *fanoutExternal) GetResourceName(ctx context.Context, path []*gnmi.Path) (string, error) {
+	if len(e.targets) == 0 {
+		return "", nil
+	}
+	return e.clients[e.targets[0]].GetResourceName(ctx, path)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/yndd/ndd-provider-srl/internal/shard"
+)
+
+const (
+	// Errors
+	errGetShardObject = "cannot get object to determine its shard"
+	errListShard      = "cannot list the objects of the shard"
+)
+
+// shards is the sharder of the provider replica, it is set by the options of
+// Configure before the controllers are set up. Without a sharder the replica
+// reconciles all objects.
+var shards *shard.Sharder
+
+// A shardKey returns the key of the object on the shard ring.
+type shardKey func(o client.Object) string
+
+// managedShardKey shards a managed resource by the network node it references,
+// such that all resources of a network node are reconciled by the replica that
+// subscribes to the network node. Resources that select network nodes are
+// sharded by their name.
+func managedShardKey(o client.Object) string {
+	if mg, ok := o.(resource.Managed); ok {
+		if ref := mg.GetNetworkNodeReference(); ref != nil && ref.Name != "" {
+			return ref.Name
+		}
+	}
+	return objectShardKey(o)
+}
+
+// objectShardKey shards an object by its namespace and name.
+func objectShardKey(o client.Object) string {
+	return client.ObjectKeyFromObject(o).String()
+}
+
+// newShardedReconciler wraps a reconciler such that it only reconciles the
+// objects of the shard of the replica, the requests of the other objects are
+// dropped. Objects that are not found are passed to the reconciler.
+func newShardedReconciler(kube client.Client, obj client.Object, key shardKey, r reconcile.Reconciler) reconcile.Reconciler {
+	return &shardedReconciler{Reconciler: r, kube: kube, obj: obj, key: key}
+}
+
+type shardedReconciler struct {
+	reconcile.Reconciler
+	kube client.Client
+	obj  client.Object
+	key  shardKey
+}
+
+func (r *shardedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	if shards == nil {
+		return r.Reconciler.Reconcile(ctx, req)
+	}
+	o, ok := r.obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return r.Reconciler.Reconcile(ctx, req)
+	}
+	if err := r.kube.Get(ctx, req.NamespacedName, o); err != nil {
+		if IgnoreNotFound(err) == nil {
+			return r.Reconciler.Reconcile(ctx, req)
+		}
+		return reconcile.Result{}, errors.Wrap(err, errGetShardObject)
+	}
+	if !shards.Owns(r.key(o)) {
+		return reconcile.Result{}, nil
+	}
+	return r.Reconciler.Reconcile(ctx, req)
+}
+
+// shardSource returns a source that enqueues all objects of the list when the
+// members of the shard ring change, such that the objects that moved to the
+// shard of the replica are reconciled. The sharded reconciler drops the objects
+// of the other shards.
+func shardSource(kube client.Client, l logging.Logger, newList func() client.ObjectList) source.Source {
+	return source.Func(func(ctx context.Context, h handler.EventHandler, q workqueue.RateLimitingInterface, _ ...predicate.Predicate) error {
+		changed := shards.Subscribe()
+		if changed == nil {
+			return nil
+		}
+		go func() {
+			for {
+				select {
+				case <-changed:
+				case <-ctx.Done():
+					return
+				}
+				ol := newList()
+				if err := kube.List(ctx, ol); err != nil {
+					l.Debug(errListShard, "error", err)
+					continue
+				}
+				if err := meta.EachListItem(ol, func(obj runtime.Object) error {
+					if o, ok := obj.(client.Object); ok {
+						h.Generic(event.GenericEvent{Object: o}, q)
+					}
+					return nil
+				}); err != nil {
+					l.Debug(errListShard, "error", err)
+				}
+			}
+		}()
+		return nil
+	})
+}
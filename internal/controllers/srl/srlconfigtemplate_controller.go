@@ -0,0 +1,351 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errGetConfigTemplate          = "cannot get SrlConfigTemplate"
+	errListConfigTemplate         = "cannot list SrlConfigTemplates"
+	errUpdateConfigTemplateStatus = "cannot update SrlConfigTemplate status"
+	errConfigTemplateKind         = "kind is not a SR Linux resource that can be rendered per network node"
+	errParseTemplate              = "cannot parse template"
+	errRenderTemplate             = "cannot render template"
+	errDecodeRendered             = "rendered template is not valid for the kind"
+	errGetVariables               = "cannot get variables ConfigMap"
+	errFmtParseVariables          = "cannot parse variables of network node %s"
+	errRenderNetworkNodes         = "cannot render the resource for all network nodes"
+)
+
+// SetupConfigTemplate adds a controller that reconciles SrlConfigTemplates.
+func SetupConfigTemplate(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration) error {
+	name := "configtemplate/" + strings.ToLower(srlv1.ConfigTemplateGroupKind)
+
+	r := &configTemplateReconciler{
+		client: mgr.GetClient(),
+		scheme: mgr.GetScheme(),
+		log:    l.WithValues("controller", name),
+		poll:   poll,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlConfigTemplate{}).
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(r.networkNodeMapFunc),
+		).
+		Watches(
+			&source.Kind{Type: &corev1.ConfigMap{}},
+			handler.EnqueueRequestsFromMapFunc(r.configMapMapFunc),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlConfigTemplateList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlConfigTemplate{}, objectShardKey, r))
+}
+
+// configTemplateData is the data supplied to the template of a SrlConfigTemplate
+// when it gets rendered for a network node.
+type configTemplateData struct {
+	NetworkNode string
+	Labels      map[string]string
+	Annotations map[string]string
+	Vars        map[string]interface{}
+}
+
+// A configTemplateReconciler renders a resource per selected network node and
+// keeps the rendered resources in sync with the template and the variables of
+// the network nodes.
+type configTemplateReconciler struct {
+	client client.Client
+	scheme *runtime.Scheme
+	log    logging.Logger
+	poll   time.Duration
+}
+
+// Reconcile a SrlConfigTemplate. The rendered resources are owned by the
+// SrlConfigTemplate, when it is deleted they get garbage collected.
+func (r *configTemplateReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	t := &srlv1.SrlConfigTemplate{}
+	if err := r.client.Get(ctx, req.NamespacedName, t); err != nil {
+		return reconcile.Result{}, errors.Wrap(IgnoreNotFound(err), errGetConfigTemplate)
+	}
+	if meta.WasDeleted(t) {
+		return reconcile.Result{}, nil
+	}
+
+	children, err := r.render(ctx, log, t)
+	if err != nil {
+		log.Debug("Cannot render", "error", err)
+		t.SetConditions(nddv1.Unavailable(), nddv1.ReconcileError(err))
+	} else {
+		t.SetConditions(nddv1.Available(), nddv1.ReconcileSuccess())
+	}
+	if children != nil {
+		if err := r.prune(ctx, t, children); err != nil {
+			log.Debug("Cannot prune", "error", err)
+			t.SetConditions(nddv1.ReconcileError(err))
+		}
+		t.Status.Children = children
+	}
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, t), errUpdateConfigTemplateStatus)
+}
+
+// render renders and applies a resource for every selected network node. A failure
+// to render the resource for a network node is reported on the child and does not
+// block the other network nodes. The returned children are nil when the template
+// itself is not valid.
+func (r *configTemplateReconciler) render(ctx context.Context, log logging.Logger, t *srlv1.SrlConfigTemplate) ([]srlv1.ConfigTemplateChild, error) {
+	gvk := srlv1.GroupVersion.WithKind(t.Spec.Kind)
+	obj, err := r.scheme.New(gvk)
+	if err != nil || t.Spec.Kind == srlv1.RegistrationKind {
+		return nil, errors.New(errConfigTemplateKind)
+	}
+	if _, ok := obj.(resource.Managed); !ok {
+		return nil, errors.New(errConfigTemplateKind)
+	}
+
+	pc := newPoolClaimer(r.client, srlv1.ConfigTemplateKind, t.GetName())
+	tmpl, err := template.New(t.GetName()).Option("missingkey=error").Funcs(poolFuncs(ctx, pc, "")).Parse(t.Spec.Template)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseTemplate)
+	}
+
+	selector := labels.Everything()
+	if t.Spec.NetworkNodeSelector != nil {
+		if selector, err = metav1.LabelSelectorAsSelector(t.Spec.NetworkNodeSelector); err != nil {
+			return nil, errors.Wrap(err, errNetworkNodeSelector)
+		}
+	}
+
+	vars, err := r.getVariables(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	nnl := &ndrv1.NetworkNodeList{}
+	if err := r.client.List(ctx, nnl); err != nil {
+		return nil, errors.Wrap(err, errGetNetworkNode)
+	}
+	sort.Slice(nnl.Items, func(i, j int) bool { return nnl.Items[i].GetName() < nnl.Items[j].GetName() })
+
+	children := make([]srlv1.ConfigTemplateChild, 0)
+	failed := 0
+	for _, nn := range nnl.Items {
+		if !selector.Matches(labels.Set(nn.GetLabels())) {
+			continue
+		}
+		child := srlv1.ConfigTemplateChild{
+			Kind:        t.Spec.Kind,
+			Name:        t.GetName() + "-" + nn.GetName(),
+			NetworkNode: nn.GetName(),
+		}
+		data := configTemplateData{
+			NetworkNode: nn.GetName(),
+			Labels:      nn.GetLabels(),
+			Annotations: nn.GetAnnotations(),
+			Vars:        vars[nn.GetName()],
+		}
+		tmpl.Funcs(poolFuncs(ctx, pc, nn.GetName()))
+		if err := r.apply(ctx, t, tmpl, gvk, child.Name, data); err != nil {
+			log.Debug("Cannot render for network node", "networknode", nn.GetName(), "error", err)
+			child.Message = err.Error()
+			failed++
+		}
+		children = append(children, child)
+	}
+	if failed != 0 {
+		return children, errors.New(errRenderNetworkNodes)
+	}
+	// the claims of a network node that failed to render are unknown, hence the
+	// values are only released when all network nodes rendered
+	if err := pc.release(ctx); err != nil {
+		return children, err
+	}
+	return children, nil
+}
+
+// poolFuncs returns the template functions that claim values from the pools for
+// the network node, e.g. {{ claimPrefix "loopbacks" "system0" }} or
+// {{ claimAsn "asns" "bgp" }}. The claims are scoped to the network node.
+func poolFuncs(ctx context.Context, pc *poolClaimer, nodeName string) template.FuncMap {
+	return template.FuncMap{
+		"claimPrefix": func(pool, claim string) (string, error) {
+			return pc.claim(ctx, srlv1.IpPoolKind, pool, nodeName+"/"+claim)
+		},
+		"claimAsn": func(pool, claim string) (uint32, error) {
+			return pc.claimNumber(ctx, srlv1.AsnPoolKind, pool, nodeName+"/"+claim)
+		},
+		"claimVni": func(pool, claim string) (uint32, error) {
+			return pc.claimNumber(ctx, srlv1.VniPoolKind, pool, nodeName+"/"+claim)
+		},
+	}
+}
+
+// getVariables returns the variables per network node from the variables ConfigMap.
+func (r *configTemplateReconciler) getVariables(ctx context.Context, t *srlv1.SrlConfigTemplate) (map[string]map[string]interface{}, error) {
+	vars := make(map[string]map[string]interface{})
+	ref := t.Spec.VariablesConfigMapRef
+	if ref == nil {
+		return vars, nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return nil, errors.Wrap(err, errGetVariables)
+	}
+	for nodeName, d := range cm.Data {
+		j, err := yaml.ToJSON([]byte(d))
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtParseVariables, nodeName)
+		}
+		v := make(map[string]interface{})
+		if err := json.Unmarshal(j, &v); err != nil {
+			return nil, errors.Wrapf(err, errFmtParseVariables, nodeName)
+		}
+		vars[nodeName] = v
+	}
+	return vars, nil
+}
+
+// apply renders the resource for a network node and creates or updates it. The
+// rendered resource is only updated when the hash of its spec changed.
+func (r *configTemplateReconciler) apply(ctx context.Context, t *srlv1.SrlConfigTemplate, tmpl *template.Template, gvk schema.GroupVersionKind, name string, data configTemplateData) error {
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return errors.Wrap(err, errRenderTemplate)
+	}
+	j, err := yaml.ToJSON(buf.Bytes())
+	if err != nil {
+		return errors.Wrap(err, errRenderTemplate)
+	}
+	params := make(map[string]interface{})
+	if err := json.Unmarshal(j, &params); err != nil {
+		return errors.Wrap(err, errRenderTemplate)
+	}
+
+	spec := map[string]interface{}{
+		"active":         t.Spec.Active,
+		"deletionPolicy": string(t.Spec.DeletionPolicy),
+		"networkNodeRef": map[string]interface{}{"name": data.NetworkNode},
+		"forNetworkNode": params,
+	}
+	d, err := json.Marshal(spec)
+	if err != nil {
+		return errors.Wrap(err, errJSONMarshal)
+	}
+	if err := r.validate(gvk, d); err != nil {
+		return err
+	}
+	return applyChild(ctx, r.client, t, srlv1.ConfigTemplateGroupVersionKind, gvk, name, map[string]string{srlv1.LabelKeyConfigTemplate: t.GetName()}, spec)
+}
+
+// validate decodes the rendered spec into the kind, unknown fields are rejected
+// such that errors in the template are reported on the SrlConfigTemplate.
+func (r *configTemplateReconciler) validate(gvk schema.GroupVersionKind, spec []byte) error {
+	obj, err := r.scheme.New(gvk)
+	if err != nil {
+		return errors.New(errConfigTemplateKind)
+	}
+	d, err := json.Marshal(map[string]json.RawMessage{"spec": spec})
+	if err != nil {
+		return errors.Wrap(err, errJSONMarshal)
+	}
+	dec := json.NewDecoder(bytes.NewReader(d))
+	dec.DisallowUnknownFields()
+	return errors.Wrap(dec.Decode(obj), errDecodeRendered)
+}
+
+// prune deletes the resources rendered before that are no longer rendered, e.g.
+// because the network node is no longer selected or the kind changed.
+func (r *configTemplateReconciler) prune(ctx context.Context, t *srlv1.SrlConfigTemplate, children []srlv1.ConfigTemplateChild) error {
+	keep := make(map[childKey]bool)
+	for _, c := range children {
+		keep[childKey{Kind: c.Kind, Name: c.Name}] = true
+	}
+	previous := make([]childKey, 0, len(t.Status.Children))
+	for _, c := range t.Status.Children {
+		previous = append(previous, childKey{Kind: c.Kind, Name: c.Name})
+	}
+	return pruneChildren(ctx, r.client, t, previous, keep)
+}
+
+// networkNodeMapFunc enqueues all SrlConfigTemplates when a network node changes,
+// since the labels and annotations of a network node are variables of the templates.
+func (r *configTemplateReconciler) networkNodeMapFunc(o client.Object) []reconcile.Request {
+	return r.configTemplateRequests(func(t *srlv1.SrlConfigTemplate) bool { return true })
+}
+
+// configMapMapFunc enqueues the SrlConfigTemplates that refer to the ConfigMap.
+func (r *configTemplateReconciler) configMapMapFunc(o client.Object) []reconcile.Request {
+	return r.configTemplateRequests(func(t *srlv1.SrlConfigTemplate) bool {
+		ref := t.Spec.VariablesConfigMapRef
+		return ref != nil && ref.Name == o.GetName() && ref.Namespace == o.GetNamespace()
+	})
+}
+
+func (r *configTemplateReconciler) configTemplateRequests(match func(t *srlv1.SrlConfigTemplate) bool) []reconcile.Request {
+	tl := &srlv1.SrlConfigTemplateList{}
+	if err := r.client.List(context.TODO(), tl); err != nil {
+		r.log.Debug(errListConfigTemplate, "error", err)
+		return nil
+	}
+	reqs := make([]reconcile.Request, 0)
+	for _, t := range tl.Items {
+		t := t
+		if match(&t) {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: t.GetName()}})
+		}
+	}
+	return reqs
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errUnexpectedEvpnL2Service = "the composite resource is not a SrlEvpnL2Service resource"
+	errFmtDuplicateAttachment  = "duplicate attachment of interface %s on network node %s"
+	errListEvpnL3Service       = "cannot list SrlEvpnL3Services"
+)
+
+// SetupEvpnL2Service adds a controller that reconciles SrlEvpnL2Services.
+func SetupEvpnL2Service(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration) error {
+	name := "composite/" + strings.ToLower(srlv1.EvpnL2ServiceGroupKind)
+
+	r := &compositeReconciler{
+		client:       mgr.GetClient(),
+		scheme:       mgr.GetScheme(),
+		log:          l.WithValues("controller", name),
+		poll:         poll,
+		newComposite: func() composite { return &srlv1.SrlEvpnL2Service{} },
+		render:       renderEvpnL2Service,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlEvpnL2Service{}).
+		Owns(&srlv1.SrlNetworkinstance{}).
+		Owns(&srlv1.SrlInterfaceSubinterface{}).
+		Owns(&srlv1.SrlTunnelinterfaceVxlaninterface{}).
+		Owns(&srlv1.SrlNetworkinstanceProtocolsBgpevpn{}).
+		Owns(&srlv1.SrlNetworkinstanceProtocolsBgpvpn{}).
+		Watches(
+			// the irb subinterfaces of the linked ip-vrfs are part of the mac-vrf
+			&source.Kind{Type: &srlv1.SrlEvpnL3Service{}},
+			handler.EnqueueRequestsFromMapFunc(evpnL3ServiceMapFunc),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlEvpnL2ServiceList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlEvpnL2Service{}, objectShardKey, r))
+}
+
+// evpnL3ServiceMapFunc enqueues the SrlEvpnL2Services linked to a SrlEvpnL3Service.
+func evpnL3ServiceMapFunc(o client.Object) []reconcile.Request {
+	cr, ok := o.(*srlv1.SrlEvpnL3Service)
+	if !ok {
+		return nil
+	}
+	reqs := make([]reconcile.Request, 0, len(cr.Spec.MacVrfs))
+	for _, mv := range cr.Spec.MacVrfs {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: mv.EvpnL2Service}})
+	}
+	return reqs
+}
+
+// renderEvpnL2Service generates the child resources of a SrlEvpnL2Service.
+func renderEvpnL2Service(ctx context.Context, kube client.Client, cr composite) ([]compositeChild, error) {
+	o, ok := cr.(*srlv1.SrlEvpnL2Service)
+	if !ok {
+		return nil, errors.New(errUnexpectedEvpnL2Service)
+	}
+	s, err := newEvpnL2Service(o)
+	if err != nil {
+		return nil, err
+	}
+	pc := newPoolClaimer(kube, srlv1.EvpnL2ServiceKind, o.GetName())
+	vni, err := claimVni(ctx, pc, o.Spec.Vni, o.Spec.VniPoolRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := pc.release(ctx); err != nil {
+		return nil, err
+	}
+	s.vni = vni
+	o.Status.Vni = s.vni
+
+	// the irb subinterfaces of the SrlEvpnL3Services that link the mac-vrf
+	l3l := &srlv1.SrlEvpnL3ServiceList{}
+	if err := kube.List(ctx, l3l); err != nil {
+		return nil, errors.Wrap(err, errListEvpnL3Service)
+	}
+	irbs := make([]string, 0)
+	for _, l3 := range l3l.Items {
+		for _, mv := range l3.Spec.MacVrfs {
+			if mv.EvpnL2Service == o.GetName() {
+				irbs = append(irbs, subinterfaceName(irbInterface(&l3), mv.IrbIndex))
+			}
+		}
+	}
+	sort.Strings(irbs)
+
+	perNode, err := attachmentsPerNetworkNode(o.Spec.Attachments)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]compositeChild, 0)
+	for _, nodeName := range sortedNetworkNodes(perNode) {
+		subinterfaces := make([]string, 0)
+		for _, a := range perNode[nodeName] {
+			children = append(children, s.subinterfaceChild(nodeName, a.Interface, bridgedSubinterface(a)))
+			subinterfaces = append(subinterfaces, subinterfaceName(a.Interface, attachmentIndex(a)))
+		}
+		subinterfaces = append(subinterfaces, irbs...)
+		children = append(children,
+			s.vxlanInterfaceChild(nodeName),
+			s.networkInstanceChild(nodeName, subinterfaces),
+			s.bgpEvpnChild(nodeName, nil),
+			s.bgpVpnChild(nodeName),
+		)
+	}
+	return children, nil
+}
+
+// newEvpnL2Service returns the parameters of the mac-vrf of the SrlEvpnL2Service.
+func newEvpnL2Service(o *srlv1.SrlEvpnL2Service) (*evpnService, error) {
+	s := &evpnService{
+		name:            o.Spec.Name,
+		owner:           o.GetName(),
+		active:          o.Spec.Active,
+		deletionPolicy:  o.Spec.DeletionPolicy,
+		networkInstance: "mac-vrf",
+		tunnelInterface: o.Spec.TunnelInterface,
+		vxlanType:       "bridged",
+		vni:             o.Spec.Vni,
+		evi:             o.Spec.Evi,
+	}
+	if s.name == "" {
+		s.name = o.GetName()
+	}
+	if s.tunnelInterface == "" {
+		s.tunnelInterface = defaultTunnelInterface
+	}
+	rt, err := routeTarget(o.Spec.RouteTarget, o.Spec.AutonomousSystem, o.Spec.Evi)
+	if err != nil {
+		return nil, err
+	}
+	s.routeTarget = rt
+	return s, nil
+}
+
+// attachmentsPerNetworkNode groups the attachments per network node, an interface
+// can only be attached once with the same subinterface index.
+func attachmentsPerNetworkNode(attachments []srlv1.EvpnServiceAttachment) (map[string][]srlv1.EvpnServiceAttachment, error) {
+	perNode := make(map[string][]srlv1.EvpnServiceAttachment)
+	seen := make(map[string]bool)
+	for _, a := range attachments {
+		key := a.NetworkNode + "/" + subinterfaceName(a.Interface, attachmentIndex(a))
+		if seen[key] {
+			return nil, errors.Errorf(errFmtDuplicateAttachment, a.Interface, a.NetworkNode)
+		}
+		seen[key] = true
+		perNode[a.NetworkNode] = append(perNode[a.NetworkNode], a)
+	}
+	return perNode, nil
+}
+
+// sortedNetworkNodes returns the network nodes in order, such that the children
+// are rendered in a stable order.
+func sortedNetworkNodes(perNode map[string][]srlv1.EvpnServiceAttachment) []string {
+	nodeNames := make([]string, 0, len(perNode))
+	for nodeName := range perNode {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+	return nodeNames
+}
+
+// bridgedSubinterface returns the bridged subinterface of the attachment.
+func bridgedSubinterface(a srlv1.EvpnServiceAttachment) *srlv1.InterfaceSubinterface {
+	si := &srlv1.InterfaceSubinterface{
+		AdminState: utils.StringPtr("enable"),
+		Index:      utils.Uint32Ptr(attachmentIndex(a)),
+		Type:       utils.StringPtr("bridged"),
+		Vlan: &srlv1.InterfaceSubinterfaceVlan{Encap: &srlv1.InterfaceSubinterfaceVlanEncap{
+			Untagged: &srlv1.InterfaceSubinterfaceVlanEncapUntagged{},
+		}},
+	}
+	if a.VlanID != nil {
+		si.Vlan.Encap = &srlv1.InterfaceSubinterfaceVlanEncap{
+			SingleTagged: &srlv1.InterfaceSubinterfaceVlanEncapSingleTagged{VlanId: utils.StringPtr(strconv.Itoa(int(*a.VlanID)))},
+		}
+	}
+	return si
+}
+
+// attachmentIndex returns the subinterface index of the attachment.
+func attachmentIndex(a srlv1.EvpnServiceAttachment) uint32 {
+	switch {
+	case a.Index != nil:
+		return *a.Index
+	case a.VlanID != nil:
+		return uint32(*a.VlanID)
+	default:
+		return 0
+	}
+}
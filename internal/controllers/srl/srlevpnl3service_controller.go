@@ -0,0 +1,264 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errUnexpectedEvpnL3Service = "the composite resource is not a SrlEvpnL3Service resource"
+	errFmtGetEvpnL2Service     = "cannot get SrlEvpnL2Service %s"
+	errFmtDuplicateIrbIndex    = "duplicate irb index %d"
+)
+
+// SetupEvpnL3Service adds a controller that reconciles SrlEvpnL3Services.
+func SetupEvpnL3Service(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration) error {
+	name := "composite/" + strings.ToLower(srlv1.EvpnL3ServiceGroupKind)
+
+	r := &compositeReconciler{
+		client:       mgr.GetClient(),
+		scheme:       mgr.GetScheme(),
+		log:          l.WithValues("controller", name),
+		poll:         poll,
+		newComposite: func() composite { return &srlv1.SrlEvpnL3Service{} },
+		render:       renderEvpnL3Service,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlEvpnL3Service{}).
+		Owns(&srlv1.SrlNetworkinstance{}).
+		Owns(&srlv1.SrlInterfaceSubinterface{}).
+		Owns(&srlv1.SrlTunnelinterfaceVxlaninterface{}).
+		Owns(&srlv1.SrlNetworkinstanceProtocolsBgpevpn{}).
+		Owns(&srlv1.SrlNetworkinstanceProtocolsBgpvpn{}).
+		Watches(
+			// the ip-vrf is created on the network nodes of the linked mac-vrfs
+			&source.Kind{Type: &srlv1.SrlEvpnL2Service{}},
+			handler.EnqueueRequestsFromMapFunc(evpnL2ServiceMapFunc(mgr.GetClient(), l)),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlEvpnL3ServiceList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlEvpnL3Service{}, objectShardKey, r))
+}
+
+// evpnL2ServiceMapFunc enqueues the SrlEvpnL3Services that link a SrlEvpnL2Service.
+func evpnL2ServiceMapFunc(kube client.Client, l logging.Logger) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		l3l := &srlv1.SrlEvpnL3ServiceList{}
+		if err := kube.List(context.TODO(), l3l); err != nil {
+			l.Debug(errListEvpnL3Service, "error", err)
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0)
+		for _, l3 := range l3l.Items {
+			for _, mv := range l3.Spec.MacVrfs {
+				if mv.EvpnL2Service == o.GetName() {
+					reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: l3.GetName()}})
+					break
+				}
+			}
+		}
+		return reqs
+	}
+}
+
+// renderEvpnL3Service generates the child resources of a SrlEvpnL3Service. The
+// ip-vrf is created on every network node of the linked mac-vrfs.
+func renderEvpnL3Service(ctx context.Context, kube client.Client, cr composite) ([]compositeChild, error) {
+	o, ok := cr.(*srlv1.SrlEvpnL3Service)
+	if !ok {
+		return nil, errors.New(errUnexpectedEvpnL3Service)
+	}
+	s, err := newEvpnL3Service(o)
+	if err != nil {
+		return nil, err
+	}
+	pc := newPoolClaimer(kube, srlv1.EvpnL3ServiceKind, o.GetName())
+	vni, err := claimVni(ctx, pc, o.Spec.Vni, o.Spec.VniPoolRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := pc.release(ctx); err != nil {
+		return nil, err
+	}
+	s.vni = vni
+	o.Status.Vni = s.vni
+	irbIf := irbInterface(o)
+
+	perNode := make(map[string][]srlv1.EvpnL3ServiceMacVrf)
+	indexes := make(map[uint32]bool)
+	for _, mv := range o.Spec.MacVrfs {
+		if indexes[mv.IrbIndex] {
+			return nil, errors.Errorf(errFmtDuplicateIrbIndex, mv.IrbIndex)
+		}
+		indexes[mv.IrbIndex] = true
+
+		l2 := &srlv1.SrlEvpnL2Service{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: mv.EvpnL2Service}, l2); err != nil {
+			return nil, errors.Wrapf(err, errFmtGetEvpnL2Service, mv.EvpnL2Service)
+		}
+		perL2Node, err := attachmentsPerNetworkNode(l2.Spec.Attachments)
+		if err != nil {
+			return nil, err
+		}
+		for nodeName := range perL2Node {
+			perNode[nodeName] = append(perNode[nodeName], mv)
+		}
+	}
+
+	nodeNames := make([]string, 0, len(perNode))
+	for nodeName := range perNode {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	children := make([]compositeChild, 0)
+	for _, nodeName := range nodeNames {
+		subinterfaces := make([]string, 0)
+		for _, mv := range perNode[nodeName] {
+			children = append(children, s.subinterfaceChild(nodeName, irbIf, irbSubinterface(o, mv)))
+			subinterfaces = append(subinterfaces, subinterfaceName(irbIf, mv.IrbIndex))
+		}
+		children = append(children,
+			s.vxlanInterfaceChild(nodeName),
+			s.networkInstanceChild(nodeName, subinterfaces),
+			s.bgpEvpnChild(nodeName, &srlv1.NetworkinstanceProtocolsBgpevpnBgpInstanceRoutes{
+				RouteTable: &srlv1.NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTable{
+					MacIp: &srlv1.NetworkinstanceProtocolsBgpevpnBgpInstanceRoutesRouteTableMacIp{
+						AdvertiseGatewayMac: utils.BoolPtr(true),
+					},
+				},
+			}),
+			s.bgpVpnChild(nodeName),
+		)
+	}
+	return children, nil
+}
+
+// newEvpnL3Service returns the parameters of the ip-vrf of the SrlEvpnL3Service.
+func newEvpnL3Service(o *srlv1.SrlEvpnL3Service) (*evpnService, error) {
+	s := &evpnService{
+		name:            o.Spec.Name,
+		owner:           o.GetName(),
+		active:          o.Spec.Active,
+		deletionPolicy:  o.Spec.DeletionPolicy,
+		networkInstance: "ip-vrf",
+		tunnelInterface: o.Spec.TunnelInterface,
+		vxlanType:       "routed",
+		vni:             o.Spec.Vni,
+		evi:             o.Spec.Evi,
+	}
+	if s.name == "" {
+		s.name = o.GetName()
+	}
+	if s.tunnelInterface == "" {
+		s.tunnelInterface = defaultTunnelInterface
+	}
+	rt, err := routeTarget(o.Spec.RouteTarget, o.Spec.AutonomousSystem, o.Spec.Evi)
+	if err != nil {
+		return nil, err
+	}
+	s.routeTarget = rt
+	return s, nil
+}
+
+// irbInterface returns the irb interface of the SrlEvpnL3Service.
+func irbInterface(o *srlv1.SrlEvpnL3Service) string {
+	if o.Spec.IrbInterface == "" {
+		return defaultIrbInterface
+	}
+	return o.Spec.IrbInterface
+}
+
+// irbSubinterface returns the irb subinterface of a linked mac-vrf with the anycast
+// gateway addresses. The ARP and neighbor discovery entries are learned and
+// advertised in EVPN as required for symmetric IRB.
+func irbSubinterface(o *srlv1.SrlEvpnL3Service, mv srlv1.EvpnL3ServiceMacVrf) *srlv1.InterfaceSubinterface {
+	vrid := o.Spec.VirtualRouterId
+	if vrid == 0 {
+		vrid = 1
+	}
+	si := &srlv1.InterfaceSubinterface{
+		AdminState: utils.StringPtr("enable"),
+		Index:      utils.Uint32Ptr(mv.IrbIndex),
+		AnycastGw:  &srlv1.InterfaceSubinterfaceAnycastGw{VirtualRouterId: uint8Ptr(vrid)},
+	}
+	if o.Spec.AnycastGwMac != "" {
+		si.AnycastGw.AnycastGwMac = utils.StringPtr(o.Spec.AnycastGwMac)
+	}
+	if len(mv.Ipv4Prefixes) != 0 {
+		si.Ipv4 = &srlv1.InterfaceSubinterfaceIpv4{
+			Arp: &srlv1.InterfaceSubinterfaceIpv4Arp{
+				LearnUnsolicited: utils.BoolPtr(true),
+				HostRoute: &srlv1.InterfaceSubinterfaceIpv4ArpHostRoute{
+					Populate: []*srlv1.InterfaceSubinterfaceIpv4ArpHostRoutePopulate{{RouteType: utils.StringPtr("dynamic")}},
+				},
+				Evpn: &srlv1.InterfaceSubinterfaceIpv4ArpEvpn{
+					Advertise: []*srlv1.InterfaceSubinterfaceIpv4ArpEvpnAdvertise{{RouteType: utils.StringPtr("dynamic")}},
+				},
+			},
+		}
+		for _, prefix := range mv.Ipv4Prefixes {
+			si.Ipv4.Address = append(si.Ipv4.Address, &srlv1.InterfaceSubinterfaceIpv4Address{
+				IpPrefix:  utils.StringPtr(prefix),
+				AnycastGw: utils.BoolPtr(true),
+			})
+		}
+	}
+	if len(mv.Ipv6Prefixes) != 0 {
+		si.Ipv6 = &srlv1.InterfaceSubinterfaceIpv6{
+			NeighborDiscovery: &srlv1.InterfaceSubinterfaceIpv6NeighborDiscovery{
+				LearnUnsolicited: utils.StringPtr("global"),
+				HostRoute: &srlv1.InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoute{
+					Populate: []*srlv1.InterfaceSubinterfaceIpv6NeighborDiscoveryHostRoutePopulate{{RouteType: utils.StringPtr("dynamic")}},
+				},
+				Evpn: &srlv1.InterfaceSubinterfaceIpv6NeighborDiscoveryEvpn{
+					Advertise: []*srlv1.InterfaceSubinterfaceIpv6NeighborDiscoveryEvpnAdvertise{{RouteType: utils.StringPtr("dynamic")}},
+				},
+			},
+		}
+		for _, prefix := range mv.Ipv6Prefixes {
+			si.Ipv6.Address = append(si.Ipv6.Address, &srlv1.InterfaceSubinterfaceIpv6Address{
+				IpPrefix:  utils.StringPtr(prefix),
+				AnycastGw: utils.BoolPtr(true),
+			})
+		}
+	}
+	return si
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errUnexpectedFabric       = "the composite resource is not a SrlFabric resource"
+	errFmtAsPoolExhausted     = "autonomous system pool %d-%d exhausted"
+	errFmtFabricPoolNotSet    = "either %s or %s must be set"
+	errFmtFabricPoolNotIPv4   = "%s %s is not an IPv4 prefix"
+	errFmtDuplicateFabricNode = "duplicate network node %s"
+	errFmtUnknownFabricNode   = "link endpoint references unknown network node %s"
+	errFmtDuplicateFabricLink = "interface %s of network node %s is used by multiple links"
+	errFmtFabricSelfLink      = "link between interfaces %s and %s of network node %s"
+	errFmtFabricLinkTiers     = "link between %s %s and %s %s does not connect adjacent tiers"
+)
+
+const (
+	defaultUnderlayNetworkInstance = "default"
+	fabricLoopbackInterface        = "system0"
+	fabricBgpGroup                 = "underlay"
+)
+
+// fabricTiers are the tiers of the roles of the network nodes, the links of the
+// fabric connect the network nodes of adjacent tiers.
+var fabricTiers = map[string]int{
+	srlv1.FabricRoleLeaf:       0,
+	srlv1.FabricRoleSpine:      1,
+	srlv1.FabricRoleSuperspine: 2,
+}
+
+// SetupFabric adds a controller that reconciles SrlFabrics.
+func SetupFabric(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration) error {
+	name := "composite/" + strings.ToLower(srlv1.FabricGroupKind)
+
+	r := &compositeReconciler{
+		client:       mgr.GetClient(),
+		scheme:       mgr.GetScheme(),
+		log:          l.WithValues("controller", name),
+		poll:         poll,
+		newComposite: func() composite { return &srlv1.SrlFabric{} },
+		render:       renderFabric,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlFabric{}).
+		Owns(&srlv1.SrlInterface{}).
+		Owns(&srlv1.SrlInterfaceSubinterface{}).
+		Owns(&srlv1.SrlNetworkinstance{}).
+		Owns(&srlv1.SrlNetworkinstanceProtocolsBgp{}).
+		Owns(&srlv1.SrlRoutingpolicyPrefixset{}).
+		Owns(&srlv1.SrlRoutingpolicyPolicy{}).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlFabricList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlFabric{}, objectShardKey, r))
+}
+
+// renderFabric generates the child resources of a SrlFabric.
+func renderFabric(ctx context.Context, kube client.Client, cr composite) ([]compositeChild, error) {
+	o, ok := cr.(*srlv1.SrlFabric)
+	if !ok {
+		return nil, errors.New(errUnexpectedFabric)
+	}
+	pc := newPoolClaimer(kube, srlv1.FabricKind, o.GetName())
+	f, err := newFabric(ctx, pc, o)
+	if err != nil {
+		return nil, err
+	}
+	if err := pc.release(ctx); err != nil {
+		return nil, err
+	}
+
+	status := make([]srlv1.FabricNodeStatus, 0, len(f.nodes))
+	children := make([]compositeChild, 0)
+	for _, n := range f.nodes {
+		status = append(status, srlv1.FabricNodeStatus{NetworkNode: n.name, Role: n.role, AutonomousSystem: n.as, RouterId: n.routerID})
+		children = append(children, f.render(n)...)
+	}
+	o.Status.Nodes = status
+	return children, nil
+}
+
+// A fabricInterface is a point-to-point interface of a network node.
+type fabricInterface struct {
+	name string
+	// prefix is the address of the interface with the /31 prefix length
+	prefix        string
+	peerNode      string
+	peerInterface string
+	peerAddress   string
+}
+
+// A fabricNode is a network node with its allocations.
+type fabricNode struct {
+	name       string
+	role       string
+	as         uint32
+	routerID   string
+	interfaces []*fabricInterface
+}
+
+// A fabric holds the allocations of a SrlFabric and renders the child resources
+// per network node.
+type fabric struct {
+	owner           string
+	active          bool
+	deletionPolicy  nddv1.DeletionPolicy
+	networkInstance string
+	loopbackPool    string
+	nodes           []*fabricNode
+}
+
+// newFabric validates the topology of the SrlFabric and allocates the autonomous
+// systems, loopbacks and link addresses. The values are either claimed from the
+// referenced pools or allocated in the order of the nodes and links from the
+// literal pools. The links must connect the leafs to the spines and the spines
+// to the superspines.
+func newFabric(ctx context.Context, pc *poolClaimer, o *srlv1.SrlFabric) (*fabric, error) {
+	f := &fabric{
+		owner:           o.GetName(),
+		active:          o.Spec.Active,
+		deletionPolicy:  o.Spec.DeletionPolicy,
+		networkInstance: o.Spec.NetworkInstance,
+		loopbackPool:    o.Spec.LoopbackPool,
+		nodes:           make([]*fabricNode, 0, len(o.Spec.Nodes)),
+	}
+	if f.networkInstance == "" {
+		f.networkInstance = defaultUnderlayNetworkInstance
+	}
+	if ref := o.Spec.LoopbackPoolRef; ref != nil {
+		p, err := pc.get(ctx, srlv1.IpPoolKind, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		f.loopbackPool = p.(*srlv1.SrlIpPool).Spec.Prefix
+	}
+	switch {
+	case f.loopbackPool == "":
+		return nil, errors.Errorf(errFmtFabricPoolNotSet, "loopbackPool", "loopbackPoolRef")
+	case !isIPv4Prefix(f.loopbackPool):
+		return nil, errors.Errorf(errFmtFabricPoolNotIPv4, "loopback pool", f.loopbackPool)
+	case o.Spec.LinkPoolRef == nil && o.Spec.LinkPool == "":
+		return nil, errors.Errorf(errFmtFabricPoolNotSet, "linkPool", "linkPoolRef")
+	case o.Spec.LinkPoolRef == nil && !isIPv4Prefix(o.Spec.LinkPool):
+		return nil, errors.Errorf(errFmtFabricPoolNotIPv4, "link pool", o.Spec.LinkPool)
+	}
+
+	nodes := make(map[string]*fabricNode)
+	asIndexes := make(map[string]int)
+	for i, n := range o.Spec.Nodes {
+		if _, ok := nodes[n.NetworkNode]; ok {
+			return nil, errors.Errorf(errFmtDuplicateFabricNode, n.NetworkNode)
+		}
+		fn := &fabricNode{name: n.NetworkNode, role: n.Role}
+		loopback, err := fabricPrefix(ctx, pc, o.Spec.LoopbackPoolRef, o.Spec.LoopbackPool, 32, i, "loopback/"+n.NetworkNode)
+		if err != nil {
+			return nil, err
+		}
+		if fn.routerID, err = prefixAddress(loopback, 0); err != nil {
+			return nil, err
+		}
+		group := fabricAsGroup(n)
+		if _, ok := asIndexes[group]; !ok {
+			asIndexes[group] = len(asIndexes)
+		}
+		if fn.as, err = fabricAutonomousSystem(ctx, pc, o, asIndexes[group], group, n); err != nil {
+			return nil, err
+		}
+		nodes[n.NetworkNode] = fn
+		f.nodes = append(f.nodes, fn)
+	}
+
+	used := make(map[string]bool)
+	for i, l := range o.Spec.Links {
+		if l.A.NetworkNode == l.B.NetworkNode {
+			return nil, errors.Errorf(errFmtFabricSelfLink, l.A.Interface, l.B.Interface, l.A.NetworkNode)
+		}
+		for _, ep := range []srlv1.FabricLinkEndpoint{l.A, l.B} {
+			if _, ok := nodes[ep.NetworkNode]; !ok {
+				return nil, errors.Errorf(errFmtUnknownFabricNode, ep.NetworkNode)
+			}
+			if used[ep.NetworkNode+"/"+ep.Interface] {
+				return nil, errors.Errorf(errFmtDuplicateFabricLink, ep.Interface, ep.NetworkNode)
+			}
+			used[ep.NetworkNode+"/"+ep.Interface] = true
+		}
+		a, b := nodes[l.A.NetworkNode], nodes[l.B.NetworkNode]
+		if d := fabricTiers[a.role] - fabricTiers[b.role]; d != 1 && d != -1 {
+			return nil, errors.Errorf(errFmtFabricLinkTiers, a.role, a.name, b.role, b.name)
+		}
+		subnet, err := fabricPrefix(ctx, pc, o.Spec.LinkPoolRef, o.Spec.LinkPool, 31, i, "link/"+l.A.NetworkNode+"/"+l.A.Interface)
+		if err != nil {
+			return nil, err
+		}
+		aAddr, err := prefixAddress(subnet, 0)
+		if err != nil {
+			return nil, err
+		}
+		bAddr, err := prefixAddress(subnet, 1)
+		if err != nil {
+			return nil, err
+		}
+		a.interfaces = append(a.interfaces, &fabricInterface{
+			name: l.A.Interface, prefix: aAddr + "/31", peerNode: l.B.NetworkNode, peerInterface: l.B.Interface, peerAddress: bAddr,
+		})
+		b.interfaces = append(b.interfaces, &fabricInterface{
+			name: l.B.Interface, prefix: bAddr + "/31", peerNode: l.A.NetworkNode, peerInterface: l.A.Interface, peerAddress: aAddr,
+		})
+	}
+	return f, nil
+}
+
+// fabricPrefix returns the prefix of the length that is claimed from the
+// referenced SrlIpPool, or the i-th prefix of the literal pool.
+func fabricPrefix(ctx context.Context, pc *poolClaimer, ref *srlv1.PoolReference, prefix string, length uint8, i int, claim string) (string, error) {
+	if ref != nil {
+		return pc.claimPrefix(ctx, ref.Name, claim, length)
+	}
+	r, err := newPrefixRange(prefix, int(length))
+	if err != nil {
+		return "", err
+	}
+	v, ok := r.value(uint64(i))
+	if !ok {
+		return "", errors.Errorf(errFmtPoolExhausted, prefix)
+	}
+	return v, nil
+}
+
+// fabricAsGroup returns the group of network nodes that share an autonomous
+// system, every leaf has its own autonomous system while the spines and the
+// superspines share the autonomous system of their tier.
+func fabricAsGroup(n srlv1.FabricNode) string {
+	if n.Role == srlv1.FabricRoleLeaf {
+		return n.NetworkNode
+	}
+	return n.Role
+}
+
+// fabricAutonomousSystem returns the autonomous system of the i-th group of
+// network nodes, it is claimed from the referenced SrlAsnPool or allocated from
+// the literal pool unless the network node overrides it.
+func fabricAutonomousSystem(ctx context.Context, pc *poolClaimer, o *srlv1.SrlFabric, i int, group string, n srlv1.FabricNode) (uint32, error) {
+	switch {
+	case n.AutonomousSystem != nil:
+		return *n.AutonomousSystem, nil
+	case o.Spec.AutonomousSystemPoolRef != nil:
+		return pc.claimNumber(ctx, srlv1.AsnPoolKind, o.Spec.AutonomousSystemPoolRef.Name, "as/"+group)
+	case o.Spec.AutonomousSystemPool == nil:
+		return 0, errors.Errorf(errFmtFabricPoolNotSet, "autonomousSystemPool", "autonomousSystemPoolRef")
+	}
+	p := o.Spec.AutonomousSystemPool
+	if uint64(p.Start)+uint64(i) > uint64(p.End) {
+		return 0, errors.Errorf(errFmtAsPoolExhausted, p.Start, p.End)
+	}
+	return p.Start + uint32(i), nil
+}
+
+// peerAs returns the autonomous system of the peer network node.
+func (f *fabric) peerAs(nodeName string) uint32 {
+	for _, n := range f.nodes {
+		if n.name == nodeName {
+			return n.as
+		}
+	}
+	return 0
+}
+
+// render renders the child resources of the network node.
+func (f *fabric) render(n *fabricNode) []compositeChild {
+	spec := childResourceSpec(f.active, f.deletionPolicy, n.name)
+	prefixSet := f.owner + "-loopbacks"
+	policy := f.owner + "-export"
+
+	children := make([]compositeChild, 0)
+	niItfces := make([]*srlv1.NetworkinstanceInterface, 0, len(n.interfaces)+1)
+	neighbors := make([]*srlv1.NetworkinstanceProtocolsBgpNeighbor, 0, len(n.interfaces))
+
+	itfces := append([]*fabricInterface{{name: fabricLoopbackInterface, prefix: n.routerID + "/32"}}, n.interfaces...)
+	for _, itfce := range itfces {
+		description := "fabric loopback"
+		if itfce.peerNode != "" {
+			description = "fabric link to " + itfce.peerNode + " " + itfce.peerInterface
+		}
+		children = append(children,
+			compositeChild{networkNode: n.name, obj: &srlv1.SrlInterface{
+				ObjectMeta: childObjectMeta(f.owner, n.name, itfce.name),
+				Spec: srlv1.InterfaceSpec{
+					ResourceSpec: spec,
+					ForNetworkNode: srlv1.InterfaceParameters{
+						SrlInterface: &srlv1.Interface{
+							Name:        utils.StringPtr(itfce.name),
+							AdminState:  utils.StringPtr("enable"),
+							Description: utils.StringPtr(description),
+						},
+					},
+				},
+			}},
+			compositeChild{networkNode: n.name, obj: &srlv1.SrlInterfaceSubinterface{
+				ObjectMeta: childObjectMeta(f.owner, n.name, itfce.name, "0"),
+				Spec: srlv1.InterfaceSubinterfaceSpec{
+					ResourceSpec: spec,
+					ForNetworkNode: srlv1.InterfaceSubinterfaceParameters{
+						InterfaceName: utils.StringPtr(itfce.name),
+						SrlInterfaceSubinterface: &srlv1.InterfaceSubinterface{
+							Index:      utils.Uint32Ptr(0),
+							AdminState: utils.StringPtr("enable"),
+							Ipv4: &srlv1.InterfaceSubinterfaceIpv4{
+								Address: []*srlv1.InterfaceSubinterfaceIpv4Address{{IpPrefix: utils.StringPtr(itfce.prefix)}},
+							},
+						},
+					},
+				},
+			}},
+		)
+		niItfces = append(niItfces, &srlv1.NetworkinstanceInterface{Name: utils.StringPtr(subinterfaceName(itfce.name, 0))})
+		if itfce.peerNode != "" {
+			neighbors = append(neighbors, &srlv1.NetworkinstanceProtocolsBgpNeighbor{
+				PeerAddress: utils.StringPtr(itfce.peerAddress),
+				PeerAs:      utils.Uint32Ptr(f.peerAs(itfce.peerNode)),
+				PeerGroup:   utils.StringPtr(fabricBgpGroup),
+				Description: utils.StringPtr(itfce.peerNode),
+			})
+		}
+	}
+
+	return append(children,
+		compositeChild{networkNode: n.name, obj: &srlv1.SrlNetworkinstance{
+			ObjectMeta: childObjectMeta(f.owner, n.name, "ni"),
+			Spec: srlv1.NetworkinstanceSpec{
+				ResourceSpec: spec,
+				ForNetworkNode: srlv1.NetworkinstanceParameters{
+					SrlNetworkinstance: &srlv1.Networkinstance{
+						Name:       utils.StringPtr(f.networkInstance),
+						Type:       utils.StringPtr("default"),
+						AdminState: utils.StringPtr("enable"),
+						Interface:  niItfces,
+					},
+				},
+			},
+		}},
+		compositeChild{networkNode: n.name, obj: &srlv1.SrlRoutingpolicyPrefixset{
+			ObjectMeta: childObjectMeta(f.owner, n.name, "prefix-set"),
+			Spec: srlv1.RoutingpolicyPrefixsetSpec{
+				ResourceSpec: spec,
+				ForNetworkNode: srlv1.RoutingpolicyPrefixsetParameters{
+					SrlRoutingpolicyPrefixset: &srlv1.RoutingpolicyPrefixset{
+						Name: utils.StringPtr(prefixSet),
+						Prefix: []*srlv1.RoutingpolicyPrefixsetPrefix{{
+							IpPrefix:        utils.StringPtr(f.loopbackPool),
+							MaskLengthRange: utils.StringPtr("32..32"),
+						}},
+					},
+				},
+			},
+		}},
+		compositeChild{networkNode: n.name, obj: &srlv1.SrlRoutingpolicyPolicy{
+			ObjectMeta: childObjectMeta(f.owner, n.name, "policy"),
+			Spec: srlv1.RoutingpolicyPolicySpec{
+				ResourceSpec: spec,
+				ForNetworkNode: srlv1.RoutingpolicyPolicyParameters{
+					SrlRoutingpolicyPolicy: &srlv1.RoutingpolicyPolicy{
+						Name: utils.StringPtr(policy),
+						Statement: []*srlv1.RoutingpolicyPolicyStatement{{
+							SequenceId: utils.Uint32Ptr(10),
+							Match:      &srlv1.RoutingpolicyPolicyStatementMatch{PrefixSet: utils.StringPtr(prefixSet)},
+							Action:     &srlv1.RoutingpolicyPolicyStatementAction{Accept: &srlv1.RoutingpolicyPolicyStatementActionAccept{}},
+						}},
+					},
+				},
+			},
+		}},
+		compositeChild{networkNode: n.name, obj: &srlv1.SrlNetworkinstanceProtocolsBgp{
+			ObjectMeta: childObjectMeta(f.owner, n.name, "bgp"),
+			Spec: srlv1.NetworkinstanceProtocolsBgpSpec{
+				ResourceSpec: spec,
+				ForNetworkNode: srlv1.NetworkinstanceProtocolsBgpParameters{
+					NetworkInstanceName: utils.StringPtr(f.networkInstance),
+					SrlNetworkinstanceProtocolsBgp: &srlv1.NetworkinstanceProtocolsBgp{
+						AdminState:       utils.StringPtr("enable"),
+						AutonomousSystem: utils.Uint32Ptr(n.as),
+						RouterId:         utils.StringPtr(n.routerID),
+						Ipv4Unicast: &srlv1.NetworkinstanceProtocolsBgpIpv4Unicast{
+							AdminState: utils.StringPtr("enable"),
+							Multipath: &srlv1.NetworkinstanceProtocolsBgpIpv4UnicastMultipath{
+								AllowMultipleAs: utils.BoolPtr(true),
+								MaxPathsLevel1:  utils.Uint32Ptr(64),
+							},
+						},
+						Group: []*srlv1.NetworkinstanceProtocolsBgpGroup{{
+							GroupName:    utils.StringPtr(fabricBgpGroup),
+							AdminState:   utils.StringPtr("enable"),
+							ExportPolicy: utils.StringPtr(policy),
+							Ipv4Unicast:  &srlv1.NetworkinstanceProtocolsBgpGroupIpv4Unicast{AdminState: utils.StringPtr("enable")},
+						}},
+						Neighbor: neighbors,
+					},
+				},
+			},
+		}},
+	)
+}
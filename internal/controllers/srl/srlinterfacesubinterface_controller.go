@@ -35,11 +35,11 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	"github.com/yndd/ndd-yang/pkg/parser"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	cevent "sigs.k8s.io/controller-runtime/pkg/event"
@@ -830,33 +830,38 @@ func SetupInterfaceSubinterface(mgr ctrl.Manager, o controller.Options, l loggin
 
 	events := make(chan cevent.GenericEvent)
 
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(newCommitReportingManager(mgr),
 		resource.ManagedKind(srlv1.InterfaceSubinterfaceGroupVersionKind),
-		managed.WithExternalConnecter(&connectorInterfaceSubinterface{
+		managed.WithExternalConnecter(newGuardedConnecter(mgr.GetClient(), l, levelInterfaceSubinterface, &connectorInterfaceSubinterface{
 			log:         l,
 			kube:        mgr.GetClient(),
 			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
-			newClientFn: target.NewTarget},
+			newClientFn: target.NewTarget}),
 		),
 		managed.WithParser(l),
-		managed.WithValidator(&validatorInterfaceSubinterface{log: l, parser: *parser.NewParser(parser.WithLogger(l))}),
+		managed.WithPollInterval(poll),
+		managed.WithValidator(newTracedValidator(&validatorInterfaceSubinterface{log: l, parser: *parser.NewParser(parser.WithLogger(l))})),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return srlv1.InterfaceSubinterfaceGroupKind, events, ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
-		For(&srlv1.SrlInterfaceSubinterface{}).
-		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		For(&srlv1.SrlInterfaceSubinterface{}, builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate())).
 		Watches(
 			&source.Channel{Source: events},
 			&handler.EnqueueRequestForObject{},
 		).
-		//Watches(
-		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
-		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
-		//).
-		Complete(r)
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(networkNodeMapFunc(mgr.GetClient(), l, func() resource.ManagedList { return &srlv1.SrlInterfaceSubinterfaceList{} }, referencesNetworkNode)),
+			builder.WithPredicates(networkNodeChangedPredicate()),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlInterfaceSubinterfaceList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlInterfaceSubinterface{}, managedShardKey, newTracedReconciler(&srlv1.SrlInterfaceSubinterface{}, newPolledReconciler(mgr.GetClient(), &srlv1.SrlInterfaceSubinterface{}, poll, r))))
 }
 
 type validatorInterfaceSubinterface struct {
@@ -1092,22 +1097,10 @@ func (c *connectorInterfaceSubinterface) Connect(ctx context.Context, mg resourc
 	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
 		return nil, errors.New(targetNotConfigured)
 	}
-	cfg := &gnmitypes.TargetConfig{
-		Name:       nn.GetName(),
-		Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-		Username:   utils.StringPtr("admin"),
-		Password:   utils.StringPtr("admin"),
-		Timeout:    10 * time.Second,
-		SkipVerify: utils.BoolPtr(true),
-		Insecure:   utils.BoolPtr(true),
-		TLSCA:      utils.StringPtr(""), //TODO TLS
-		TLSCert:    utils.StringPtr(""), //TODO TLS
-		TLSKey:     utils.StringPtr(""),
-		Gzip:       utils.BoolPtr(false),
-	}
+	cfg := targetConfig(nn)
 
 	cl := target.NewTarget(cfg)
-	if err := cl.CreateGNMIClient(ctx); err != nil {
+	if err := cl.CreateGNMIClient(ctx, gnmiDialOptions(cl.Config.Name)...); err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
@@ -1182,7 +1175,7 @@ func (e *externalInterfaceSubinterface) Observe(ctx context.Context, mg resource
 	}
 
 	// gnmi get response
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errReadInterfaceSubinterface)
 	}
@@ -1486,7 +1479,7 @@ func (e *externalInterfaceSubinterface) Create(ctx context.Context, mg resource.
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errReadInterfaceSubinterface)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInterfaceSubinterface)
 	}
 
 	return managed.ExternalCreation{}, nil
@@ -1540,7 +1533,7 @@ func (e *externalInterfaceSubinterface) Update(ctx context.Context, mg resource.
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errReadInterfaceSubinterface)
+		return managed.ExternalUpdate{}, errors.Wrap(err, erreUpdateInterfaceSubinterface)
 	}
 
 	return managed.ExternalUpdate{}, nil
@@ -1612,7 +1605,7 @@ func (e *externalInterfaceSubinterface) GetConfig(ctx context.Context) ([]byte,
 		Encoding: gnmi.Encoding_JSON,
 	}
 
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return make([]byte, 0), errors.Wrap(err, errGetConfig)
 	}
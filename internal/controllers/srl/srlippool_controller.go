@@ -0,0 +1,52 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+// SetupIpPool adds a controller that reconciles SrlIpPools.
+func SetupIpPool(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration) error {
+	name := "pool/" + strings.ToLower(srlv1.IpPoolGroupKind)
+
+	r := &poolReconciler{
+		client:  mgr.GetClient(),
+		log:     l.WithValues("controller", name),
+		poll:    poll,
+		newPool: func() pool { return &srlv1.SrlIpPool{} },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlIpPool{}).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlIpPoolList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlIpPool{}, objectShardKey, r))
+}
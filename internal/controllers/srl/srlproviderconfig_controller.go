@@ -0,0 +1,282 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errGetProviderConfig          = "cannot get SrlProviderConfig"
+	errUpdateProviderConfigStatus = "cannot update SrlProviderConfig status"
+	errFmtGetSecret               = "cannot get secret %s"
+	errFmtSecretKey               = "secret %s has no %s key"
+	errWriteTLSFiles              = "cannot write TLS files"
+	errListProviderConfigs        = "cannot list SrlProviderConfigs"
+	errFmtRetryTimer              = "invalid retry timer %s, must be positive"
+	errFmtBufferSize              = "invalid buffer size %d, must be positive"
+
+	// keys of the credentials and TLS secrets
+	secretKeyTLSCA   = "ca.crt"
+	secretKeyTLSCert = corev1.TLSCertKey
+	secretKeyTLSKey  = corev1.TLSPrivateKeyKey
+)
+
+// tlsDir is the directory the files of the TLS secrets are written to.
+var tlsDir = filepath.Join(os.TempDir(), "ndd-provider-srl", "tls")
+
+// SetupProviderConfig adds a controller that loads the SrlProviderConfigs.
+func SetupProviderConfig(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration) error {
+	name := "providerconfig/" + strings.ToLower(srlv1.ProviderConfigGroupKind)
+
+	r := &providerConfigReconciler{
+		client: mgr.GetClient(),
+		reader: mgr.GetAPIReader(),
+		log:    l.WithValues("controller", name),
+		poll:   poll,
+		dir:    tlsDir,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlProviderConfig{}).
+		Complete(r)
+}
+
+// LoadProviderConfigs loads the SrlProviderConfigs and their secrets before the
+// controllers start, such that the first reconciles of the resources use them.
+// A config of which the secrets cannot be loaded is left to the provider config
+// controller, which reports the error in its conditions.
+func LoadProviderConfigs(ctx context.Context, reader client.Reader, l logging.Logger) error {
+	r := &providerConfigReconciler{reader: reader, log: l, dir: tlsDir}
+	pcl := &srlv1.SrlProviderConfigList{}
+	if err := reader.List(ctx, pcl); err != nil {
+		return errors.Wrap(err, errListProviderConfigs)
+	}
+	for i := range pcl.Items {
+		p := &pcl.Items[i]
+		if meta.WasDeleted(p) {
+			continue
+		}
+		pc, err := r.load(ctx, p)
+		if err != nil {
+			r.log.Debug("Cannot load", "name", p.GetName(), "error", err)
+			continue
+		}
+		providerConfigs.set(p.GetName(), pc)
+		r.removeTLSFiles(p.GetName(), pc.tlsDir)
+	}
+	return nil
+}
+
+// A providerConfigReconciler loads the SrlProviderConfigs and their secrets in
+// the provider configs that the controllers read. The secrets are read again
+// every poll interval, such that rotated credentials and certificates are
+// picked up.
+type providerConfigReconciler struct {
+	client client.Client
+	// reader reads the secrets from the API server, such that the provider does
+	// not cache all secrets of the cluster
+	reader client.Reader
+	log    logging.Logger
+	poll   time.Duration
+	// dir the files of the TLS secrets are written to
+	dir string
+}
+
+// Reconcile a SrlProviderConfig.
+func (r *providerConfigReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	p := &srlv1.SrlProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, p); err != nil {
+		if IgnoreNotFound(err) == nil {
+			providerConfigs.delete(req.Name)
+			r.removeTLSFiles(req.Name, "")
+		}
+		return reconcile.Result{}, errors.Wrap(IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(p) {
+		providerConfigs.delete(p.GetName())
+		r.removeTLSFiles(p.GetName(), "")
+		return reconcile.Result{}, nil
+	}
+
+	pc, err := r.load(ctx, p)
+	if err != nil {
+		// the previously loaded config stays in use, such that a missing secret
+		// does not break the connections to the device drivers
+		log.Debug("Cannot load", "error", err)
+		p.SetConditions(nddv1.Unavailable(), nddv1.ReconcileError(err))
+	} else {
+		providerConfigs.set(p.GetName(), pc)
+		r.removeTLSFiles(p.GetName(), pc.tlsDir)
+		p.SetConditions(nddv1.Available(), nddv1.ReconcileSuccess())
+	}
+	// every replica loads the config, the status is reported by the replica
+	// that owns the config
+	if !shards.Owns(objectShardKey(p)) {
+		return reconcile.Result{RequeueAfter: r.poll}, nil
+	}
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdateProviderConfigStatus)
+}
+
+// load returns the provider config of the SrlProviderConfig with the
+// credentials and the files of the TLS secret it references.
+func (r *providerConfigReconciler) load(ctx context.Context, p *srlv1.SrlProviderConfig) (*providerConfig, error) {
+	if err := validateDeviceDriver(p.Spec.DeviceDriver); err != nil {
+		return nil, err
+	}
+	pc := &providerConfig{spec: *p.Spec.DeepCopy()}
+	if ref := p.Spec.DeviceDriver.CredentialsSecretRef; ref != nil {
+		s, err := r.secret(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range []string{corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey} {
+			if _, ok := s.Data[k]; !ok {
+				return nil, errors.Errorf(errFmtSecretKey, secretName(ref), k)
+			}
+		}
+		pc.username = string(s.Data[corev1.BasicAuthUsernameKey])
+		pc.password = string(s.Data[corev1.BasicAuthPasswordKey])
+	}
+	if ref := p.Spec.DeviceDriver.TLSSecretRef; ref != nil {
+		s, err := r.secret(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		pc.tlsDir = r.tlsFilesDir(p.GetName(), s)
+		if pc.tlsCA, pc.tlsCert, pc.tlsKey, err = r.writeTLSFiles(pc.tlsDir, s); err != nil {
+			return nil, errors.Wrap(err, errWriteTLSFiles)
+		}
+	}
+	return pc, nil
+}
+
+// validateDeviceDriver returns an error when the retry timer or buffer size of
+// the device driver connection is not positive, with which the subscriptions
+// would retry without pause or block on every response.
+func validateDeviceDriver(dd srlv1.DeviceDriverConnection) error {
+	if dd.RetryTimer != nil && dd.RetryTimer.Duration <= 0 {
+		return errors.Errorf(errFmtRetryTimer, dd.RetryTimer.Duration)
+	}
+	if dd.BufferSize != nil && *dd.BufferSize <= 0 {
+		return errors.Errorf(errFmtBufferSize, *dd.BufferSize)
+	}
+	return nil
+}
+
+func (r *providerConfigReconciler) secret(ctx context.Context, ref *corev1.SecretReference) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	if err := r.reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrapf(err, errFmtGetSecret, secretName(ref))
+	}
+	return s, nil
+}
+
+// tlsFilesDir returns the directory of the files of the version of the TLS
+// secret of the SrlProviderConfig with the name.
+func (r *providerConfigReconciler) tlsFilesDir(name string, s *corev1.Secret) string {
+	return filepath.Join(r.dir, name+"-"+s.GetResourceVersion())
+}
+
+// writeTLSFiles writes the keys of the TLS secret to files in the directory,
+// from which the gnmi targets load them. The files of a version of the secret
+// are written to their own directory, such that a rotation changes the config
+// of the targets and the targets reconnect with the new certificates.
+func (r *providerConfigReconciler) writeTLSFiles(dir string, s *corev1.Secret) (string, string, string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", "", "", err
+	}
+	paths := make([]string, 0, 3)
+	for _, k := range []string{secretKeyTLSCA, secretKeyTLSCert, secretKeyTLSKey} {
+		d, ok := s.Data[k]
+		if !ok {
+			paths = append(paths, "")
+			continue
+		}
+		path := filepath.Join(dir, k)
+		if err := os.WriteFile(path, d, 0600); err != nil {
+			return "", "", "", err
+		}
+		paths = append(paths, path)
+	}
+	return paths[0], paths[1], paths[2], nil
+}
+
+// removeTLSFiles removes the directories of the previous versions of the TLS
+// secret of the SrlProviderConfig with the name, the directory in use is kept.
+// The targets load the files when they connect, the targets of the previous
+// version are replaced once the loaded config is in use.
+func (r *providerConfigReconciler) removeTLSFiles(name, keep string) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		// the directory of a version is the name followed by the resource
+		// version, such that configs with a common name prefix are kept
+		version := strings.TrimPrefix(e.Name(), name+"-")
+		if !e.IsDir() || version == e.Name() || !isDigits(version) {
+			continue
+		}
+		dir := filepath.Join(r.dir, e.Name())
+		if dir == keep {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			r.log.Debug("Cannot remove TLS files", "dir", dir, "error", err)
+		}
+	}
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func secretName(ref *corev1.SecretReference) string {
+	return ref.Namespace + "/" + ref.Name
+}
@@ -19,7 +19,6 @@ package srl
 import (
 	"context"
 	"encoding/json"
-	"strconv"
 	"time"
 
 	"github.com/karimra/gnmic/target"
@@ -35,11 +34,11 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	"github.com/yndd/ndd-yang/pkg/parser"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	cevent "sigs.k8s.io/controller-runtime/pkg/event"
@@ -84,33 +83,38 @@ func SetupRoutingpolicyCommunityset(mgr ctrl.Manager, o controller.Options, l lo
 
 	events := make(chan cevent.GenericEvent)
 
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(newCommitReportingManager(mgr),
 		resource.ManagedKind(srlv1.RoutingpolicyCommunitysetGroupVersionKind),
-		managed.WithExternalConnecter(&connectorRoutingpolicyCommunityset{
+		managed.WithExternalConnecter(newGuardedConnecter(mgr.GetClient(), l, levelRoutingpolicyCommunityset, &connectorRoutingpolicyCommunityset{
 			log:         l,
 			kube:        mgr.GetClient(),
 			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
-			newClientFn: target.NewTarget},
+			newClientFn: target.NewTarget}),
 		),
 		managed.WithParser(l),
-		managed.WithValidator(&validatorRoutingpolicyCommunityset{log: l, parser: *parser.NewParser(parser.WithLogger(l))}),
+		managed.WithPollInterval(poll),
+		managed.WithValidator(newTracedValidator(&validatorRoutingpolicyCommunityset{log: l, parser: *parser.NewParser(parser.WithLogger(l))})),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return srlv1.RoutingpolicyCommunitysetGroupKind, events, ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
-		For(&srlv1.SrlRoutingpolicyCommunityset{}).
-		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		For(&srlv1.SrlRoutingpolicyCommunityset{}, builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate())).
 		Watches(
 			&source.Channel{Source: events},
 			&handler.EnqueueRequestForObject{},
 		).
-		//Watches(
-		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
-		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
-		//).
-		Complete(r)
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(networkNodeMapFunc(mgr.GetClient(), l, func() resource.ManagedList { return &srlv1.SrlRoutingpolicyCommunitysetList{} }, referencesNetworkNode)),
+			builder.WithPredicates(networkNodeChangedPredicate()),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlRoutingpolicyCommunitysetList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlRoutingpolicyCommunityset{}, managedShardKey, newTracedReconciler(&srlv1.SrlRoutingpolicyCommunityset{}, newPolledReconciler(mgr.GetClient(), &srlv1.SrlRoutingpolicyCommunityset{}, poll, r))))
 }
 
 type validatorRoutingpolicyCommunityset struct {
@@ -279,31 +283,28 @@ func (c *connectorRoutingpolicyCommunityset) Connect(ctx context.Context, mg res
 		return nil, errors.Wrap(err, errTrackTCUsage)
 	}
 
+	// a resource with a network node selector is fanned out to all selected network nodes
+	if o.Spec.NetworkNodeSelector != nil {
+		return connectNetworkNodeSelector(ctx, c.kube, log, o, c.connectNetworkNode)
+	}
+
 	// find network node that is configured status
 	nn := &ndrv1.NetworkNode{}
 	if err := c.kube.Get(ctx, types.NamespacedName{Name: o.GetNetworkNodeReference().Name}, nn); err != nil {
 		return nil, errors.Wrap(err, errGetNetworkNode)
 	}
+	return c.connectNetworkNode(ctx, log, nn)
+}
 
+// connectNetworkNode produces an ExternalClient for a single network node.
+func (c *connectorRoutingpolicyCommunityset) connectNetworkNode(ctx context.Context, log logging.Logger, nn *ndrv1.NetworkNode) (managed.ExternalClient, error) {
 	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
 		return nil, errors.New(targetNotConfigured)
 	}
-	cfg := &gnmitypes.TargetConfig{
-		Name:       nn.GetName(),
-		Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-		Username:   utils.StringPtr("admin"),
-		Password:   utils.StringPtr("admin"),
-		Timeout:    10 * time.Second,
-		SkipVerify: utils.BoolPtr(true),
-		Insecure:   utils.BoolPtr(true),
-		TLSCA:      utils.StringPtr(""), //TODO TLS
-		TLSCert:    utils.StringPtr(""), //TODO TLS
-		TLSKey:     utils.StringPtr(""),
-		Gzip:       utils.BoolPtr(false),
-	}
+	cfg := targetConfig(nn)
 
 	cl := target.NewTarget(cfg)
-	if err := cl.CreateGNMIClient(ctx); err != nil {
+	if err := cl.CreateGNMIClient(ctx, gnmiDialOptions(cl.Config.Name)...); err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
@@ -378,7 +379,7 @@ func (e *externalRoutingpolicyCommunityset) Observe(ctx context.Context, mg reso
 	}
 
 	// gnmi get response
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errReadRoutingpolicyCommunityset)
 	}
@@ -680,7 +681,7 @@ func (e *externalRoutingpolicyCommunityset) Create(ctx context.Context, mg resou
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errReadRoutingpolicyCommunityset)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRoutingpolicyCommunityset)
 	}
 
 	return managed.ExternalCreation{}, nil
@@ -734,7 +735,7 @@ func (e *externalRoutingpolicyCommunityset) Update(ctx context.Context, mg resou
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errReadRoutingpolicyCommunityset)
+		return managed.ExternalUpdate{}, errors.Wrap(err, erreUpdateRoutingpolicyCommunityset)
 	}
 
 	return managed.ExternalUpdate{}, nil
@@ -806,7 +807,7 @@ func (e *externalRoutingpolicyCommunityset) GetConfig(ctx context.Context) ([]by
 		Encoding: gnmi.Encoding_JSON,
 	}
 
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return make([]byte, 0), errors.Wrap(err, errGetConfig)
 	}
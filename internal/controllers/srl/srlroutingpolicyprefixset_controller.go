@@ -19,7 +19,6 @@ package srl
 import (
 	"context"
 	"encoding/json"
-	"strconv"
 	"time"
 
 	"github.com/karimra/gnmic/target"
@@ -35,11 +34,11 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	"github.com/yndd/ndd-yang/pkg/parser"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	cevent "sigs.k8s.io/controller-runtime/pkg/event"
@@ -90,33 +89,38 @@ func SetupRoutingpolicyPrefixset(mgr ctrl.Manager, o controller.Options, l loggi
 
 	events := make(chan cevent.GenericEvent)
 
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(newCommitReportingManager(mgr),
 		resource.ManagedKind(srlv1.RoutingpolicyPrefixsetGroupVersionKind),
-		managed.WithExternalConnecter(&connectorRoutingpolicyPrefixset{
+		managed.WithExternalConnecter(newGuardedConnecter(mgr.GetClient(), l, levelRoutingpolicyPrefixset, &connectorRoutingpolicyPrefixset{
 			log:         l,
 			kube:        mgr.GetClient(),
 			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
-			newClientFn: target.NewTarget},
+			newClientFn: target.NewTarget}),
 		),
 		managed.WithParser(l),
-		managed.WithValidator(&validatorRoutingpolicyPrefixset{log: l, parser: *parser.NewParser(parser.WithLogger(l))}),
+		managed.WithPollInterval(poll),
+		managed.WithValidator(newTracedValidator(&validatorRoutingpolicyPrefixset{log: l, parser: *parser.NewParser(parser.WithLogger(l))})),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return srlv1.RoutingpolicyPrefixsetGroupKind, events, ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
-		For(&srlv1.SrlRoutingpolicyPrefixset{}).
-		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		For(&srlv1.SrlRoutingpolicyPrefixset{}, builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate())).
 		Watches(
 			&source.Channel{Source: events},
 			&handler.EnqueueRequestForObject{},
 		).
-		//Watches(
-		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
-		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
-		//).
-		Complete(r)
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(networkNodeMapFunc(mgr.GetClient(), l, func() resource.ManagedList { return &srlv1.SrlRoutingpolicyPrefixsetList{} }, referencesNetworkNode)),
+			builder.WithPredicates(networkNodeChangedPredicate()),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlRoutingpolicyPrefixsetList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlRoutingpolicyPrefixset{}, managedShardKey, newTracedReconciler(&srlv1.SrlRoutingpolicyPrefixset{}, newPolledReconciler(mgr.GetClient(), &srlv1.SrlRoutingpolicyPrefixset{}, poll, r))))
 }
 
 type validatorRoutingpolicyPrefixset struct {
@@ -285,31 +289,28 @@ func (c *connectorRoutingpolicyPrefixset) Connect(ctx context.Context, mg resour
 		return nil, errors.Wrap(err, errTrackTCUsage)
 	}
 
+	// a resource with a network node selector is fanned out to all selected network nodes
+	if o.Spec.NetworkNodeSelector != nil {
+		return connectNetworkNodeSelector(ctx, c.kube, log, o, c.connectNetworkNode)
+	}
+
 	// find network node that is configured status
 	nn := &ndrv1.NetworkNode{}
 	if err := c.kube.Get(ctx, types.NamespacedName{Name: o.GetNetworkNodeReference().Name}, nn); err != nil {
 		return nil, errors.Wrap(err, errGetNetworkNode)
 	}
+	return c.connectNetworkNode(ctx, log, nn)
+}
 
+// connectNetworkNode produces an ExternalClient for a single network node.
+func (c *connectorRoutingpolicyPrefixset) connectNetworkNode(ctx context.Context, log logging.Logger, nn *ndrv1.NetworkNode) (managed.ExternalClient, error) {
 	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
 		return nil, errors.New(targetNotConfigured)
 	}
-	cfg := &gnmitypes.TargetConfig{
-		Name:       nn.GetName(),
-		Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-		Username:   utils.StringPtr("admin"),
-		Password:   utils.StringPtr("admin"),
-		Timeout:    10 * time.Second,
-		SkipVerify: utils.BoolPtr(true),
-		Insecure:   utils.BoolPtr(true),
-		TLSCA:      utils.StringPtr(""), //TODO TLS
-		TLSCert:    utils.StringPtr(""), //TODO TLS
-		TLSKey:     utils.StringPtr(""),
-		Gzip:       utils.BoolPtr(false),
-	}
+	cfg := targetConfig(nn)
 
 	cl := target.NewTarget(cfg)
-	if err := cl.CreateGNMIClient(ctx); err != nil {
+	if err := cl.CreateGNMIClient(ctx, gnmiDialOptions(cl.Config.Name)...); err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
@@ -384,7 +385,7 @@ func (e *externalRoutingpolicyPrefixset) Observe(ctx context.Context, mg resourc
 	}
 
 	// gnmi get response
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errReadRoutingpolicyPrefixset)
 	}
@@ -686,7 +687,7 @@ func (e *externalRoutingpolicyPrefixset) Create(ctx context.Context, mg resource
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errReadRoutingpolicyPrefixset)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRoutingpolicyPrefixset)
 	}
 
 	return managed.ExternalCreation{}, nil
@@ -740,7 +741,7 @@ func (e *externalRoutingpolicyPrefixset) Update(ctx context.Context, mg resource
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errReadRoutingpolicyPrefixset)
+		return managed.ExternalUpdate{}, errors.Wrap(err, erreUpdateRoutingpolicyPrefixset)
 	}
 
 	return managed.ExternalUpdate{}, nil
@@ -812,7 +813,7 @@ func (e *externalRoutingpolicyPrefixset) GetConfig(ctx context.Context) ([]byte,
 		Encoding: gnmi.Encoding_JSON,
 	}
 
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return make([]byte, 0), errors.Wrap(err, errGetConfig)
 	}
@@ -19,7 +19,6 @@ package srl
 import (
 	"context"
 	"encoding/json"
-	"strconv"
 	"time"
 
 	"github.com/karimra/gnmic/target"
@@ -35,11 +34,11 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	"github.com/yndd/ndd-yang/pkg/parser"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	cevent "sigs.k8s.io/controller-runtime/pkg/event"
@@ -97,33 +96,38 @@ func SetupSystemNetworkinstanceProtocolsEvpn(mgr ctrl.Manager, o controller.Opti
 
 	events := make(chan cevent.GenericEvent)
 
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(newCommitReportingManager(mgr),
 		resource.ManagedKind(srlv1.SystemNetworkinstanceProtocolsEvpnGroupVersionKind),
-		managed.WithExternalConnecter(&connectorSystemNetworkinstanceProtocolsEvpn{
+		managed.WithExternalConnecter(newGuardedConnecter(mgr.GetClient(), l, levelSystemNetworkinstanceProtocolsEvpn, &connectorSystemNetworkinstanceProtocolsEvpn{
 			log:         l,
 			kube:        mgr.GetClient(),
 			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
-			newClientFn: target.NewTarget},
+			newClientFn: target.NewTarget}),
 		),
 		managed.WithParser(l),
-		managed.WithValidator(&validatorSystemNetworkinstanceProtocolsEvpn{log: l, parser: *parser.NewParser(parser.WithLogger(l))}),
+		managed.WithPollInterval(poll),
+		managed.WithValidator(newTracedValidator(&validatorSystemNetworkinstanceProtocolsEvpn{log: l, parser: *parser.NewParser(parser.WithLogger(l))})),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return srlv1.SystemNetworkinstanceProtocolsEvpnGroupKind, events, ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
-		For(&srlv1.SrlSystemNetworkinstanceProtocolsEvpn{}).
-		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		For(&srlv1.SrlSystemNetworkinstanceProtocolsEvpn{}, builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate())).
 		Watches(
 			&source.Channel{Source: events},
 			&handler.EnqueueRequestForObject{},
 		).
-		//Watches(
-		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
-		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
-		//).
-		Complete(r)
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(networkNodeMapFunc(mgr.GetClient(), l, func() resource.ManagedList { return &srlv1.SrlSystemNetworkinstanceProtocolsEvpnList{} }, referencesNetworkNode)),
+			builder.WithPredicates(networkNodeChangedPredicate()),
+		).
+		Watches(
+			shardSource(mgr.GetClient(), l, func() client.ObjectList { return &srlv1.SrlSystemNetworkinstanceProtocolsEvpnList{} }),
+			&handler.EnqueueRequestForObject{},
+		).
+		Complete(newShardedReconciler(mgr.GetClient(), &srlv1.SrlSystemNetworkinstanceProtocolsEvpn{}, managedShardKey, newTracedReconciler(&srlv1.SrlSystemNetworkinstanceProtocolsEvpn{}, newPolledReconciler(mgr.GetClient(), &srlv1.SrlSystemNetworkinstanceProtocolsEvpn{}, poll, r))))
 }
 
 type validatorSystemNetworkinstanceProtocolsEvpn struct {
@@ -303,22 +307,10 @@ func (c *connectorSystemNetworkinstanceProtocolsEvpn) Connect(ctx context.Contex
 	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
 		return nil, errors.New(targetNotConfigured)
 	}
-	cfg := &gnmitypes.TargetConfig{
-		Name:       nn.GetName(),
-		Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-		Username:   utils.StringPtr("admin"),
-		Password:   utils.StringPtr("admin"),
-		Timeout:    10 * time.Second,
-		SkipVerify: utils.BoolPtr(true),
-		Insecure:   utils.BoolPtr(true),
-		TLSCA:      utils.StringPtr(""), //TODO TLS
-		TLSCert:    utils.StringPtr(""), //TODO TLS
-		TLSKey:     utils.StringPtr(""),
-		Gzip:       utils.BoolPtr(false),
-	}
+	cfg := targetConfig(nn)
 
 	cl := target.NewTarget(cfg)
-	if err := cl.CreateGNMIClient(ctx); err != nil {
+	if err := cl.CreateGNMIClient(ctx, gnmiDialOptions(cl.Config.Name)...); err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
@@ -395,7 +387,7 @@ func (e *externalSystemNetworkinstanceProtocolsEvpn) Observe(ctx context.Context
 	}
 
 	// gnmi get response
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errReadSystemNetworkinstanceProtocolsEvpn)
 	}
@@ -671,7 +663,7 @@ func (e *externalSystemNetworkinstanceProtocolsEvpn) Create(ctx context.Context,
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errReadSystemNetworkinstanceProtocolsEvpn)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSystemNetworkinstanceProtocolsEvpn)
 	}
 
 	return managed.ExternalCreation{}, nil
@@ -725,7 +717,7 @@ func (e *externalSystemNetworkinstanceProtocolsEvpn) Update(ctx context.Context,
 
 	_, err = e.client.Set(ctx, req)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errReadSystemNetworkinstanceProtocolsEvpn)
+		return managed.ExternalUpdate{}, errors.Wrap(err, erreUpdateSystemNetworkinstanceProtocolsEvpn)
 	}
 
 	return managed.ExternalUpdate{}, nil
@@ -799,7 +791,7 @@ func (e *externalSystemNetworkinstanceProtocolsEvpn) GetConfig(ctx context.Conte
 		Encoding: gnmi.Encoding_JSON,
 	}
 
-	resp, err := e.client.Get(ctx, req)
+	resp, err := nodeConfigs.get(ctx, e.client, req)
 	if err != nil {
 		return make([]byte, 0), errors.Wrap(err, errGetConfig)
 	}
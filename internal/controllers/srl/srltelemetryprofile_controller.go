@@ -0,0 +1,246 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/karimra/gnmic/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/collector"
+)
+
+const (
+	// Errors
+	errGetTelemetryProfile          = "cannot get SrlTelemetryProfile"
+	errListTelemetryProfile         = "cannot list SrlTelemetryProfiles"
+	errUpdateTelemetryProfileStatus = "cannot update SrlTelemetryProfile status"
+	errFmtTelemetryPath             = "invalid path %s of subscription %s"
+	errFmtTelemetryOptions          = "invalid options of subscription %s"
+	errFmtDuplicateSubscription     = "duplicate subscription %s"
+	errTelemetryFilePath            = "a path is required when the sink is file"
+	errSetTelemetryProfile          = "cannot set telemetry profile"
+)
+
+// SetupTelemetryProfile adds a controller that reconciles SrlTelemetryProfiles.
+func SetupTelemetryProfile(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, telemetry *collector.Telemetry) error {
+	name := "telemetryprofile/" + strings.ToLower(srlv1.TelemetryProfileGroupKind)
+
+	r := &telemetryProfileReconciler{
+		client:    mgr.GetClient(),
+		log:       l.WithValues("controller", name),
+		poll:      poll,
+		telemetry: telemetry,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srlv1.SrlTelemetryProfile{}).
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(r.networkNodeMapFunc),
+		).
+		Complete(r)
+}
+
+// A telemetryProfileReconciler applies the SrlTelemetryProfiles to the telemetry
+// of the collector, which subscribes to the paths of the selected network nodes.
+type telemetryProfileReconciler struct {
+	client    client.Client
+	log       logging.Logger
+	poll      time.Duration
+	telemetry *collector.Telemetry
+}
+
+// Reconcile a SrlTelemetryProfile.
+func (r *telemetryProfileReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	p := &srlv1.SrlTelemetryProfile{}
+	if err := r.client.Get(ctx, req.NamespacedName, p); err != nil {
+		if IgnoreNotFound(err) == nil {
+			r.telemetry.DeleteProfile(req.Name)
+		}
+		return reconcile.Result{}, errors.Wrap(IgnoreNotFound(err), errGetTelemetryProfile)
+	}
+	if meta.WasDeleted(p) {
+		r.telemetry.DeleteProfile(p.GetName())
+		return reconcile.Result{}, nil
+	}
+
+	nodes, err := r.apply(ctx, p)
+	if err != nil {
+		log.Debug("Cannot apply", "error", err)
+		r.telemetry.DeleteProfile(p.GetName())
+		p.Status.NetworkNodes = nil
+		p.SetConditions(nddv1.Unavailable(), nddv1.ReconcileError(err))
+	} else {
+		p.Status.NetworkNodes = nodes
+		p.SetConditions(nddv1.Available(), nddv1.ReconcileSuccess())
+	}
+	// every replica applies the profile to the targets of its shard, the status
+	// is reported by the replica that owns the profile
+	if !shards.Owns(objectShardKey(p)) {
+		return reconcile.Result{RequeueAfter: r.poll}, nil
+	}
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdateTelemetryProfileStatus)
+}
+
+// apply validates the subscriptions of the profile and sets the profile with the
+// selected network nodes in the telemetry of the collector.
+func (r *telemetryProfileReconciler) apply(ctx context.Context, p *srlv1.SrlTelemetryProfile) ([]string, error) {
+	subs, err := telemetrySubscriptions(p)
+	if err != nil {
+		return nil, err
+	}
+	if p.Spec.Export.Sink == srlv1.TelemetrySinkFile && stringPtrValue(p.Spec.Export.Path) == "" {
+		return nil, errors.New(errTelemetryFilePath)
+	}
+	nodes, err := r.selectNetworkNodes(ctx, p.Spec.NetworkNodeSelector)
+	if err != nil {
+		return nil, err
+	}
+	sink := p.Spec.Export.Sink
+	if sink == "" {
+		sink = srlv1.TelemetrySinkPrometheus
+	}
+	if err := r.telemetry.SetProfile(collector.TelemetryProfile{
+		Name:          p.GetName(),
+		NetworkNodes:  nodes,
+		Subscriptions: subs,
+		Sink:          sink,
+		Path:          stringPtrValue(p.Spec.Export.Path),
+	}); err != nil {
+		return nil, errors.Wrap(err, errSetTelemetryProfile)
+	}
+	return nodes, nil
+}
+
+// telemetrySubscriptions returns the subscriptions of the profile, the paths and
+// the options of every subscription are validated.
+func telemetrySubscriptions(p *srlv1.SrlTelemetryProfile) ([]collector.TelemetrySubscription, error) {
+	subs := make([]collector.TelemetrySubscription, 0, len(p.Spec.Subscriptions))
+	names := make(map[string]bool)
+	for _, s := range p.Spec.Subscriptions {
+		if names[s.Name] {
+			return nil, errors.Errorf(errFmtDuplicateSubscription, s.Name)
+		}
+		names[s.Name] = true
+
+		paths := make([]*gnmi.Path, 0, len(s.Paths))
+		for _, xpath := range s.Paths {
+			path, err := utils.ParsePath(xpath)
+			if err != nil {
+				return nil, errors.Wrapf(err, errFmtTelemetryPath, xpath, s.Name)
+			}
+			paths = append(paths, path)
+		}
+
+		opts := collector.SubscriptionOptions{
+			Mode:              stringPtrValue(s.Mode),
+			SuppressRedundant: s.SuppressRedundant,
+			Encoding:          stringPtrValue(s.Encoding),
+		}
+		if s.SampleInterval != nil {
+			opts.SampleInterval = s.SampleInterval.Duration
+		}
+		if s.HeartbeatInterval != nil {
+			opts.HeartbeatInterval = s.HeartbeatInterval.Duration
+		}
+		opts = opts.WithDefaults(collector.SubscriptionOptions{
+			Mode:     collector.SubscriptionModeSample,
+			Encoding: "json_ietf",
+		})
+		if err := opts.Validate(); err != nil {
+			return nil, errors.Wrapf(err, errFmtTelemetryOptions, s.Name)
+		}
+		subs = append(subs, collector.TelemetrySubscription{Name: s.Name, Paths: paths, Options: opts})
+	}
+	return subs, nil
+}
+
+// selectNetworkNodes returns the names of the configured network nodes that
+// match the selector, all configured network nodes match a nil selector.
+func (r *telemetryProfileReconciler) selectNetworkNodes(ctx context.Context, ls *metav1.LabelSelector) ([]string, error) {
+	selector := labels.Everything()
+	if ls != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(ls); err != nil {
+			return nil, errors.Wrap(err, errNetworkNodeSelector)
+		}
+	}
+	nnl := &ndrv1.NetworkNodeList{}
+	if err := r.client.List(ctx, nnl); err != nil {
+		return nil, errors.Wrap(err, errGetNetworkNode)
+	}
+	nodes := make([]string, 0)
+	for _, nn := range nnl.Items {
+		if !selector.Matches(labels.Set(nn.GetLabels())) {
+			continue
+		}
+		if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
+			continue
+		}
+		nodes = append(nodes, nn.GetName())
+	}
+	sort.Strings(nodes)
+	return nodes, nil
+}
+
+// networkNodeMapFunc enqueues all SrlTelemetryProfiles when a network node
+// changes, since the labels and the state of a network node select it.
+func (r *telemetryProfileReconciler) networkNodeMapFunc(o client.Object) []reconcile.Request {
+	pl := &srlv1.SrlTelemetryProfileList{}
+	if err := r.client.List(context.TODO(), pl); err != nil {
+		r.log.Debug(errListTelemetryProfile, "error", err)
+		return nil
+	}
+	reqs := make([]reconcile.Request, 0, len(pl.Items))
+	for _, p := range pl.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: p.GetName()}})
+	}
+	return reqs
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+	"github.com/yndd/ndd-provider-srl/internal/tracing"
+)
+
+// traceKey returns the key of the span of the reconcile of the object, the type
+// of the object distinguishes the kinds with the same name.
+func traceKey(o client.Object) string {
+	return traceKeyOf(o, client.ObjectKeyFromObject(o))
+}
+
+func traceKeyOf(o client.Object, nn types.NamespacedName) string {
+	return fmt.Sprintf("%T/%s", o, nn)
+}
+
+// resourceAttributes returns the span attributes of the managed resource.
+func resourceAttributes(mg resource.Managed) []attribute.KeyValue {
+	gvk := groupVersionKind(mg)
+	return []attribute.KeyValue{
+		tracing.AttrGroup.String(gvk.Group),
+		tracing.AttrVersion.String(gvk.Version),
+		tracing.AttrKind.String(gvk.Kind),
+		tracing.AttrName.String(mg.GetName()),
+		tracing.AttrNamespace.String(mg.GetNamespace()),
+	}
+}
+
+// groupVersionKind returns the kind of the managed resource, the type meta is
+// empty for the objects read from the cache of the manager.
+func groupVersionKind(mg resource.Managed) schema.GroupVersionKind {
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		gvk = srlv1.GroupVersion.WithKind(reflect.Indirect(reflect.ValueOf(mg)).Type().Name())
+	}
+	return gvk
+}
+
+// newTracedReconciler wraps a reconciler such that every reconcile of a resource
+// is a span, the spans of the external client and the validator of the resource
+// are children of the span of the reconcile.
+func newTracedReconciler(obj client.Object, r reconcile.Reconciler) reconcile.Reconciler {
+	return &tracedReconciler{Reconciler: r, obj: obj}
+}
+
+type tracedReconciler struct {
+	reconcile.Reconciler
+	obj client.Object
+}
+
+func (r *tracedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	key := traceKeyOf(r.obj, req.NamespacedName)
+	ctx, span := tracing.StartReconcile(ctx, key,
+		tracing.AttrGroup.String(srlv1.Group),
+		tracing.AttrVersion.String(srlv1.Version),
+		tracing.AttrKind.String(reflect.Indirect(reflect.ValueOf(r.obj)).Type().Name()),
+		tracing.AttrName.String(req.Name),
+		tracing.AttrNamespace.String(req.Namespace),
+	)
+	result, err := r.Reconciler.Reconcile(ctx, req)
+	span.SetAttributes(
+		attribute.Bool("reconcile.requeue", result.Requeue),
+		attribute.String("reconcile.requeue_after", result.RequeueAfter.String()),
+	)
+	tracing.EndReconcile(key, err)
+	return result, err
+}
+
+// newTracedValidator wraps a validator such that every validation step is a
+// span of the reconcile of the resource.
+func newTracedValidator(v managed.Validator) managed.Validator {
+	return &tracedValidator{Validator: v}
+}
+
+type tracedValidator struct {
+	managed.Validator
+}
+
+func (v *tracedValidator) ValidateLocalleafRef(ctx context.Context, mg resource.Managed) (managed.ValidateLocalleafRefObservation, error) {
+	ctx, span := tracing.Start(tracing.WithReconcile(ctx, traceKey(mg)), "ValidateLocalleafRef", resourceAttributes(mg)...)
+	o, err := v.Validator.ValidateLocalleafRef(ctx, mg)
+	span.SetAttributes(attribute.Bool("validation.success", o.Success), attribute.Int("validation.leafrefs", len(o.ResolvedLeafRefs)))
+	tracing.End(span, err)
+	return o, err
+}
+
+func (v *tracedValidator) ValidateExternalleafRef(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateExternalleafRefObservation, error) {
+	ctx, span := tracing.Start(tracing.WithReconcile(ctx, traceKey(mg)), "ValidateExternalleafRef", resourceAttributes(mg)...)
+	o, err := v.Validator.ValidateExternalleafRef(ctx, mg, cfg)
+	span.SetAttributes(attribute.Bool("validation.success", o.Success), attribute.Int("validation.leafrefs", len(o.ResolvedLeafRefs)))
+	tracing.End(span, err)
+	return o, err
+}
+
+func (v *tracedValidator) ValidateParentDependency(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateParentDependencyObservation, error) {
+	ctx, span := tracing.Start(tracing.WithReconcile(ctx, traceKey(mg)), "ValidateParentDependency", resourceAttributes(mg)...)
+	o, err := v.Validator.ValidateParentDependency(ctx, mg, cfg)
+	span.SetAttributes(attribute.Bool("validation.success", o.Success), attribute.Int("validation.leafrefs", len(o.ResolvedLeafRefs)))
+	tracing.End(span, err)
+	return o, err
+}
+
+func (v *tracedValidator) ValidateResourceIndexes(ctx context.Context, mg resource.Managed) (managed.ValidateResourceIndexesObservation, error) {
+	ctx, span := tracing.Start(tracing.WithReconcile(ctx, traceKey(mg)), "ValidateResourceIndexes", resourceAttributes(mg)...)
+	o, err := v.Validator.ValidateResourceIndexes(ctx, mg)
+	span.SetAttributes(attribute.Bool("validation.changed", o.Changed), tracing.AttrDeletes.Int(len(o.ResourceDeletes)))
+	tracing.End(span, err)
+	return o, err
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errListUniqueKind         = "cannot list resources to validate the uniqueness of identifiers"
+	errFmtDuplicateIdentifier = "%s %s%s is also used by %s %s%s"
+)
+
+// Identifier kinds that are validated for uniqueness.
+const (
+	identifierVni      = "vni"
+	identifierEvi      = "evi"
+	identifierEsi      = "esi"
+	identifierRouterID = "router-id"
+)
+
+// zeroEsi is the reserved ESI of single-homed interfaces, it is never unique.
+const zeroEsi = "00:00:00:00:00:00:00:00:00:00"
+
+// An identifier is a value of a resource that must be unique within its scope.
+// The scope is either a network node or, when empty, the fabric.
+type identifier struct {
+	kind  string
+	scope string
+	value string
+	// group allows resources of the same group to share the value, e.g. the
+	// ethernet segment with the same name on all network nodes of a multi-homed
+	// interface shares the same ESI
+	group string
+	// networkNode the resource is applied to, used to report collisions
+	networkNode string
+}
+
+// A uniqueKind is a kind of resource that has identifiers that must be unique.
+type uniqueKind struct {
+	kind        string
+	newList     func() resource.ManagedList
+	identifiers func(mg resource.Managed) []identifier
+}
+
+// uniqueKinds are the kinds of resources of which the identifiers are indexed.
+// The router-id is indexed for both the network instances and bgp, such that a
+// collision between both kinds is detected as well.
+var uniqueKinds = []uniqueKind{
+	{
+		kind:    srlv1.TunnelinterfaceVxlaninterfaceKind,
+		newList: func() resource.ManagedList { return &srlv1.SrlTunnelinterfaceVxlaninterfaceList{} },
+		identifiers: func(mg resource.Managed) []identifier {
+			o, ok := mg.(*srlv1.SrlTunnelinterfaceVxlaninterface)
+			if !ok || o.Spec.ForNetworkNode.SrlTunnelinterfaceVxlaninterface == nil {
+				return nil
+			}
+			ingress := o.Spec.ForNetworkNode.SrlTunnelinterfaceVxlaninterface.Ingress
+			if ingress == nil || ingress.Vni == nil {
+				return nil
+			}
+			nodeName := networkNodeName(mg)
+			return []identifier{{kind: identifierVni, scope: nodeName, value: strconv.FormatUint(uint64(*ingress.Vni), 10), networkNode: nodeName}}
+		},
+	},
+	{
+		kind:    srlv1.NetworkinstanceProtocolsBgpevpnKind,
+		newList: func() resource.ManagedList { return &srlv1.SrlNetworkinstanceProtocolsBgpevpnList{} },
+		identifiers: func(mg resource.Managed) []identifier {
+			o, ok := mg.(*srlv1.SrlNetworkinstanceProtocolsBgpevpn)
+			if !ok || o.Spec.ForNetworkNode.SrlNetworkinstanceProtocolsBgpevpn == nil {
+				return nil
+			}
+			nodeName := networkNodeName(mg)
+			ids := make([]identifier, 0)
+			for _, bi := range o.Spec.ForNetworkNode.SrlNetworkinstanceProtocolsBgpevpn.BgpInstance {
+				if bi.Evi != nil {
+					ids = append(ids, identifier{kind: identifierEvi, scope: nodeName, value: strconv.FormatUint(uint64(*bi.Evi), 10), networkNode: nodeName})
+				}
+			}
+			return ids
+		},
+	},
+	{
+		kind: srlv1.SystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiKind,
+		newList: func() resource.ManagedList {
+			return &srlv1.SrlSystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsiList{}
+		},
+		identifiers: func(mg resource.Managed) []identifier {
+			o, ok := mg.(*srlv1.SrlSystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsi)
+			if !ok || o.Spec.ForNetworkNode.SrlSystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsi == nil {
+				return nil
+			}
+			es := o.Spec.ForNetworkNode.SrlSystemNetworkinstanceProtocolsEvpnEsisBgpinstanceEsi
+			if es.Esi == nil || es.Name == nil || strings.ToLower(*es.Esi) == zeroEsi {
+				return nil
+			}
+			return []identifier{{kind: identifierEsi, value: strings.ToLower(*es.Esi), group: *es.Name, networkNode: networkNodeName(mg)}}
+		},
+	},
+	{
+		kind:    srlv1.NetworkinstanceProtocolsBgpKind,
+		newList: func() resource.ManagedList { return &srlv1.SrlNetworkinstanceProtocolsBgpList{} },
+		identifiers: func(mg resource.Managed) []identifier {
+			o, ok := mg.(*srlv1.SrlNetworkinstanceProtocolsBgp)
+			if !ok || o.Spec.ForNetworkNode.SrlNetworkinstanceProtocolsBgp == nil || o.Spec.ForNetworkNode.SrlNetworkinstanceProtocolsBgp.RouterId == nil {
+				return nil
+			}
+			return routerIDIdentifiers(mg, *o.Spec.ForNetworkNode.SrlNetworkinstanceProtocolsBgp.RouterId)
+		},
+	},
+	{
+		kind:    srlv1.NetworkinstanceKind,
+		newList: func() resource.ManagedList { return &srlv1.SrlNetworkinstanceList{} },
+		identifiers: func(mg resource.Managed) []identifier {
+			o, ok := mg.(*srlv1.SrlNetworkinstance)
+			if !ok || o.Spec.ForNetworkNode.SrlNetworkinstance == nil || o.Spec.ForNetworkNode.SrlNetworkinstance.RouterId == nil {
+				return nil
+			}
+			return routerIDIdentifiers(mg, *o.Spec.ForNetworkNode.SrlNetworkinstance.RouterId)
+		},
+	},
+}
+
+// routerIDIdentifiers returns the router-id identifier of the resource, the
+// network instances of the same network node can share the router-id.
+func routerIDIdentifiers(mg resource.Managed, routerID string) []identifier {
+	nodeName := networkNodeName(mg)
+	return []identifier{{kind: identifierRouterID, value: routerID, group: nodeName, networkNode: nodeName}}
+}
+
+// networkNodeName returns the network node the resource is applied to.
+func networkNodeName(mg resource.Managed) string {
+	if ref := mg.GetNetworkNodeReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// uniqueKindOf returns the uniqueKind of the resource.
+func uniqueKindOf(mg resource.Managed) (uniqueKind, bool) {
+	for _, uk := range uniqueKinds {
+		if len(uk.identifiers(mg)) != 0 {
+			return uk, true
+		}
+	}
+	return uniqueKind{}, false
+}
+
+// findCollision returns a message that describes the first collision of the
+// identifiers of the resource with the identifiers of the other resources, the
+// message is empty when the identifiers are unique. The identifiers are indexed
+// per network node or per fabric depending on their kind.
+func findCollision(ctx context.Context, kube client.Client, mg resource.Managed) (string, error) {
+	uk, ok := uniqueKindOf(mg)
+	if !ok {
+		return "", nil
+	}
+	ids := uk.identifiers(mg)
+	kinds := make(map[string]bool)
+	for _, id := range ids {
+		kinds[id.kind] = true
+	}
+
+	for _, other := range uniqueKinds {
+		l := other.newList()
+		if err := kube.List(ctx, l); err != nil {
+			return "", errors.Wrap(err, errListUniqueKind)
+		}
+		for _, o := range l.GetItems() {
+			if (other.kind == uk.kind && o.GetName() == mg.GetName()) || meta.WasDeleted(o) {
+				continue
+			}
+			for _, oid := range other.identifiers(o) {
+				if !kinds[oid.kind] {
+					continue
+				}
+				for _, id := range ids {
+					if id.kind != oid.kind || id.scope != oid.scope || id.value != oid.value {
+						continue
+					}
+					if id.group != "" && id.group == oid.group {
+						continue
+					}
+					// the network node of the other resource is only reported when
+					// the identifier is unique per fabric
+					otherNode := oid.networkNode
+					if id.scope != "" {
+						otherNode = ""
+					}
+					return fmt.Sprintf(errFmtDuplicateIdentifier, id.kind, id.value, onNetworkNode(id.scope), other.kind, o.GetName(), onNetworkNode(otherNode)), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// allowIdentifiers returns an error when an identifier of the managed resource
+// collides with another resource. The DuplicateIdentifier condition is set on the
+// managed resource, it gets persisted by the reconciler together with the rest of
+// the status.
+func (e *guardedExternal) allowIdentifiers(ctx context.Context, mg resource.Managed) error {
+	collision, err := findCollision(ctx, e.kube, mg)
+	if err != nil {
+		return err
+	}
+	if collision != "" {
+		e.log.Debug("change blocked", "reason", srlv1.ConditionReasonIdentifierCollision, "collision", collision)
+		mg.SetConditions(srlv1.DuplicateIdentifier().WithMessage(collision))
+		return errors.New(collision)
+	}
+	if mg.GetCondition(srlv1.ConditionKindDuplicateIdentifier).Status == corev1.ConditionTrue {
+		mg.SetConditions(srlv1.UniqueIdentifiers())
+	}
+	return nil
+}
+
+// onNetworkNode returns the network node suffix of a collision message.
+func onNetworkNode(nodeName string) string {
+	if nodeName == "" {
+		return ""
+	}
+	return " on network node " + nodeName
+}
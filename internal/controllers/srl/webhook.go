@@ -0,0 +1,263 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srlv1 "github.com/yndd/ndd-provider-srl/apis/srl/v1"
+)
+
+const (
+	// Errors
+	errNewDecoder = "cannot create admission decoder"
+
+	// UniquenessWebhookPath is the path of the validating admission webhook that
+	// rejects resources with identifiers that collide with other resources.
+	UniquenessWebhookPath = "/validate-srl-ndd-yndd-io-v1-uniqueness"
+
+	// AddressingWebhookPath is the path of the validating admission webhook that
+	// rejects subinterfaces with semantically invalid addressing.
+	AddressingWebhookPath = "/validate-srl-ndd-yndd-io-v1-addressing"
+
+	// PoolWebhookPath is the path of the validating admission webhook that
+	// rejects pools that overlap with other pools of the same kind.
+	PoolWebhookPath = "/validate-srl-ndd-yndd-io-v1-pool"
+
+	// ChangedByWebhookPath is the path of the mutating admission webhook that
+	// records the user that changed the spec of a resource.
+	ChangedByWebhookPath = "/mutate-srl-ndd-yndd-io-v1-changed-by"
+)
+
+// +kubebuilder:webhook:path=/validate-srl-ndd-yndd-io-v1-uniqueness,mutating=false,failurePolicy=fail,sideEffects=None,groups=srl.ndd.yndd.io,resources=srltunnelinterfacevxlaninterfaces;srlnetworkinstanceprotocolsbgpevpns;srlsystemnetworkinstanceprotocolsevpnesisbgpinstanceesis;srlnetworkinstanceprotocolsbgps;srlnetworkinstances,verbs=create;update,versions=v1,name=uniqueness.srl.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupUniquenessWebhook registers the validating admission webhook that rejects
+// resources with a VNI, EVI, ESI or router-id that collides with another resource.
+// The same validation is done by the reconcilers, the webhook reports the collision
+// before the resource is admitted.
+func SetupUniquenessWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	return setupValidatingWebhook(mgr, l, UniquenessWebhookPath, findCollision)
+}
+
+// +kubebuilder:webhook:path=/validate-srl-ndd-yndd-io-v1-addressing,mutating=false,failurePolicy=fail,sideEffects=None,groups=srl.ndd.yndd.io,resources=srlinterfacesubinterfaces,verbs=create;update,versions=v1,name=addressing.srl.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupAddressingWebhook registers the validating admission webhook that rejects
+// subinterfaces with overlapping prefixes, network or broadcast addresses, more
+// than one primary address or neighbors and virtual addresses outside the subnet.
+// The same validation is done by the reconcilers.
+func SetupAddressingWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	return setupValidatingWebhook(mgr, l, AddressingWebhookPath, findAddressingError)
+}
+
+// +kubebuilder:webhook:path=/validate-srl-ndd-yndd-io-v1-pool,mutating=false,failurePolicy=fail,sideEffects=None,groups=srl.ndd.yndd.io,resources=srlippools;srlasnpools;srlvnipools,verbs=create;update,versions=v1,name=pool.srl.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupPoolWebhook registers the validating admission webhook that rejects
+// invalid pools and pools that overlap with another pool of the same kind. Pools
+// that are admitted concurrently can still overlap, of those only the oldest
+// allocates values.
+func SetupPoolWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	return setupObjectWebhook(mgr, l, PoolWebhookPath, findPoolOverlap)
+}
+
+// findPoolOverlap returns a message when the pool is invalid or overlaps with
+// another pool of the same kind.
+func findPoolOverlap(ctx context.Context, kube client.Client, o client.Object) (string, error) {
+	p, ok := o.(pool)
+	if !ok {
+		return "", nil
+	}
+	r, err := newPoolRange(p)
+	if err != nil {
+		return err.Error(), nil
+	}
+	kind := poolKind(p)
+	pools, err := listPools(ctx, kube, kind)
+	if err != nil {
+		return "", err
+	}
+	for _, other := range pools {
+		if other.GetName() == p.GetName() || meta.WasDeleted(other) {
+			continue
+		}
+		or, err := newPoolRange(other)
+		if err != nil {
+			continue
+		}
+		if r.intersects(or) {
+			return fmt.Sprintf(errFmtPoolOverlaps, kind, p.GetName(), kind, other.GetName()), nil
+		}
+	}
+	return "", nil
+}
+
+// +kubebuilder:webhook:path=/mutate-srl-ndd-yndd-io-v1-changed-by,mutating=true,failurePolicy=ignore,sideEffects=None,groups=srl.ndd.yndd.io,resources=*,verbs=create;update,versions=v1,name=changedby.srl.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupChangedByWebhook registers the mutating admission webhook that sets the
+// changed-by annotation of a resource to the user that created it or changed
+// its spec, the user is recorded in the audit trail of the changes pushed to
+// the network nodes.
+func SetupChangedByWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	mgr.GetWebhookServer().Register(ChangedByWebhookPath, &webhook.Admission{Handler: &changedBy{
+		log: l.WithValues("webhook", ChangedByWebhookPath),
+	}})
+	return nil
+}
+
+type changedBy struct {
+	log logging.Logger
+}
+
+// admissionObject is the part of an admitted object the changedBy webhook
+// compares.
+type admissionObject struct {
+	Metadata struct {
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata"`
+	Spec interface{} `json:"spec,omitempty"`
+}
+
+// Handle sets the changed-by annotation of a created resource or of a resource
+// of which the spec changed to the user of the request.
+func (c *changedBy) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	o := &admissionObject{}
+	if err := json.Unmarshal(req.Object.Raw, o); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// the annotation of a resource of which the spec did not change keeps the
+	// user of the previous change, such that it cannot be set by hand
+	user := req.UserInfo.Username
+	if req.Operation == admissionv1.Update {
+		old := &admissionObject{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if reflect.DeepEqual(o.Spec, old.Spec) {
+			user = old.Metadata.Annotations[srlv1.AnnotationKeyChangedBy]
+		}
+	}
+	if o.Metadata.Annotations[srlv1.AnnotationKeyChangedBy] == user {
+		return admission.Allowed("")
+	}
+
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	md, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		md = map[string]interface{}{}
+		obj["metadata"] = md
+	}
+	annotations, ok := md["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = map[string]interface{}{}
+		md["annotations"] = annotations
+	}
+	annotations[srlv1.AnnotationKeyChangedBy] = user
+	changed, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	c.log.Debug("Changed by", "kind", req.Kind.Kind, "name", req.Name, "user", user)
+	return admission.PatchResponseFromRaw(req.Object.Raw, changed)
+}
+
+// A validateFn returns a message that describes why the resource is rejected,
+// the message is empty when the resource is valid.
+type validateFn func(ctx context.Context, kube client.Client, mg resource.Managed) (string, error)
+
+// A validateObjectFn validates resources that are not managed resources.
+type validateObjectFn func(ctx context.Context, kube client.Client, o client.Object) (string, error)
+
+func setupValidatingWebhook(mgr ctrl.Manager, l logging.Logger, path string, validate validateFn) error {
+	return setupObjectWebhook(mgr, l, path, func(ctx context.Context, kube client.Client, o client.Object) (string, error) {
+		mg, ok := o.(resource.Managed)
+		if !ok {
+			return "", nil
+		}
+		return validate(ctx, kube, mg)
+	})
+}
+
+func setupObjectWebhook(mgr ctrl.Manager, l logging.Logger, path string, validate validateObjectFn) error {
+	d, err := admission.NewDecoder(mgr.GetScheme())
+	if err != nil {
+		return errors.Wrap(err, errNewDecoder)
+	}
+	mgr.GetWebhookServer().Register(path, &webhook.Admission{Handler: &validator{
+		kube:     mgr.GetClient(),
+		scheme:   mgr.GetScheme(),
+		decoder:  d,
+		validate: validate,
+		log:      l.WithValues("webhook", path),
+	}})
+	return nil
+}
+
+type validator struct {
+	kube     client.Client
+	scheme   *runtime.Scheme
+	decoder  *admission.Decoder
+	validate validateObjectFn
+	log      logging.Logger
+}
+
+// Handle validates a created or updated resource.
+func (v *validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	obj, err := v.scheme.New(srlv1.GroupVersion.WithKind(req.Kind.Kind))
+	if err != nil {
+		return admission.Allowed("")
+	}
+	o, ok := obj.(client.Object)
+	if !ok {
+		return admission.Allowed("")
+	}
+	if err := v.decoder.Decode(req, o); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	msg, err := v.validate(ctx, v.kube, o)
+	if err != nil {
+		v.log.Debug("Cannot validate", "kind", req.Kind.Kind, "name", req.Name, "error", err)
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if msg != "" {
+		v.log.Debug("Rejected", "kind", req.Kind.Kind, "name", req.Name, "reason", msg)
+		return admission.Denied(msg)
+	}
+	return admission.Allowed("")
+}
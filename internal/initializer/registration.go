@@ -21,7 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
-	"github.com/yndd/ndd-runtime/pkg/resource"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -29,7 +29,7 @@ import (
 )
 
 const (
-	errApplyRegistration = "cannot apply Registration object"
+	errCreateRegistration = "cannot create Registration object"
 )
 
 // NewRegistrationObject returns a new *RegistrationObject initializer.
@@ -37,10 +37,14 @@ func NewRegistrationObject() *RegistrationObject {
 	return &RegistrationObject{}
 }
 
-// RegistrationObject has the initializer for creating the Registration object.
+// RegistrationObject has the initializer for creating the default Registration
+// object, which applies to all network nodes that are not selected by another
+// Registration.
 type RegistrationObject struct{}
 
-// Run makes sure Registration object exists.
+// Run makes sure the default Registration object exists. An existing object is
+// not changed, such that the customizations of its subscriptions and exception
+// paths are kept when the provider restarts.
 func (lo *RegistrationObject) Run(ctx context.Context, kube client.Client) error {
 	l := &srlv1.Registration{
 		ObjectMeta: metav1.ObjectMeta{
@@ -107,5 +111,8 @@ func (lo *RegistrationObject) Run(ctx context.Context, kube client.Client) error
 			},
 		},
 	}
-	return errors.Wrap(resource.NewAPIPatchingApplicator(kube).Apply(ctx, l), errApplyRegistration)
+	if err := kube.Create(ctx, l); err != nil && !kerrors.IsAlreadyExists(err) {
+		return errors.Wrap(err, errCreateRegistration)
+	}
+	return nil
 }
@@ -18,63 +18,106 @@ package initializer
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
-	errGetCRD             = "cannot get crd"
-	errFmtTimeoutExceeded = "%f seconds timeout for waiting CRDs to be ready is exceeded"
+	errListCRD            = "cannot list crds"
+	errFmtTimeoutExceeded = "%f seconds timeout for waiting CRDs to be ready is exceeded, missing %s"
 )
 
 // NewCRDWaiter returns a new *CRDWaiter initializer.
-func NewCRDWaiter(names []string, timeout time.Duration, period time.Duration, log logging.Logger) *CRDWaiter {
-	return &CRDWaiter{Names: names, Timeout: timeout, Period: period, log: log}
+func NewCRDWaiter(group string, kinds []string, timeout time.Duration, period time.Duration, log logging.Logger) *CRDWaiter {
+	return &CRDWaiter{Group: group, Kinds: kinds, Timeout: timeout, Period: period, log: log}
 }
 
-// CRDWaiter blocks the execution until all the CRDs whose names are given are
-// deployed to the cluster.
+// CRDWaiter blocks the execution until the CRDs of all the kinds of the group
+// are deployed to the cluster and established.
 type CRDWaiter struct {
-	Names   []string
+	Group   string
+	Kinds   []string
 	Timeout time.Duration
 	Period  time.Duration
 	log     logging.Logger
 }
 
-// Run continuously checks whether the list of CRDs whose names are given are
-// present in the cluster.
+// Run continuously checks whether the CRDs of the kinds are present and
+// established in the cluster. Errors of the API server are retried until the
+// timeout is exceeded or the context is cancelled.
 func (cw *CRDWaiter) Run(ctx context.Context, kube client.Client) error {
 	timeout := time.After(cw.Timeout)
 	ticker := time.NewTicker(cw.Period)
 	defer ticker.Stop()
+	missing := cw.Kinds
 	for {
 		select {
 		case <-ticker.C:
-			cw.log.Info("Waiting for required CRDs to be present", "names", cw.Names, "poll-interval", cw.Period)
-			present := 0
-			for _, n := range cw.Names {
-				crd := &v1.CustomResourceDefinition{}
-				nn := types.NamespacedName{Name: n}
-				err := kube.Get(ctx, nn, crd)
-				if err != nil && !kerrors.IsNotFound(err) {
-					return errors.Wrap(err, errGetCRD)
-				}
-				if kerrors.IsNotFound(err) {
-					break
-				}
-				present++
+			m, err := cw.missing(ctx, kube)
+			if err != nil {
+				cw.log.Info("Cannot check the required CRDs, retrying", "error", err, "poll-interval", cw.Period)
+				continue
 			}
-			if present == len(cw.Names) {
+			if len(m) == 0 {
 				return nil
 			}
+			missing = m
+			cw.log.Info("Waiting for required CRDs to be present", "group", cw.Group, "kinds", missing, "poll-interval", cw.Period)
 		case <-timeout:
-			return errors.Errorf(errFmtTimeoutExceeded, cw.Timeout.Seconds())
+			return errors.Errorf(errFmtTimeoutExceeded, cw.Timeout.Seconds(), strings.Join(missing, ", "))
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 }
+
+// missing returns the kinds of which the CRD is not present or not established.
+func (cw *CRDWaiter) missing(ctx context.Context, kube client.Client) ([]string, error) {
+	crds := &v1.CustomResourceDefinitionList{}
+	if err := kube.List(ctx, crds); err != nil {
+		return nil, errors.Wrap(err, errListCRD)
+	}
+	established := make(map[string]bool)
+	for _, crd := range crds.Items {
+		if crd.Spec.Group != cw.Group {
+			continue
+		}
+		for _, c := range crd.Status.Conditions {
+			if c.Type == v1.Established && c.Status == v1.ConditionTrue {
+				established[crd.Spec.Names.Kind] = true
+			}
+		}
+	}
+	missing := make([]string, 0)
+	for _, k := range cw.Kinds {
+		if !established[k] {
+			missing = append(missing, k)
+		}
+	}
+	return missing, nil
+}
+
+// Kinds returns the kinds of the group version in the scheme that have a list
+// kind, which are the kinds that are served by a CRD.
+func Kinds(s *runtime.Scheme, gv schema.GroupVersion) []string {
+	types := s.KnownTypes(gv)
+	kinds := make([]string, 0, len(types))
+	for k := range types {
+		if strings.HasSuffix(k, "List") {
+			continue
+		}
+		if _, ok := types[k+"List"]; ok {
+			kinds = append(kinds, k)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
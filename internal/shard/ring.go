@@ -0,0 +1,93 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+)
+
+const (
+	// virtualNodes is the number of points of a member on the ring, which
+	// spreads the keys evenly over the members
+	virtualNodes = 128
+)
+
+// A Ring is a consistent hash ring of the members, a key is owned by the first
+// member clockwise of the hash of the key. When a member joins or leaves, only
+// the keys of the arcs of that member move.
+type Ring struct {
+	members []string
+	points  []uint64
+	owners  map[uint64]string
+}
+
+// NewRing returns a ring of the members.
+func NewRing(members []string) *Ring {
+	r := &Ring{
+		members: append([]string{}, members...),
+		points:  make([]uint64, 0, len(members)*virtualNodes),
+		owners:  make(map[uint64]string, len(members)*virtualNodes),
+	}
+	sort.Strings(r.members)
+	for _, m := range r.members {
+		for i := 0; i < virtualNodes; i++ {
+			p := hash(m + "#" + strconv.Itoa(i))
+			// on a collision the member that sorts first keeps the point
+			if _, ok := r.owners[p]; ok {
+				continue
+			}
+			r.owners[p] = m
+			r.points = append(r.points, p)
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// Members returns the sorted members of the ring.
+func (r *Ring) Members() []string {
+	return r.members
+}
+
+// Owner returns the member that owns the key, an empty ring has no owner.
+func (r *Ring) Owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	h := hash(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// hash returns the fnv hash of the string mixed with the murmur3 finalizer,
+// fnv alone clusters the hashes of similar strings like node names.
+func hash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"strconv"
+	"testing"
+)
+
+// testKeys returns keys that look like the names of network nodes.
+func testKeys(n int) []string {
+	keys := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		keys = append(keys, "leaf"+strconv.Itoa(i))
+	}
+	return keys
+}
+
+func TestRingOwner(t *testing.T) {
+	cases := map[string]struct {
+		members []string
+		want    map[string]bool
+	}{
+		"Empty": {
+			members: nil,
+			want:    map[string]bool{"": true},
+		},
+		"Single": {
+			members: []string{"a"},
+			want:    map[string]bool{"a": true},
+		},
+		"Several": {
+			members: []string{"c", "a", "b"},
+			want:    map[string]bool{"a": true, "b": true, "c": true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewRing(tc.members)
+			got := make(map[string]bool)
+			for _, k := range testKeys(1000) {
+				owner := r.Owner(k)
+				if !tc.want[owner] {
+					t.Fatalf("Owner(%s): unexpected owner %q", k, owner)
+				}
+				got[owner] = true
+			}
+			// every member owns keys
+			for m := range tc.want {
+				if !got[m] {
+					t.Errorf("member %q owns no keys", m)
+				}
+			}
+		})
+	}
+}
+
+// TestRingMembersChange checks that only the keys of the member that joined or
+// left move.
+func TestRingMembersChange(t *testing.T) {
+	cases := map[string]struct {
+		before []string
+		after  []string
+		// moved is the member the keys move to or from
+		moved string
+	}{
+		"Add": {
+			before: []string{"a", "b"},
+			after:  []string{"a", "b", "c"},
+			moved:  "c",
+		},
+		"AddToSingle": {
+			before: []string{"a"},
+			after:  []string{"a", "b"},
+			moved:  "b",
+		},
+		"Remove": {
+			before: []string{"a", "b", "c"},
+			after:  []string{"a", "c"},
+			moved:  "b",
+		},
+		"RemoveToSingle": {
+			before: []string{"a", "b"},
+			after:  []string{"b"},
+			moved:  "a",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			before, after := NewRing(tc.before), NewRing(tc.after)
+			moves := 0
+			for _, k := range testKeys(1000) {
+				b, a := before.Owner(k), after.Owner(k)
+				if a == b {
+					continue
+				}
+				moves++
+				if a != tc.moved && b != tc.moved {
+					t.Errorf("key %s moved from %s to %s, want a move from or to %s", k, b, a, tc.moved)
+				}
+			}
+			if moves == 0 {
+				t.Errorf("no keys moved from or to %s", tc.moved)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LabelKeyShardGroup is the label of the leases of the provider replicas
+	// that share the network nodes
+	LabelKeyShardGroup = "srl.ndd.yndd.io/shard-group"
+	// AnnotationKeyShardMembers is the annotation of the lease of a replica with
+	// the members of the ring the replica applied
+	AnnotationKeyShardMembers = "srl.ndd.yndd.io/shard-members"
+
+	// DefaultLeaseDuration is the default duration after which a replica that
+	// did not renew its lease leaves the ring
+	DefaultLeaseDuration = 15 * time.Second
+	// DefaultRenewInterval is the default interval of the renewals of the lease
+	// of a replica
+	DefaultRenewInterval = 5 * time.Second
+
+	// errors
+	errGetLease    = "cannot get shard lease"
+	errCreateLease = "cannot create shard lease"
+	errUpdateLease = "cannot update shard lease"
+	errListLeases  = "cannot list shard leases"
+)
+
+// A Sharder shards keys, e.g. the names of the network nodes, across the
+// replicas of the provider with a consistent hash ring. Every replica holds a
+// lease that it renews, the replicas of which the lease did not expire form the
+// ring. A key only moves to its new owner once the replica that held it in the
+// ring it applied released it, either by applying the new ring or because its
+// lease expired or got deleted. A nil Sharder owns all keys, such that a
+// provider without sharding reconciles everything.
+type Sharder struct {
+	kube          client.Client
+	reader        client.Reader
+	log           logging.Logger
+	group         string
+	namespace     string
+	identity      string
+	leaseDuration time.Duration
+	renewInterval time.Duration
+
+	// mu protects the ring, the renewal, the held rings and the subscribers
+	mu      sync.RWMutex
+	ring    *Ring
+	renewed time.Time
+	// held are the rings applied by the other replicas of which the lease did
+	// not expire, by the members of the ring
+	held map[string]*Ring
+	// alive is the liveness of the replica at the last sync
+	alive       bool
+	subscribers []chan struct{}
+}
+
+// Option is a function to initialize the options of a Sharder.
+type Option func(s *Sharder)
+
+// WithLogger initializes the sharder with a logger.
+func WithLogger(l logging.Logger) Option {
+	return func(s *Sharder) {
+		s.log = l
+	}
+}
+
+// WithLeaseDuration initializes the duration after which a replica that did
+// not renew its lease leaves the ring.
+func WithLeaseDuration(d time.Duration) Option {
+	return func(s *Sharder) {
+		s.leaseDuration = d
+	}
+}
+
+// WithRenewInterval initializes the interval of the renewals of the lease.
+func WithRenewInterval(d time.Duration) Option {
+	return func(s *Sharder) {
+		s.renewInterval = d
+	}
+}
+
+// NewSharder returns a sharder of the group in the namespace for the replica
+// with the identity. The reader is used to get and list the leases of the group,
+// such that the membership is read from the API server rather than from a cache.
+func NewSharder(kube client.Client, reader client.Reader, group, namespace, identity string, opts ...Option) *Sharder {
+	s := &Sharder{
+		kube:          kube,
+		reader:        reader,
+		log:           logging.NewNopLogger(),
+		group:         group,
+		namespace:     namespace,
+		identity:      identity,
+		leaseDuration: DefaultLeaseDuration,
+		renewInterval: DefaultRenewInterval,
+		ring:          NewRing(nil),
+		held:          make(map[string]*Ring),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Identity returns the identity of the replica.
+func (s *Sharder) Identity() string {
+	if s == nil {
+		return ""
+	}
+	return s.identity
+}
+
+// Owns returns true when the key belongs to the shard of the replica and no
+// other replica holds it. Until the replica joined the ring or when its lease
+// was not renewed within the lease duration it owns no keys.
+func (s *Sharder) Owns(key string) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.owns(key, time.Now())
+}
+
+func (s *Sharder) owns(key string, now time.Time) bool {
+	if !s.live(now) || s.ring.Owner(key) != s.identity {
+		return false
+	}
+	for member, ring := range s.held {
+		if ring.Owner(key) == member {
+			return false
+		}
+	}
+	return true
+}
+
+// live returns true when the lease of the replica was renewed within the lease
+// duration.
+func (s *Sharder) live(now time.Time) bool {
+	return !s.renewed.IsZero() && now.Sub(s.renewed) < s.leaseDuration
+}
+
+// Subscribe returns a channel that is notified when the keys of the replica
+// could have changed, the channel of a nil Sharder is never notified.
+func (s *Sharder) Subscribe() <-chan struct{} {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch := make(chan struct{}, 1)
+	s.subscribers = append(s.subscribers, ch)
+	return ch
+}
+
+// Start renews the lease of the replica and rebuilds the ring from the leases
+// of the group until the context is cancelled, after which the lease is
+// deleted such that the other replicas take over the shard without waiting for
+// the lease to expire. Start implements manager.Runnable.
+func (s *Sharder) Start(ctx context.Context) error {
+	s.log.Debug("Starting sharder", "group", s.group, "identity", s.identity)
+	ticker := time.NewTicker(s.renewInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.renew(ctx); err != nil {
+			s.log.Debug("cannot renew shard lease", "error", err)
+		}
+		if err := s.sync(ctx); err != nil {
+			s.log.Debug("cannot sync shard ring", "error", err)
+			s.expire(time.Now())
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			s.release()
+			return nil
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, every replica
+// holds a lease.
+func (s *Sharder) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Sharder) leaseName() string {
+	return s.group + "-" + s.identity
+}
+
+// renew creates or renews the lease of the replica, the lease is annotated with
+// the members of the ring the replica applied.
+func (s *Sharder) renew(ctx context.Context) error {
+	now := metav1.NewMicroTime(time.Now())
+	seconds := int32(s.leaseDuration.Seconds())
+	s.mu.RLock()
+	members := strings.Join(s.ring.Members(), ",")
+	s.mu.RUnlock()
+	if err := s.renewLease(ctx, now, seconds, members); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.renewed = now.Time
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Sharder) renewLease(ctx context.Context, now metav1.MicroTime, seconds int32, members string) error {
+	// the lease is read from the API server, a cached read would start an
+	// informer of the leases of the cluster and could return a stale lease
+	l := &coordinationv1.Lease{}
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.leaseName()}, l); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return errors.Wrap(err, errGetLease)
+		}
+		l = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   s.namespace,
+				Name:        s.leaseName(),
+				Labels:      map[string]string{LabelKeyShardGroup: s.group},
+				Annotations: map[string]string{AnnotationKeyShardMembers: members},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.identity,
+				LeaseDurationSeconds: &seconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		return errors.Wrap(s.kube.Create(ctx, l), errCreateLease)
+	}
+	meta := l.GetAnnotations()
+	if meta == nil {
+		meta = make(map[string]string)
+	}
+	meta[AnnotationKeyShardMembers] = members
+	l.SetAnnotations(meta)
+	l.Spec.HolderIdentity = &s.identity
+	l.Spec.LeaseDurationSeconds = &seconds
+	l.Spec.RenewTime = &now
+	return errors.Wrap(s.kube.Update(ctx, l), errUpdateLease)
+}
+
+// sync rebuilds the ring and the rings held by the other replicas from the
+// leases of the group that did not expire, the subscribers are notified when
+// the keys of the replica could have changed.
+func (s *Sharder) sync(ctx context.Context) error {
+	ll := &coordinationv1.LeaseList{}
+	if err := s.reader.List(ctx, ll, client.InNamespace(s.namespace), client.MatchingLabels{LabelKeyShardGroup: s.group}); err != nil {
+		return errors.Wrap(err, errListLeases)
+	}
+	now := time.Now()
+	members := make([]string, 0, len(ll.Items))
+	held := make(map[string]*Ring)
+	for _, l := range ll.Items {
+		if expired(&l, now) {
+			continue
+		}
+		member := *l.Spec.HolderIdentity
+		members = append(members, member)
+		if member == s.identity {
+			continue
+		}
+		var applied []string
+		if m := l.GetAnnotations()[AnnotationKeyShardMembers]; m != "" {
+			applied = strings.Split(m, ",")
+		}
+		held[member] = NewRing(applied)
+	}
+	ring := NewRing(members)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// a replica of which the lease was not renewed drops its keys, which the
+	// subscribers need to know as well
+	alive := s.live(now)
+	changed := !reflect.DeepEqual(s.ring.Members(), ring.Members()) || !sameRings(s.held, held) || alive != s.alive
+	s.ring, s.held, s.alive = ring, held, alive
+	if !changed {
+		return nil
+	}
+	s.log.Debug("shard members changed", "members", ring.Members(), "live", alive)
+	s.notify()
+	return nil
+}
+
+// expire notifies the subscribers when the lease of the replica was not renewed
+// since the last sync.
+func (s *Sharder) expire(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.alive || s.live(now) {
+		return
+	}
+	s.alive = false
+	s.notify()
+}
+
+// notify notifies the subscribers of a change of the keys of the replica.
+func (s *Sharder) notify() {
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// sameRings returns true when the replicas hold rings with the same members.
+func sameRings(a, b map[string]*Ring) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for member, ra := range a {
+		rb, ok := b[member]
+		if !ok || !reflect.DeepEqual(ra.Members(), rb.Members()) {
+			return false
+		}
+	}
+	return true
+}
+
+// release deletes the lease of the replica.
+func (s *Sharder) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.renewInterval)
+	defer cancel()
+	l := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.leaseName()}}
+	if err := s.kube.Delete(ctx, l); client.IgnoreNotFound(err) != nil {
+		s.log.Debug("cannot delete shard lease", "error", err)
+	}
+}
+
+// expired returns true when the lease was not renewed within its duration.
+func expired(l *coordinationv1.Lease, now time.Time) bool {
+	if l.Spec.HolderIdentity == nil || l.Spec.RenewTime == nil || l.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return l.Spec.RenewTime.Add(time.Duration(*l.Spec.LeaseDurationSeconds) * time.Second).Before(now)
+}
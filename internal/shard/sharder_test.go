@@ -0,0 +1,212 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNamespace = "ndd-system"
+
+func newTestClient(t *testing.T) client.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := coordinationv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(s).Build()
+}
+
+func newTestSharder(kube client.Client, identity string, leaseDuration time.Duration) *Sharder {
+	return NewSharder(kube, kube, "test", testNamespace, identity, WithLeaseDuration(leaseDuration))
+}
+
+// checkOwners fails the test when a key is owned by more than one of the
+// sharders, all keys must have an owner when complete is true.
+func checkOwners(t *testing.T, step string, sharders []*Sharder, complete bool) {
+	t.Helper()
+	for _, k := range testKeys(500) {
+		owners := make([]string, 0, 1)
+		for _, s := range sharders {
+			if s.Owns(k) {
+				owners = append(owners, s.Identity())
+			}
+		}
+		if len(owners) > 1 {
+			t.Fatalf("%s: key %s owned by %v", step, k, owners)
+		}
+		if complete && len(owners) == 0 {
+			t.Fatalf("%s: key %s has no owner", step, k)
+		}
+	}
+}
+
+// converge renews and syncs the sharders until their rings are applied by all,
+// the owners of the keys are checked after every renewal and sync.
+func converge(ctx context.Context, t *testing.T, step string, sharders []*Sharder) {
+	t.Helper()
+	for i := 0; i < 3; i++ {
+		for _, s := range sharders {
+			if err := s.renew(ctx); err != nil {
+				t.Fatal(err)
+			}
+			checkOwners(t, step, sharders, false)
+		}
+		for _, s := range sharders {
+			if err := s.sync(ctx); err != nil {
+				t.Fatal(err)
+			}
+			checkOwners(t, step, sharders, false)
+		}
+	}
+	checkOwners(t, step, sharders, true)
+}
+
+func TestNilSharderOwnsAll(t *testing.T) {
+	var s *Sharder
+	if !s.Owns("leaf1") {
+		t.Errorf("nil sharder: want to own all keys")
+	}
+	if s.Subscribe() != nil {
+		t.Errorf("nil sharder: want no subscription")
+	}
+}
+
+func TestSharderOwns(t *testing.T) {
+	now := time.Now()
+	cases := map[string]struct {
+		renewed time.Time
+		members []string
+		held    map[string][]string
+		key     string
+		want    bool
+	}{
+		"NotJoined": {
+			members: []string{"a"},
+			key:     "leaf1",
+			want:    false,
+		},
+		"Expired": {
+			renewed: now.Add(-time.Minute),
+			members: []string{"a"},
+			key:     "leaf1",
+			want:    false,
+		},
+		"Owner": {
+			renewed: now,
+			members: []string{"a"},
+			key:     "leaf1",
+			want:    true,
+		},
+		"HeldByPreviousOwner": {
+			renewed: now,
+			members: []string{"a", "b"},
+			// b has not applied the ring with a yet
+			held: map[string][]string{"b": {"b"}},
+			key:  ownedBy(t, []string{"a", "b"}, "a"),
+			want: false,
+		},
+		"ReleasedByPreviousOwner": {
+			renewed: now,
+			members: []string{"a", "b"},
+			held:    map[string][]string{"b": {"a", "b"}},
+			key:     ownedBy(t, []string{"a", "b"}, "a"),
+			want:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := NewSharder(nil, nil, "test", testNamespace, "a")
+			s.renewed = tc.renewed
+			s.ring = NewRing(tc.members)
+			for m, applied := range tc.held {
+				s.held[m] = NewRing(applied)
+			}
+			if got := s.owns(tc.key, now); got != tc.want {
+				t.Errorf("owns(%s): want %t, got %t", tc.key, tc.want, got)
+			}
+		})
+	}
+}
+
+// ownedBy returns a key that the member owns on the ring of the members.
+func ownedBy(t *testing.T, members []string, member string) string {
+	r := NewRing(members)
+	for _, k := range testKeys(100) {
+		if r.Owner(k) == member {
+			return k
+		}
+	}
+	t.Fatalf("member %s owns no keys", member)
+	return ""
+}
+
+// TestSharderMembersChange checks that a key is never owned by two live
+// replicas while replicas join and leave the ring.
+func TestSharderMembersChange(t *testing.T) {
+	ctx := context.Background()
+	kube := newTestClient(t)
+	a := newTestSharder(kube, "a", time.Minute)
+	b := newTestSharder(kube, "b", time.Minute)
+	converge(ctx, t, "start", []*Sharder{a, b})
+
+	c := newTestSharder(kube, "c", time.Minute)
+	converge(ctx, t, "join", []*Sharder{a, b, c})
+
+	// b leaves and deletes its lease
+	b.release()
+	converge(ctx, t, "leave", []*Sharder{a, c})
+	if got := a.ring.Members(); len(got) != 2 {
+		t.Errorf("members after leave: want [a c], got %v", got)
+	}
+}
+
+// TestSharderLeaseExpires checks that the keys of a replica that stops renewing
+// its lease move to the other replicas once its lease expired, and not before.
+func TestSharderLeaseExpires(t *testing.T) {
+	ctx := context.Background()
+	kube := newTestClient(t)
+	leaseDuration := time.Second
+	a := newTestSharder(kube, "a", leaseDuration)
+	b := newTestSharder(kube, "b", leaseDuration)
+	converge(ctx, t, "start", []*Sharder{a, b})
+
+	// b hangs, it does not renew its lease nor sync the ring
+	if err := a.renew(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkOwners(t, "hang", []*Sharder{a, b}, true)
+
+	time.Sleep(leaseDuration + 100*time.Millisecond)
+	checkOwners(t, "expired", []*Sharder{a, b}, false)
+	converge(ctx, t, "expired", []*Sharder{a})
+	for _, k := range testKeys(10) {
+		if b.Owns(k) {
+			t.Errorf("expired replica b owns %s", k)
+		}
+	}
+}
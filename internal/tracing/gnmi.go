@@ -0,0 +1,61 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"path"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// GNMIDialOptions returns the dial options that trace the unary gNMI RPCs to
+// the device driver of the network node, the spans carry the number of paths
+// of the requests.
+func GNMIDialOptions(node string) []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(gnmiInterceptor(node))}
+}
+
+func gnmiInterceptor(node string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attrs := []attribute.KeyValue{
+			semconv.RPCSystemKey.String("grpc"),
+			semconv.RPCMethodKey.String(path.Base(method)),
+			AttrNetworkNode.String(node),
+		}
+		switch r := req.(type) {
+		case *gnmi.GetRequest:
+			attrs = append(attrs, AttrPaths.Int(len(r.GetPath())))
+		case *gnmi.SetRequest:
+			attrs = append(attrs,
+				AttrUpdates.Int(len(r.GetUpdate())),
+				AttrReplaces.Int(len(r.GetReplace())),
+				AttrDeletes.Int(len(r.GetDelete())),
+			)
+		}
+		ctx, span := otel.Tracer(instrumentationName).Start(ctx, "gnmi."+path.Base(method),
+			trace.WithAttributes(attrs...), trace.WithSpanKind(trace.SpanKindClient))
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		End(span, err)
+		return err
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ExporterNone disables the export of the spans
+	ExporterNone = "none"
+	// ExporterOTLP exports the spans to an OTLP collector over gRPC
+	ExporterOTLP = "otlp"
+	// ExporterFile exports the spans as JSON to a file, or to stdout when the
+	// path is empty
+	ExporterFile = "file"
+
+	// ServiceName of the spans of the provider
+	ServiceName = "ndd-provider-srl"
+
+	instrumentationName = "github.com/yndd/ndd-provider-srl"
+
+	// errors
+	errFmtUnknownExporter = "unknown tracing exporter %s"
+	errCreateExporter     = "cannot create tracing exporter"
+	errOpenFile           = "cannot open tracing file"
+)
+
+// Span attributes
+const (
+	AttrGroup       = attribute.Key("ndd.group")
+	AttrVersion     = attribute.Key("ndd.version")
+	AttrKind        = attribute.Key("ndd.kind")
+	AttrName        = attribute.Key("ndd.name")
+	AttrNamespace   = attribute.Key("ndd.namespace")
+	AttrNetworkNode = attribute.Key("ndd.network_node")
+	AttrPaths       = attribute.Key("gnmi.paths")
+	AttrUpdates     = attribute.Key("gnmi.updates")
+	AttrReplaces    = attribute.Key("gnmi.replaces")
+	AttrDeletes     = attribute.Key("gnmi.deletes")
+)
+
+// Options of the tracing of the provider.
+type Options struct {
+	// Exporter of the spans: none, otlp or file
+	Exporter string
+	// Endpoint of the OTLP collector
+	Endpoint string
+	// Insecure disables the TLS of the connection to the OTLP collector
+	Insecure bool
+	// Path of the file the spans are written to
+	Path string
+	// SampleRatio is the ratio of the reconciles that are traced
+	SampleRatio float64
+}
+
+// Setup installs the global tracer provider with the exporter of the options,
+// the returned function flushes and stops the exporter. Without an exporter the
+// spans are not recorded.
+func Setup(ctx context.Context, o Options) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	switch o.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(o.Endpoint)}
+		if o.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		e, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, errors.Wrap(err, errCreateExporter)
+		}
+		exporter = e
+	case ExporterFile:
+		var w io.Writer = os.Stdout
+		if o.Path != "" {
+			f, err := os.OpenFile(o.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+			if err != nil {
+				return nil, errors.Wrap(err, errOpenFile)
+			}
+			w = f
+		}
+		e, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			return nil, errors.Wrap(err, errCreateExporter)
+		}
+		exporter = e
+	default:
+		return nil, errors.Errorf(errFmtUnknownExporter, o.Exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(o.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(ServiceName))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// Start starts a span of the provider.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// reconciles are the spans of the running reconciles by key. The reconciler of
+// the resources does not pass its context to the external client and the
+// validator, their spans get the span of the reconcile of the resource as
+// parent through this registry. A controller never reconciles the same key
+// concurrently.
+var reconciles sync.Map
+
+// StartReconcile starts the span of the reconcile of the resource with the key.
+func StartReconcile(ctx context.Context, key string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx, span := Start(ctx, "Reconcile", attrs...)
+	reconciles.Store(key, span)
+	return ctx, span
+}
+
+// EndReconcile ends the span of the reconcile of the resource with the key.
+func EndReconcile(key string, err error) {
+	if span, ok := reconciles.LoadAndDelete(key); ok {
+		End(span.(trace.Span), err)
+	}
+}
+
+// WithReconcile returns the context with the span of the running reconcile of
+// the resource with the key, the context is returned as is when the context
+// already has a span or when the resource is not being reconciled.
+func WithReconcile(ctx context.Context, key string) context.Context {
+	if trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return ctx
+	}
+	if span, ok := reconciles.Load(key); ok {
+		return trace.ContextWithSpan(ctx, span.(trace.Span))
+	}
+	return ctx
+}